@@ -217,9 +217,9 @@ type Namespace struct {
 }
 
 // parseNamespaceList parses the output of 'fxconfig namespace list' command.
-// Expected format: "N) name: version X policy: <hex>".
-// Example: "0) perf: version 0 policy: 0a05454344534112b201...".
-func parseNamespaceList(output string) ([]Namespace, error) { //nolint:gocognit
+// Expected format is a table with the columns "NAME VERSION POLICY FINGERPRINT".
+// Example: "perf  0  threshold ECDSA  SHA256:5d41...".
+func parseNamespaceList(output string) ([]Namespace, error) {
 	namespaces := make([]Namespace, 0)
 
 	for line := range strings.SplitSeq(output, "\n") {
@@ -227,43 +227,29 @@ func parseNamespaceList(output string) ([]Namespace, error) { //nolint:gocognit
 		// Skip header, empty lines, and error messages
 		if line == "" ||
 			strings.HasPrefix(line, "Installed namespaces") ||
+			strings.HasPrefix(line, "NAME") ||
 			strings.HasPrefix(line, "Error:") ||
 			strings.HasPrefix(line, "Usage:") ||
 			strings.HasPrefix(line, "Flags:") {
 			continue
 		}
 
-		// Parse line format: "0) perf: version 0 policy: ..."
-		if idx := strings.Index(line, ")"); idx > 0 {
-			rest := strings.TrimSpace(line[idx+1:])
-
-			// Split by "version" keyword
-			parts := strings.Split(rest, " version ")
-			if len(parts) != 2 {
-				continue
-			}
-
-			// Extract name (before ":")
-			namePart := strings.TrimSpace(parts[0])
-			if colonIdx := strings.Index(namePart, ":"); colonIdx > 0 {
-				name := strings.TrimSpace(namePart[:colonIdx])
-
-				// Extract version (ignore policy)
-				versionPart := strings.TrimSpace(parts[1])
-				versionPolicyParts := strings.Split(versionPart, " policy: ")
-
-				version := 0
-				_, err := fmt.Sscanf(versionPolicyParts[0], "%d", &version)
-				if err != nil {
-					return nil, fmt.Errorf("failed to parse version from line '%s': %w", line, err)
-				}
-
-				namespaces = append(namespaces, Namespace{
-					Name:    name,
-					Version: version,
-				})
-			}
+		// Parse line format: "perf  0  msp OR('Org1MSP.member')"
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		version := 0
+		_, err := fmt.Sscanf(fields[1], "%d", &version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse version from line '%s': %w", line, err)
 		}
+
+		namespaces = append(namespaces, Namespace{
+			Name:    fields[0],
+			Version: version,
+		})
 	}
 
 	return namespaces, nil
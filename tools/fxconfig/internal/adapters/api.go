@@ -7,8 +7,12 @@ package adapters
 
 import (
 	"context"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
 	"github.com/hyperledger/fabric-x-common/msp"
 )
 
@@ -20,10 +24,28 @@ type MspProvider interface {
 	Validate() error
 }
 
+// Transaction pairs a transaction with its transaction ID.
+type Transaction struct {
+	TxID string
+	Tx   *applicationpb.Tx
+}
+
+// SignedEnvelope pairs an envelope signed by its submitter with the ID of the wrapped transaction.
+type SignedEnvelope struct {
+	TxID     string
+	Envelope *cb.Envelope
+}
+
 // OrdererClient submits transactions to the ordering service.
 type OrdererClient interface {
 	// Broadcast sends a signed transaction to the ordering service.
 	Broadcast(ctx context.Context, signer msp.SigningIdentity, txID string, tx *applicationpb.Tx) error
+	// BroadcastBatch sends multiple signed transactions over a single broadcast stream.
+	// Returns one error per transaction (nil on success), in input order.
+	BroadcastBatch(ctx context.Context, signer msp.SigningIdentity, txs []Transaction) []error
+	// BroadcastEnvelopeBatch sends pre-signed envelopes over a single broadcast stream.
+	// Returns one error per envelope (nil on success), in input order.
+	BroadcastEnvelopeBatch(ctx context.Context, envs []SignedEnvelope) []error
 	// Close releases resources held by the client.
 	Close() error
 }
@@ -40,6 +62,10 @@ type OrdererProvider interface {
 type QueryClient interface {
 	// GetNamespacePolicies fetches current namespace policy configurations.
 	GetNamespacePolicies(ctx context.Context) (*applicationpb.NamespacePolicies, error)
+	// GetNamespacePolicy fetches the policy of a single namespace; nil if the namespace does not exist.
+	GetNamespacePolicy(ctx context.Context, nsID string) (*applicationpb.PolicyItem, error)
+	// GetTransactionStatus fetches the final status of committed transactions; others are omitted.
+	GetTransactionStatus(ctx context.Context, txIDs []string) ([]*committerpb.TxStatus, error)
 	// Close releases resources held by the client.
 	Close() error
 }
@@ -52,12 +78,83 @@ type QueryProvider interface {
 	Validate() error
 }
 
+// BlockQueryClient reads committed blocks from the committer.
+type BlockQueryClient interface {
+	// GetBlockchainInfo fetches the current ledger height.
+	GetBlockchainInfo(ctx context.Context) (*cb.BlockchainInfo, error)
+	// GetBlockByNumber fetches a committed block including its transaction statuses.
+	GetBlockByNumber(ctx context.Context, number uint64) (*cb.Block, error)
+	// DeliverBlocks streams committed blocks including their transaction statuses, starting at from.
+	// If to is nil, new blocks are streamed as they are committed until ctx is done.
+	DeliverBlocks(ctx context.Context, from uint64, to *uint64, deliver func(*cb.Block) error) error
+	// Close releases resources held by the client.
+	Close() error
+}
+
+// BlockQueryProvider creates and validates BlockQueryClient instances.
+type BlockQueryProvider interface {
+	// Get returns a configured block query client.
+	Get() (BlockQueryClient, error)
+	// Validate checks if the provider configuration is valid.
+	Validate() error
+}
+
+// TxState classifies how much is known about the outcome of a transaction.
+type TxState int
+
+const (
+	// TxStateUnknown indicates that no status was received, e.g., because waiting failed.
+	TxStateUnknown TxState = iota
+	// TxStateFinal indicates that the committer reported the final status of the transaction.
+	TxStateFinal
+	// TxStateTimedOut indicates that the transaction was not finalized within the waiting timeout.
+	TxStateTimedOut
+	// TxStateNotFound indicates that the committer does not know the transaction, i.e., it is not
+	// committed (yet).
+	TxStateNotFound
+)
+
+// String returns the name of the state, e.g., "timed-out".
+func (s TxState) String() string {
+	switch s {
+	case TxStateFinal:
+		return "final"
+	case TxStateTimedOut:
+		return "timed-out"
+	case TxStateNotFound:
+		return "not-found"
+	default:
+		return "unknown"
+	}
+}
+
+// TxStatus is the status of a transaction as reported by the committer.
+type TxStatus struct {
+	TxID  string
+	State TxState
+	// Status is the final status of the transaction; STATUS_UNSPECIFIED unless State is TxStateFinal.
+	Status committerpb.Status
+	// BlockNum and TxNum locate the transaction in the ledger; only set if State is TxStateFinal.
+	BlockNum uint64
+	TxNum    uint32
+}
+
+// Committed reports whether the transaction was committed successfully.
+func (s TxStatus) Committed() bool {
+	return s.State == TxStateFinal && s.Status == committerpb.Status_COMMITTED
+}
+
 // NotificationClient subscribes to transaction confirmation events.
 type NotificationClient interface {
 	// Subscribe creates a subscription channel for the specified transaction ID.
-	Subscribe(ctx context.Context, txID string) (chan int, error)
-	// WaitForEvent blocks until a transaction event is received on the subscription.
-	WaitForEvent(ctx context.Context, subscription chan int) (int, error)
+	Subscribe(ctx context.Context, txID string) (chan TxStatus, error)
+	// SubscribeBatch creates one subscription channel per transaction ID using a single request.
+	// The notifier waits up to timeout for the transactions; zero uses the configured waiting timeout.
+	SubscribeBatch(ctx context.Context, txIDs []string, timeout time.Duration) ([]chan TxStatus, error)
+	// WaitForEvent blocks until a transaction event is received on the subscription, waiting up to
+	// the timeout of the subscription. A transaction that the notifier did not see finalized within
+	// the waiting timeout is reported with TxStateTimedOut.
+	WaitForEvent(ctx context.Context, subscription chan TxStatus, timeout time.Duration) (TxStatus, error)
 	// Close releases resources held by the client.
 	Close() error
 }
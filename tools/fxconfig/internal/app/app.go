@@ -11,11 +11,14 @@ package app
 import (
 	"context"
 
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/msp"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/provider"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
 )
 
@@ -23,10 +26,31 @@ import (
 type Application interface {
 	DeployNamespace(ctx context.Context, input *DeployNamespaceInput) (*DeployNamespaceOutput, TxStatus, error)
 	ListNamespaces(ctx context.Context) ([]NamespaceQueryResult, error)
+	GetNamespace(ctx context.Context, nsID string) (*NamespaceQueryResult, error)
+	NamespaceHistory(ctx context.Context, input *NamespaceHistoryInput) ([]NamespacePolicyChange, error)
+	PlanNamespaces(ctx context.Context, manifest *NamespaceManifest) (*ApplyPlan, error)
+	ApplyNamespaces(ctx context.Context, plan *ApplyPlan, wait bool) ([]TxSubmissionResult, error)
+	DiffNamespaces(ctx context.Context, specs []NamespaceSpec) ([]NamespaceDiff, error)
 	EndorseTransaction(ctx context.Context, txID string, tx *applicationpb.Tx) (*applicationpb.Tx, error)
+	EndorseTransactionWithKey(ctx context.Context, input *ThresholdEndorseInput) (*applicationpb.Tx, error)
 	SubmitTransaction(ctx context.Context, txID string, tx *applicationpb.Tx) error
 	SubmitTransactionWithWait(ctx context.Context, txID string, tx *applicationpb.Tx) (TxStatus, error)
+	SubmitTransactions(ctx context.Context, txs []adapters.Transaction, wait bool) ([]TxSubmissionResult, error)
 	MergeTransactions(ctx context.Context, txs []*applicationpb.Tx) (*applicationpb.Tx, error)
+	SignEnvelope(ctx context.Context, channel, txID string, tx *applicationpb.Tx) (*cb.Envelope, error)
+	BroadcastEnvelopes(ctx context.Context, envs []adapters.SignedEnvelope, wait bool) ([]TxSubmissionResult, error)
+	VerifyTransaction(ctx context.Context, input *VerifyTransactionInput) (*TxVerification, error)
+	InspectTransaction(ctx context.Context, txID string, tx *applicationpb.Tx) (*transaction.TxReport, error)
+	TransactionStatus(ctx context.Context, input *TxStatusInput) ([]TxSubmissionResult, error)
+	StreamEvents(ctx context.Context, input *EventsInput, handle func(*BlockEvents) error) error
+	FetchBlock(ctx context.Context, position string) (*cb.Block, error)
+	DescribeBlock(ctx context.Context, block *cb.Block) (*transaction.BlockReport, error)
+	FetchChannelConfig(ctx context.Context) (*ChannelConfig, error)
+	ComputeConfigUpdate(ctx context.Context, input *ConfigUpdateInput) (*cb.ConfigUpdateEnvelope, error)
+	SignConfigUpdate(ctx context.Context, env *cb.ConfigUpdateEnvelope) (*cb.ConfigUpdateEnvelope, error)
+	MergeConfigUpdates(ctx context.Context, envs []*cb.ConfigUpdateEnvelope) (*cb.ConfigUpdateEnvelope, error)
+	SubmitConfigUpdate(ctx context.Context, env *cb.ConfigUpdateEnvelope) (string, error)
+	AddOrganization(ctx context.Context, input *AddOrganizationInput) (*cb.ConfigUpdateEnvelope, error)
 }
 
 // AdminApp implements Application interface with provider-based dependencies.
@@ -36,4 +60,5 @@ type AdminApp struct {
 	QueryProvider        *provider.Provider[adapters.QueryClient, *config.QueriesConfig]
 	OrdererProvider      *provider.Provider[adapters.OrdererClient, *config.OrdererConfig]
 	NotificationProvider *provider.Provider[adapters.NotificationClient, *config.NotificationsConfig]
+	BlockQueryProvider   *provider.Provider[adapters.BlockQueryClient, *config.NotificationsConfig]
 }
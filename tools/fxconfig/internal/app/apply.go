@@ -0,0 +1,177 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
+)
+
+// Actions of a planned namespace change.
+const (
+	ApplyActionCreate    = "create"
+	ApplyActionUpdate    = "update"
+	ApplyActionUnchanged = "unchanged"
+)
+
+// NamespaceManifest declares the desired set of namespaces and their endorsement policies.
+type NamespaceManifest struct {
+	Namespaces []NamespaceSpec `json:"namespaces" yaml:"namespaces"`
+}
+
+// NamespaceSpec declares the desired endorsement policy of a single namespace.
+// Policy uses the syntax of the --policy flag, i.e., an MSP policy expression or "threshold:[<scheme>:]<path>".
+type NamespaceSpec struct {
+	Name   string `json:"name" yaml:"name"`
+	Policy string `json:"policy" yaml:"policy"`
+}
+
+// Validate validates the manifest.
+// Checks that it declares at least one namespace, that names are unique, and that every namespace is valid.
+func (m *NamespaceManifest) Validate(vctx validation.Context) error {
+	if len(m.Namespaces) == 0 {
+		return errors.New("manifest declares no namespaces")
+	}
+
+	seen := make(map[string]struct{}, len(m.Namespaces))
+	for i, spec := range m.Namespaces {
+		if _, ok := seen[spec.Name]; ok {
+			return fmt.Errorf("invalid namespaces[%d]: duplicate namespace %s", i, spec.Name)
+		}
+		seen[spec.Name] = struct{}{}
+
+		input := spec.deployInput(-1)
+		if err := input.Validate(vctx); err != nil {
+			return fmt.Errorf("invalid namespaces[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// deployInput returns the deployment input of the namespace at the given version.
+func (s *NamespaceSpec) deployInput(version int) DeployNamespaceInput {
+	input := DeployNamespaceInput{NsID: s.Name, Version: version}
+	input.Policy.Set(s.Policy)
+	return input
+}
+
+// NamespaceChange is the planned change of a single namespace.
+type NamespaceChange struct {
+	Action string `json:"action" yaml:"action"`
+	NsID   string `json:"name" yaml:"name"`
+	// Version is the current version of the namespace, or -1 if the namespace does not exist yet.
+	Version int                            `json:"version" yaml:"version"`
+	Current *transaction.PolicyDescription `json:"current,omitempty" yaml:"current,omitempty"`
+	Desired *transaction.PolicyDescription `json:"desired" yaml:"desired"`
+
+	spec NamespaceSpec
+}
+
+// ApplyPlan lists the changes required to reach the state declared by a manifest.
+type ApplyPlan struct {
+	Changes []NamespaceChange `json:"changes" yaml:"changes"`
+}
+
+// Pending returns the changes that require a transaction.
+func (p *ApplyPlan) Pending() []NamespaceChange {
+	var pending []NamespaceChange
+	for _, c := range p.Changes {
+		if c.Action != ApplyActionUnchanged {
+			pending = append(pending, c)
+		}
+	}
+	return pending
+}
+
+// PlanNamespaces compares the manifest against the installed namespaces.
+// Namespaces that do not exist are created; namespaces whose policy differs are updated
+// at their current version. Installed namespaces that are not declared in the manifest are left untouched.
+func (d *AdminApp) PlanNamespaces(ctx context.Context, manifest *NamespaceManifest) (*ApplyPlan, error) {
+	if err := manifest.Validate(d.Validators); err != nil {
+		return nil, err
+	}
+
+	installed, err := d.ListNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]NamespaceQueryResult, len(installed))
+	for _, ns := range installed {
+		current[ns.NsID] = ns
+	}
+
+	plan := &ApplyPlan{Changes: make([]NamespaceChange, len(manifest.Namespaces))}
+	for i, spec := range manifest.Namespaces {
+		input := spec.deployInput(-1)
+		nsPolicy, err := createPolicy(input.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create policy of namespace %s: %w", spec.Name, err)
+		}
+		desired, err := transaction.DescribeNamespacePolicy(nsPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode policy of namespace %s: %w", spec.Name, err)
+		}
+
+		change := NamespaceChange{
+			Action:  ApplyActionCreate,
+			NsID:    spec.Name,
+			Version: -1,
+			Desired: desired,
+			spec:    spec,
+		}
+		if ns, ok := current[spec.Name]; ok {
+			change.Action = ApplyActionUpdate
+			change.Version = ns.Version
+			change.Current = ns.Policy
+			if desired.Equivalent(ns.Policy) {
+				change.Action = ApplyActionUnchanged
+			}
+		}
+
+		plan.Changes[i] = change
+	}
+
+	return plan, nil
+}
+
+// ApplyNamespaces builds, endorses, and submits one transaction per pending change of the plan.
+// The transactions are submitted as a single batch; the results are in the order of Pending.
+func (d *AdminApp) ApplyNamespaces(ctx context.Context, plan *ApplyPlan, wait bool) ([]TxSubmissionResult, error) {
+	pending := plan.Pending()
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	txs := make([]adapters.Transaction, len(pending))
+	for i, c := range pending {
+		if c.spec.Name == "" {
+			// the plan was not computed by PlanNamespaces
+			return nil, fmt.Errorf("no namespace spec for planned change of %s", c.NsID)
+		}
+		input := c.spec.deployInput(c.Version)
+
+		out, err := d.CreateNamespace(ctx, &input)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create transaction for namespace %s: %w", c.NsID, err)
+		}
+
+		out.Tx, err = d.EndorseTransaction(ctx, out.TxID, out.Tx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot endorse transaction for namespace %s: %w", c.NsID, err)
+		}
+
+		txs[i] = adapters.Transaction{TxID: out.TxID, Tx: out.Tx}
+	}
+
+	return d.SubmitTransactions(ctx, txs, wait)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// installedNamespaces returns a query client serving the given MSP policies at the given versions.
+func installedNamespaces(t *testing.T, items map[string]string) *mockQueryClient {
+	t.Helper()
+
+	policies := &applicationpb.NamespacePolicies{}
+	for nsID, expr := range items {
+		p, err := transaction.CreateMspPolicy(expr)
+		require.NoError(t, err)
+		policies.Policies = append(policies.Policies,
+			&applicationpb.PolicyItem{Namespace: nsID, Version: 2, Policy: protoutil.MarshalOrPanic(p)})
+	}
+	return &mockQueryClient{policies: policies}
+}
+
+func someManifest() *NamespaceManifest {
+	return &NamespaceManifest{Namespaces: []NamespaceSpec{
+		{Name: "created", Policy: "OR('Org1MSP.member')"},
+		{Name: "updated", Policy: "AND('Org1MSP.member', 'Org2MSP.member')"},
+		{Name: "unchanged", Policy: "OR('Org1MSP.member', 'Org2MSP.member')"},
+	}}
+}
+
+func someSpec(nsID string) NamespaceSpec {
+	return NamespaceSpec{Name: nsID, Policy: "OR('Org1MSP.member')"}
+}
+
+func TestNamespaceManifest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		manifest    NamespaceManifest
+		expectError string
+	}{
+		{
+			name:     "valid",
+			manifest: *someManifest(),
+		},
+		{
+			name:        "empty",
+			manifest:    NamespaceManifest{},
+			expectError: "manifest declares no namespaces",
+		},
+		{
+			name: "duplicate",
+			manifest: NamespaceManifest{Namespaces: []NamespaceSpec{
+				{Name: "ns1", Policy: "OR('Org1MSP.member')"},
+				{Name: "ns1", Policy: "OR('Org2MSP.member')"},
+			}},
+			expectError: "invalid namespaces[1]: duplicate namespace ns1",
+		},
+		{
+			name:        "invalid name",
+			manifest:    NamespaceManifest{Namespaces: []NamespaceSpec{{Name: "", Policy: "OR('Org1MSP.member')"}}},
+			expectError: "invalid namespaces[0]: invalid namespaceID",
+		},
+		{
+			name:        "missing policy",
+			manifest:    NamespaceManifest{Namespaces: []NamespaceSpec{{Name: "ns1"}}},
+			expectError: "invalid namespaces[0]: invalid policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.manifest.Validate(fakeValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPlanNamespaces(t *testing.T) {
+	t.Parallel()
+
+	qc := installedNamespaces(t, map[string]string{
+		"updated":   "OR('Org1MSP.member')",
+		"unchanged": "OR('Org1MSP.member', 'Org2MSP.member')",
+		"unmanaged": "OR('Org3MSP.member')",
+	})
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(qc, nil),
+	}
+
+	plan, err := a.PlanNamespaces(t.Context(), someManifest())
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 3)
+
+	created := plan.Changes[0]
+	require.Equal(t, ApplyActionCreate, created.Action)
+	require.Equal(t, "created", created.NsID)
+	require.Equal(t, -1, created.Version)
+	require.Nil(t, created.Current)
+	require.Equal(t, "OR('Org1MSP.member')", created.Desired.Expression)
+
+	updated := plan.Changes[1]
+	require.Equal(t, ApplyActionUpdate, updated.Action)
+	require.Equal(t, 2, updated.Version)
+	require.Equal(t, "OR('Org1MSP.member')", updated.Current.Expression)
+	require.Equal(t, "AND('Org1MSP.member', 'Org2MSP.member')", updated.Desired.Expression)
+
+	require.Equal(t, ApplyActionUnchanged, plan.Changes[2].Action)
+
+	pending := plan.Pending()
+	require.Len(t, pending, 2)
+	require.Equal(t, "created", pending[0].NsID)
+	require.Equal(t, "updated", pending[1].NsID)
+}
+
+func TestPlanNamespaces_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(nil, errors.New("connection refused")),
+	}
+	_, err := a.PlanNamespaces(t.Context(), someManifest())
+	require.ErrorContains(t, err, "connection refused")
+
+	_, err = a.PlanNamespaces(t.Context(), &NamespaceManifest{})
+	require.ErrorContains(t, err, "manifest declares no namespaces")
+}
+
+func TestApplyNamespaces(t *testing.T) {
+	t.Parallel()
+
+	qc := installedNamespaces(t, map[string]string{"updated": "OR('Org1MSP.member')"})
+	nc := &mockNotificationClient{status: committerpb.Status_COMMITTED}
+	a := &AdminApp{
+		Validators:           fakeValidationContext(),
+		QueryProvider:        makeQueryProvider(qc, nil),
+		MspProvider:          makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider:      makeOrdererProvider(&mockOrdererClient{}, nil),
+		NotificationProvider: makeNotificationProvider(nc, nil),
+	}
+
+	manifest := &NamespaceManifest{Namespaces: someManifest().Namespaces[:2]}
+	plan, err := a.PlanNamespaces(t.Context(), manifest)
+	require.NoError(t, err)
+
+	results, err := a.ApplyNamespaces(t.Context(), plan, true)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, r := range results {
+		require.NoError(t, r.Err)
+		require.NotEmpty(t, r.TxID)
+		require.True(t, r.Status.Committed())
+	}
+}
+
+func TestApplyNamespaces_BuildsVersionedTransactions(t *testing.T) {
+	t.Parallel()
+
+	plan := &ApplyPlan{Changes: []NamespaceChange{
+		{Action: ApplyActionCreate, NsID: "ns1", Version: -1, spec: someSpec("ns1")},
+		{Action: ApplyActionUpdate, NsID: "ns2", Version: 4, spec: someSpec("ns2")},
+	}}
+
+	oc := &mockOrdererClient{}
+	a := &AdminApp{
+		MspProvider:     makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider: makeOrdererProvider(oc, nil),
+	}
+
+	results, err := a.ApplyNamespaces(t.Context(), plan, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Len(t, oc.sent, 2)
+
+	require.Nil(t, oc.sent[0].Tx.GetNamespaces()[0].GetReadWrites()[0].Version)
+	require.Equal(t, uint64(4), oc.sent[1].Tx.GetNamespaces()[0].GetReadWrites()[0].GetVersion())
+	for i, sent := range oc.sent {
+		require.Equal(t, results[i].TxID, sent.TxID)
+		require.Len(t, sent.Tx.GetEndorsements(), 1)
+	}
+}
+
+func TestApplyNamespaces_NothingPending(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{}
+	results, err := a.ApplyNamespaces(t.Context(),
+		&ApplyPlan{Changes: []NamespaceChange{{Action: ApplyActionUnchanged, NsID: "ns1"}}}, true)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestApplyNamespaces_EndorseError(t *testing.T) {
+	t.Parallel()
+
+	plan := &ApplyPlan{Changes: []NamespaceChange{
+		{Action: ApplyActionCreate, NsID: "ns1", Version: -1, spec: someSpec("ns1")},
+	}}
+	a := &AdminApp{MspProvider: makeMSPProvider(&testSigningIdentity{signErr: errors.New("hsm offline")}, nil)}
+
+	_, err := a.ApplyNamespaces(t.Context(), plan, false)
+	require.ErrorContains(t, err, "cannot endorse transaction for namespace ns1")
+	require.ErrorContains(t, err, "hsm offline")
+}
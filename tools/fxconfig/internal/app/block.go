@@ -0,0 +1,114 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// Block positions that are resolved against the committed ledger.
+const (
+	// BlockNewest is the latest committed block.
+	BlockNewest = "newest"
+	// BlockOldest is the genesis block.
+	BlockOldest = "oldest"
+	// BlockConfig is the latest config block, as recorded in the metadata of the newest block.
+	BlockConfig = "config"
+)
+
+// FetchBlock fetches a committed block from the deliver service of the committer. The position is
+// a block number or one of BlockNewest, BlockOldest, and BlockConfig.
+func (d *AdminApp) FetchBlock(ctx context.Context, position string) (*cb.Block, error) {
+	var number uint64
+	switch position {
+	case BlockNewest, BlockOldest, BlockConfig:
+	default:
+		var err error
+		number, err = strconv.ParseUint(position, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block position %q: want a block number, %s, %s, or %s",
+				position, BlockNewest, BlockOldest, BlockConfig)
+		}
+	}
+
+	// get block query service instance
+	bc, err := d.BlockQueryProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = bc.Close()
+	}()
+
+	info, err := bc.GetBlockchainInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot query ledger height: %w", err)
+	}
+	height := info.GetHeight()
+	if height == 0 {
+		return nil, errors.New("no block committed yet")
+	}
+
+	switch position {
+	case BlockNewest, BlockConfig:
+		number = height - 1
+	case BlockOldest:
+		number = 0
+	default:
+		if number >= height {
+			return nil, fmt.Errorf("block %d is not committed yet (height %d)", number, height)
+		}
+	}
+
+	block, err := deliverBlock(ctx, bc, number)
+	if err != nil || position != BlockConfig {
+		return block, err
+	}
+
+	lastConfig, err := protoutil.GetLastConfigIndexFromBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find the latest config block: %w", err)
+	}
+	if lastConfig == number {
+		return block, nil
+	}
+	return deliverBlock(ctx, bc, lastConfig)
+}
+
+// deliverBlock fetches a single committed block.
+func deliverBlock(ctx context.Context, bc adapters.BlockQueryClient, number uint64) (*cb.Block, error) {
+	var block *cb.Block
+	err := bc.DeliverBlocks(ctx, number, &number, func(b *cb.Block) error {
+		block = b
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch block %d: %w", number, err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("cannot fetch block %d: no block delivered", number)
+	}
+	return block, nil
+}
+
+// DescribeBlock decodes a committed block into a readable report.
+// It does not require any service connection.
+func (*AdminApp) DescribeBlock(_ context.Context, block *cb.Block) (*transaction.BlockReport, error) {
+	if block == nil {
+		return nil, errors.New("nil block")
+	}
+	return transaction.DescribeBlock(block), nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// someFetchBlocks returns blocks 0 to 4, where block 2 is the latest config block.
+func someFetchBlocks(t *testing.T) []*cb.Block {
+	t.Helper()
+
+	blocks := someEventBlocks(t)
+	blocks[4].Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = protoutil.MarshalOrPanic(&cb.Metadata{
+		Value: protoutil.MarshalOrPanic(&cb.OrdererBlockMetadata{LastConfig: &cb.LastConfig{Index: 2}}),
+	})
+	return blocks
+}
+
+func TestFetchBlock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		position    string
+		blocks      []*cb.Block
+		clientErr   error
+		expectBlock uint64
+		expectError string
+	}{
+		{name: "number", position: "3", expectBlock: 3},
+		{name: "newest", position: BlockNewest, expectBlock: 4},
+		{name: "oldest", position: BlockOldest, expectBlock: 0},
+		{name: "config", position: BlockConfig, expectBlock: 2},
+		{
+			name:        "config without orderer metadata",
+			position:    BlockConfig,
+			blocks:      someEventBlocks(t)[:4],
+			expectBlock: 0,
+		},
+		{
+			name:        "invalid position",
+			position:    "latest",
+			expectError: `invalid block position "latest": want a block number, newest, oldest, or config`,
+		},
+		{
+			name:        "not committed yet",
+			position:    "5",
+			expectError: "block 5 is not committed yet (height 5)",
+		},
+		{
+			name:        "empty ledger",
+			position:    BlockNewest,
+			blocks:      []*cb.Block{},
+			expectError: "no block committed yet",
+		},
+		{
+			name:        "client error",
+			position:    BlockOldest,
+			clientErr:   errors.New("unavailable"),
+			expectError: "cannot query ledger height: unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			blocks := tt.blocks
+			if blocks == nil {
+				blocks = someFetchBlocks(t)
+			}
+			a := &AdminApp{
+				BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: blocks, err: tt.clientErr}, nil),
+			}
+
+			block, err := a.FetchBlock(t.Context(), tt.position)
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectBlock, block.GetHeader().GetNumber())
+		})
+	}
+}
+
+func TestDescribeBlock(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{}
+	report, err := a.DescribeBlock(t.Context(), someEventBlocks(t)[4])
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), report.Number)
+	require.Len(t, report.Transactions, 2)
+	require.Equal(t, "tx-pay", report.Transactions[0].TxID)
+	require.Equal(t, "ABORTED_MVCC_CONFLICT", report.Transactions[1].Status)
+
+	_, err = a.DescribeBlock(t.Context(), nil)
+	require.Error(t, err)
+}
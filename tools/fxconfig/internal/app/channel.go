@@ -0,0 +1,145 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// ChannelConfig is the channel config of the latest config block.
+type ChannelConfig struct {
+	Channel  string
+	BlockNum uint64
+	Config   *cb.Config
+}
+
+// ConfigUpdateInput contains parameters for computing a channel config update.
+type ConfigUpdateInput struct {
+	// Channel is the channel of the update. If empty, the channel of the fetched config block is used.
+	Channel string
+	// Original is the channel config to update. If nil, the config of the latest config block is fetched.
+	Original *cb.Config
+	// Updated is the desired channel config.
+	Updated *cb.Config
+}
+
+// FetchChannelConfig fetches the latest config block from the committer and returns its channel config.
+func (d *AdminApp) FetchChannelConfig(ctx context.Context) (*ChannelConfig, error) {
+	block, err := d.FetchBlock(ctx, BlockConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, config, err := transaction.ConfigFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChannelConfig{Channel: channel, BlockNum: block.GetHeader().GetNumber(), Config: config}, nil
+}
+
+// ComputeConfigUpdate computes the unsigned config update that changes the original into the
+// updated channel config. Without an original config, the committed channel config is fetched.
+func (d *AdminApp) ComputeConfigUpdate(
+	ctx context.Context,
+	input *ConfigUpdateInput,
+) (*cb.ConfigUpdateEnvelope, error) {
+	if input.Updated == nil {
+		return nil, errors.New("missing updated config")
+	}
+
+	channel, original, err := d.originalConfig(ctx, input.Channel, input.Original)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.ComputeConfigUpdate(channel, original, input.Updated)
+}
+
+// originalConfig returns the channel and the channel config to update. Without an original
+// config, the committed channel config is fetched, and the channel defaults to its channel.
+func (d *AdminApp) originalConfig(
+	ctx context.Context,
+	channel string,
+	original *cb.Config,
+) (string, *cb.Config, error) {
+	if original != nil {
+		return channel, original, nil
+	}
+
+	current, err := d.FetchChannelConfig(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot fetch channel config: %w", err)
+	}
+	if channel == "" {
+		channel = current.Channel
+	}
+	return channel, current.Config, nil
+}
+
+// SignConfigUpdate adds the signature of the local MSP identity to a config update.
+// No service is contacted.
+func (d *AdminApp) SignConfigUpdate(
+	_ context.Context,
+	env *cb.ConfigUpdateEnvelope,
+) (*cb.ConfigUpdateEnvelope, error) {
+	sid, err := d.MspProvider.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing identity: %w", err)
+	}
+
+	return transaction.SignConfigUpdate(sid, env)
+}
+
+// MergeConfigUpdates combines the signatures of multiple envelopes of the same config update.
+// Useful for collecting signatures from multiple organizations.
+func (*AdminApp) MergeConfigUpdates(
+	_ context.Context,
+	envs []*cb.ConfigUpdateEnvelope,
+) (*cb.ConfigUpdateEnvelope, error) {
+	return transaction.MergeConfigUpdates(envs)
+}
+
+// SubmitConfigUpdate wraps a signed config update in a CONFIG_UPDATE envelope signed by the local
+// MSP identity and sends it to the ordering service. It returns the transaction ID of the envelope.
+func (d *AdminApp) SubmitConfigUpdate(ctx context.Context, env *cb.ConfigUpdateEnvelope) (string, error) {
+	channel, err := transaction.ConfigUpdateChannel(env)
+	if err != nil {
+		return "", err
+	}
+	if len(env.GetSignatures()) == 0 {
+		return "", errors.New("config update is not signed")
+	}
+
+	// get orderer client and signing identity
+	sc, err := d.prepareSubmission(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare submission: %w", err)
+	}
+	defer func() {
+		_ = sc.ordererClient.Close()
+	}()
+
+	txID, signed, err := transaction.CreateConfigUpdateEnvelope(sc.signingIdentity, channel, env)
+	if err != nil {
+		return "", err
+	}
+
+	errs := sc.ordererClient.BroadcastEnvelopeBatch(ctx, []adapters.SignedEnvelope{{TxID: txID, Envelope: signed}})
+	if err := errors.Join(errs...); err != nil {
+		return txID, fmt.Errorf("failed to broadcast config update: %w", err)
+	}
+
+	return txID, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction/transactiontest"
+)
+
+// someConfigBlocks returns a ledger with a genesis config block of mychannel with Org1MSP.
+func someConfigBlocks() []*cb.Block {
+	chdr := protoutil.MakeChannelHeader(cb.HeaderType_CONFIG, 0, "mychannel", 0)
+	payload := &cb.Payload{
+		Header: protoutil.MakePayloadHeader(chdr, &cb.SignatureHeader{}),
+		Data:   protoutil.MarshalOrPanic(&cb.ConfigEnvelope{Config: transactiontest.ChannelConfig("Org1MSP")}),
+	}
+	env := protoutil.MarshalOrPanic(&cb.Envelope{Payload: protoutil.MarshalOrPanic(payload)})
+	return []*cb.Block{someBlock(0, [][]byte{env})}
+}
+
+func TestFetchChannelConfig(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someConfigBlocks()}, nil)}
+
+	config, err := a.FetchChannelConfig(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", config.Channel)
+	require.Equal(t, uint64(0), config.BlockNum)
+	require.True(t, proto.Equal(transactiontest.ChannelConfig("Org1MSP"), config.Config))
+
+	a = &AdminApp{BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someEventBlocks(t)}, nil)}
+	_, err = a.FetchChannelConfig(t.Context())
+	require.ErrorContains(t, err, "invalid config block")
+}
+
+func TestComputeConfigUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         ConfigUpdateInput
+		expectChannel string
+		expectError   string
+	}{
+		{
+			name:          "fetch committed config",
+			input:         ConfigUpdateInput{Updated: transactiontest.ChannelConfig("Org1MSP", "Org2MSP")},
+			expectChannel: "mychannel",
+		},
+		{
+			name: "channel flag overrides fetched channel",
+			input: ConfigUpdateInput{
+				Channel: "otherchannel",
+				Updated: transactiontest.ChannelConfig("Org1MSP", "Org2MSP"),
+			},
+			expectChannel: "otherchannel",
+		},
+		{
+			name: "offline",
+			input: ConfigUpdateInput{
+				Channel:  "offlinechannel",
+				Original: transactiontest.ChannelConfig("Org3MSP"),
+				Updated:  transactiontest.ChannelConfig("Org1MSP", "Org2MSP"),
+			},
+			expectChannel: "offlinechannel",
+		},
+		{
+			name: "offline without channel",
+			input: ConfigUpdateInput{
+				Original: transactiontest.ChannelConfig(),
+				Updated:  transactiontest.ChannelConfig("Org1MSP"),
+			},
+			expectError: "missing channel",
+		},
+		{
+			name:        "no differences",
+			input:       ConfigUpdateInput{Updated: transactiontest.ChannelConfig("Org1MSP")},
+			expectError: "cannot compute config update: no differences detected between original and updated config",
+		},
+		{
+			name:        "missing updated config",
+			expectError: "missing updated config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &AdminApp{
+				BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someConfigBlocks()}, nil),
+			}
+
+			env, err := a.ComputeConfigUpdate(t.Context(), &tt.input)
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+
+			channel, err := transaction.ConfigUpdateChannel(env)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectChannel, channel)
+		})
+	}
+}
+
+func TestComputeConfigUpdate_FetchError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{err: errors.New("unavailable")}, nil),
+	}
+	_, err := a.ComputeConfigUpdate(t.Context(), &ConfigUpdateInput{Updated: transactiontest.ChannelConfig()})
+	require.EqualError(t, err, "cannot fetch channel config: cannot query ledger height: unavailable")
+}
+
+// someConfigUpdate returns an unsigned config update of mychannel that adds Org2MSP.
+func someConfigUpdate(t *testing.T) *cb.ConfigUpdateEnvelope {
+	t.Helper()
+
+	env, err := transaction.ComputeConfigUpdate("mychannel",
+		transactiontest.ChannelConfig("Org1MSP"), transactiontest.ChannelConfig("Org1MSP", "Org2MSP"))
+	require.NoError(t, err)
+	return env
+}
+
+func TestSignConfigUpdate(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{MspProvider: makeMSPProvider(&testSigningIdentity{}, nil)}
+	env, err := a.SignConfigUpdate(t.Context(), someConfigUpdate(t))
+	require.NoError(t, err)
+	require.Len(t, env.GetSignatures(), 1)
+	require.Equal(t, []byte("mock-sig"), env.GetSignatures()[0].GetSignature())
+
+	a = &AdminApp{MspProvider: makeMSPProvider(nil, errors.New("msp unavailable"))}
+	_, err = a.SignConfigUpdate(t.Context(), someConfigUpdate(t))
+	require.ErrorContains(t, err, "msp unavailable")
+}
+
+func TestMergeConfigUpdates(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{}
+	env := someConfigUpdate(t)
+	merged, err := a.MergeConfigUpdates(t.Context(), []*cb.ConfigUpdateEnvelope{env, env})
+	require.NoError(t, err)
+	require.Equal(t, env.GetConfigUpdate(), merged.GetConfigUpdate())
+
+	_, err = a.MergeConfigUpdates(t.Context(), []*cb.ConfigUpdateEnvelope{env})
+	require.Error(t, err)
+}
+
+func TestSubmitConfigUpdate(t *testing.T) {
+	t.Parallel()
+
+	signed := someConfigUpdate(t)
+	signed.Signatures = []*cb.ConfigSignature{{Signature: []byte("org1-sig")}}
+
+	tests := []struct {
+		name        string
+		env         *cb.ConfigUpdateEnvelope
+		oc          *mockOrdererClient
+		ocErr       error
+		expectError string
+	}{
+		{name: "submitted", env: signed, oc: &mockOrdererClient{}},
+		{
+			name:        "not signed",
+			env:         someConfigUpdate(t),
+			oc:          &mockOrdererClient{},
+			expectError: "config update is not signed",
+		},
+		{
+			name:        "invalid config update",
+			env:         &cb.ConfigUpdateEnvelope{ConfigUpdate: []byte("garbage")},
+			oc:          &mockOrdererClient{},
+			expectError: "invalid config update",
+		},
+		{
+			name:        "orderer unavailable",
+			env:         signed,
+			ocErr:       errors.New("orderer unavailable"),
+			expectError: "failed to prepare submission: failed to get orderer client: orderer unavailable",
+		},
+		{
+			name:        "rejected",
+			env:         signed,
+			oc:          &mockOrdererClient{broadcastErr: errors.New("BAD_REQUEST")},
+			expectError: "failed to broadcast config update: BAD_REQUEST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &AdminApp{
+				MspProvider:     makeMSPProvider(&testSigningIdentity{}, nil),
+				OrdererProvider: makeOrdererProvider(tt.oc, tt.ocErr),
+			}
+
+			txID, err := a.SubmitConfigUpdate(t.Context(), tt.env)
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, txID)
+			require.Len(t, tt.oc.sent, 1)
+			require.Equal(t, txID, tt.oc.sent[0].TxID)
+		})
+	}
+}
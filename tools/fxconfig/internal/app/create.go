@@ -36,14 +36,14 @@ func (*AdminApp) CreateNamespace(_ context.Context, input *DeployNamespaceInput)
 }
 
 // createPolicy creates a namespace policy from configuration.
-// Supports MSP-based and threshold ECDSA policies.
+// Supports MSP-based and threshold policies.
 func createPolicy(cfg PolicyConfig) (*applicationpb.NamespacePolicy, error) {
 	switch cfg.Type {
 	case mspPolicyType:
 		return transaction.CreateMspPolicy(cfg.MSP.Expression)
 
 	case thresholdPolicyType:
-		return transaction.CreateThresholdPolicy(cfg.Threshold.VerificationKeyPath)
+		return transaction.CreateThresholdPolicy(cfg.Threshold.Scheme, cfg.Threshold.VerificationKeyPath)
 
 	default:
 		return nil, fmt.Errorf("unknown policy type: %s", cfg.Type)
@@ -6,20 +6,29 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hyperledger/fabric-x-committer/service/verifier/policy"
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
 )
 
+// ErrNamespaceVersionChanged is returned if a namespace was updated between
+// the lookup of its version and the submission of the update.
+var ErrNamespaceVersionChanged = errors.New("namespace version changed")
+
 // DeployNamespaceInput contains parameters for namespace deployment.
 type DeployNamespaceInput struct {
 	NsID    string       `json:"name" yaml:"name"`
 	Version int          `json:"version" yaml:"version"`
 	Policy  PolicyConfig `json:"policy" yaml:"policy"`
 
+	// AutoVersion resolves Version from the current version of the installed namespace.
+	AutoVersion bool `json:"-" yaml:"-"`
+
 	Endorse bool
 	Submit  bool
 	Wait    bool
@@ -54,43 +63,88 @@ func (d *AdminApp) DeployNamespace(
 	ctx context.Context,
 	input *DeployNamespaceInput,
 ) (*DeployNamespaceOutput, TxStatus, error) {
+	// resolve the current namespace version
+	if input.AutoVersion {
+		version, err := d.namespaceVersion(ctx, input.NsID)
+		if err != nil {
+			return nil, TxStatus{}, err
+		}
+		input.Version = version
+	}
+
 	// input validation
 	if err := input.Validate(d.Validators); err != nil {
-		return nil, UnknownStatus, err
+		return nil, TxStatus{}, err
 	}
 
 	// create namespace tx
 	out, err := d.CreateNamespace(ctx, input)
 	if err != nil {
-		return nil, UnknownStatus, err
+		return nil, TxStatus{}, err
 	}
 
 	if !input.Endorse {
-		return out, UnknownStatus, nil
+		return out, TxStatus{}, nil
 	}
 
 	// Endorse transaction
 	out.Tx, err = d.EndorseTransaction(ctx, out.TxID, out.Tx)
 	if err != nil {
-		return nil, UnknownStatus, err
+		return nil, TxStatus{}, err
 	}
 
 	// note that we enforce submit if wait is set
 	if !input.Submit && !input.Wait {
-		return out, UnknownStatus, nil
+		return out, TxStatus{}, nil
+	}
+
+	// fail early if the namespace was updated since the version lookup
+	if input.AutoVersion {
+		if err := d.checkNamespaceVersion(ctx, input.NsID, input.Version); err != nil {
+			return nil, TxStatus{}, err
+		}
 	}
 
 	// submit transaction
 	if input.Wait {
 		status, err := d.SubmitTransactionWithWait(ctx, out.TxID, out.Tx)
 		if err != nil {
-			return nil, UnknownStatus, err
+			return nil, TxStatus{}, err
+		}
+		if input.AutoVersion && status.Status == committerpb.Status_ABORTED_MVCC_CONFLICT {
+			return nil, status, fmt.Errorf("%w: namespace %s was updated concurrently, version %d is outdated",
+				ErrNamespaceVersionChanged, input.NsID, input.Version)
 		}
 		return nil, status, nil
 	}
 	if err := d.SubmitTransaction(ctx, out.TxID, out.Tx); err != nil {
-		return nil, UnknownStatus, err
+		return nil, TxStatus{}, err
 	}
 
-	return nil, UnknownStatus, nil
+	return nil, TxStatus{}, nil
+}
+
+// namespaceVersion returns the current version of an installed namespace.
+func (d *AdminApp) namespaceVersion(ctx context.Context, nsID string) (int, error) {
+	ns, err := d.GetNamespace(ctx, nsID)
+	if errors.Is(err, ErrNamespaceNotFound) {
+		return 0, fmt.Errorf("cannot resolve version: %w (use 'namespace create' to install it)", err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("cannot resolve version: %w", err)
+	}
+	return ns.Version, nil
+}
+
+// checkNamespaceVersion returns ErrNamespaceVersionChanged if the namespace is no longer at the given version.
+func (d *AdminApp) checkNamespaceVersion(ctx context.Context, nsID string, version int) error {
+	current, err := d.namespaceVersion(ctx, nsID)
+	if err != nil {
+		return err
+	}
+	if current != version {
+		return fmt.Errorf("%w: namespace %s changed from version %d to %d since lookup",
+			ErrNamespaceVersionChanged, nsID, version, current)
+	}
+	return nil
 }
@@ -7,10 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
 )
 
 func TestDeployNamespaceInputValidate(t *testing.T) {
@@ -92,7 +97,7 @@ func TestDeployNamespace_CreateOnly(t *testing.T) {
 	require.NotNil(t, out)
 	require.NotEmpty(t, out.TxID)
 	require.NotNil(t, out.Tx)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestDeployNamespace_ValidationError(t *testing.T) {
@@ -134,7 +139,7 @@ func TestDeployNamespace_EndorseOnly(t *testing.T) {
 	require.NotEmpty(t, out.TxID)
 	require.NotNil(t, out.Tx)
 	require.NotEmpty(t, out.Tx.Endorsements, "endorsed tx should carry endorsements")
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestDeployNamespace_EndorseError(t *testing.T) {
@@ -167,7 +172,7 @@ func TestDeployNamespace_EndorseAndSubmit(t *testing.T) {
 	out, status, err := a.DeployNamespace(t.Context(), input)
 	require.NoError(t, err)
 	require.Nil(t, out)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestDeployNamespace_EndorseAndSubmitError(t *testing.T) {
@@ -190,7 +195,7 @@ func TestDeployNamespace_EndorseAndSubmitError(t *testing.T) {
 func TestDeployNamespace_EndorseAndSubmitWithWait(t *testing.T) {
 	t.Parallel()
 
-	const expectedStatus = 1
+	const expectedStatus = committerpb.Status_COMMITTED
 
 	a := &AdminApp{
 		Validators:           fakeValidationContext(),
@@ -206,7 +211,7 @@ func TestDeployNamespace_EndorseAndSubmitWithWait(t *testing.T) {
 	out, status, err := a.DeployNamespace(t.Context(), input)
 	require.NoError(t, err)
 	require.Nil(t, out)
-	require.Equal(t, expectedStatus, status)
+	require.Equal(t, expectedStatus, status.Status)
 }
 
 func TestDeployNamespace_EndorseAndSubmitWithWaitError(t *testing.T) {
@@ -226,3 +231,113 @@ func TestDeployNamespace_EndorseAndSubmitWithWaitError(t *testing.T) {
 	_, _, err := a.DeployNamespace(t.Context(), input)
 	require.Error(t, err)
 }
+
+// updatingQueryClient simulates a concurrent update by incrementing the version
+// of the returned namespace on every lookup.
+type updatingQueryClient struct {
+	*mockQueryClient
+
+	lookups uint64
+}
+
+func (m *updatingQueryClient) GetNamespacePolicy(ctx context.Context, nsID string) (*applicationpb.PolicyItem, error) {
+	item, err := m.mockQueryClient.GetNamespacePolicy(ctx, nsID)
+	if item == nil {
+		return item, err
+	}
+	item = proto.CloneOf(item)
+	item.Version += m.lookups
+	m.lookups++
+	return item, err
+}
+
+func autoVersionDeployInput() *DeployNamespaceInput {
+	input := validDeployInput()
+	input.Version = 0
+	input.AutoVersion = true
+	input.Endorse = true
+	return input
+}
+
+func TestDeployNamespace_AutoVersion(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		MspProvider:   makeMSPProvider(&testSigningIdentity{}, nil),
+		QueryProvider: makeQueryProvider(installedNamespaces(t, map[string]string{"testns": "OR('Org1MSP.member')"}), nil),
+	}
+	input := autoVersionDeployInput()
+
+	out, _, err := a.DeployNamespace(t.Context(), input)
+	require.NoError(t, err)
+	require.Equal(t, 2, input.Version)
+	require.Equal(t, uint64(2), out.Tx.GetNamespaces()[0].GetReadWrites()[0].GetVersion())
+}
+
+func TestDeployNamespace_AutoVersionNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(installedNamespaces(t, nil), nil),
+	}
+
+	_, _, err := a.DeployNamespace(t.Context(), autoVersionDeployInput())
+	require.ErrorIs(t, err, ErrNamespaceNotFound)
+	require.ErrorContains(t, err, "namespace create")
+}
+
+func TestDeployNamespace_AutoVersionQueryError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(nil, errors.New("query service unavailable")),
+	}
+
+	_, _, err := a.DeployNamespace(t.Context(), autoVersionDeployInput())
+	require.ErrorContains(t, err, "query service unavailable")
+}
+
+func TestDeployNamespace_AutoVersionChangedBeforeSubmit(t *testing.T) {
+	t.Parallel()
+
+	oc := &mockOrdererClient{broadcastErr: errors.New("transaction must not be submitted")}
+	qc := &updatingQueryClient{
+		mockQueryClient: installedNamespaces(t, map[string]string{"testns": "OR('Org1MSP.member')"}),
+	}
+	a := &AdminApp{
+		Validators:      fakeValidationContext(),
+		MspProvider:     makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider: makeOrdererProvider(oc, nil),
+		QueryProvider:   makeQueryProvider(qc, nil),
+	}
+	input := autoVersionDeployInput()
+	input.Submit = true
+
+	_, _, err := a.DeployNamespace(t.Context(), input)
+	require.ErrorIs(t, err, ErrNamespaceVersionChanged)
+	require.EqualError(t, err,
+		"namespace version changed: namespace testns changed from version 2 to 3 since lookup")
+}
+
+func TestDeployNamespace_AutoVersionMVCCConflict(t *testing.T) {
+	t.Parallel()
+
+	conflict := committerpb.Status_ABORTED_MVCC_CONFLICT
+	a := &AdminApp{
+		Validators:           fakeValidationContext(),
+		MspProvider:          makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider:      makeOrdererProvider(&mockOrdererClient{}, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{status: conflict}, nil),
+		QueryProvider: makeQueryProvider(
+			installedNamespaces(t, map[string]string{"testns": "OR('Org1MSP.member')"}), nil),
+	}
+	input := autoVersionDeployInput()
+	input.Wait = true
+
+	_, status, err := a.DeployNamespace(t.Context(), input)
+	require.ErrorIs(t, err, ErrNamespaceVersionChanged)
+	require.Equal(t, conflict, status.Status)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// NamespaceDiff compares the desired policy of a namespace with its committed policy.
+type NamespaceDiff struct {
+	NsID string `json:"name" yaml:"name"`
+	// Policy is the desired policy in the syntax of the --policy flag.
+	Policy string `json:"policy" yaml:"policy"`
+	// Exists reports whether the namespace is installed.
+	Exists bool `json:"exists" yaml:"exists"`
+	// Version is the committed version of the namespace, or -1 if it does not exist.
+	Version int `json:"version" yaml:"version"`
+	// Desired is the decoded desired policy.
+	Desired *transaction.PolicyDescription `json:"desired" yaml:"desired"`
+	// Diff compares the committed and the desired policy; nil if the namespace does not exist.
+	Diff *transaction.PolicyDiff `json:"diff,omitempty" yaml:"diff,omitempty"`
+}
+
+// DiffNamespaces compares the desired policies with the committed namespace policies.
+func (d *AdminApp) DiffNamespaces(ctx context.Context, specs []NamespaceSpec) ([]NamespaceDiff, error) {
+	for i, spec := range specs {
+		input := spec.deployInput(-1)
+		if err := input.Validate(d.Validators); err != nil {
+			return nil, fmt.Errorf("invalid namespaces[%d]: %w", i, err)
+		}
+	}
+
+	// get query service instance
+	qc, err := d.QueryProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = qc.Close()
+	}()
+
+	diffs := make([]NamespaceDiff, len(specs))
+	for i, spec := range specs {
+		input := spec.deployInput(-1)
+		desired, err := createPolicy(input.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create policy of namespace %s: %w", spec.Name, err)
+		}
+		desc, err := transaction.DescribeNamespacePolicy(desired)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode policy of namespace %s: %w", spec.Name, err)
+		}
+
+		item, err := qc.GetNamespacePolicy(ctx, spec.Name)
+		if err != nil {
+			return nil, fmt.Errorf("cannot query namespace: %w", err)
+		}
+
+		diffs[i] = NamespaceDiff{NsID: spec.Name, Policy: spec.Policy, Version: -1, Desired: desc}
+		if item == nil {
+			continue
+		}
+
+		var current applicationpb.NamespacePolicy
+		if err := proto.Unmarshal(item.GetPolicy(), &current); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal policy of namespace %s: %w", spec.Name, err)
+		}
+		diff, err := transaction.DiffPolicies(&current, desired)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compare policies of namespace %s: %w", spec.Name, err)
+		}
+
+		diffs[i].Exists = true
+		diffs[i].Version = int(item.GetVersion()) //nolint:gosec
+		diffs[i].Diff = diff
+	}
+
+	return diffs, nil
+}
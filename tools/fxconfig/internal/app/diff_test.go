@@ -0,0 +1,63 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffNamespaces(t *testing.T) {
+	t.Parallel()
+
+	qc := installedNamespaces(t, map[string]string{
+		"updated":   "OR('Org1MSP.member')",
+		"unchanged": "OR('Org2MSP.member', 'Org1MSP.member')",
+	})
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(qc, nil),
+	}
+
+	diffs, err := a.DiffNamespaces(t.Context(), someManifest().Namespaces)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+
+	created := diffs[0]
+	require.False(t, created.Exists)
+	require.Equal(t, -1, created.Version)
+	require.Nil(t, created.Diff)
+	require.Equal(t, "OR('Org1MSP.member')", created.Desired.Expression)
+
+	updated := diffs[1]
+	require.True(t, updated.Exists)
+	require.Equal(t, 2, updated.Version)
+	require.Equal(t, "AND('Org1MSP.member', 'Org2MSP.member')", updated.Policy)
+	require.Equal(t, []string{"'Org2MSP.member'"}, updated.Diff.AddedPrincipals)
+	require.Equal(t, []string{"policy: requires 1 of 1 -> 2 of 2", "policy: added 'Org2MSP.member'"},
+		updated.Diff.Changes)
+
+	unchanged := diffs[2]
+	require.True(t, unchanged.Exists)
+	require.True(t, unchanged.Diff.Empty())
+}
+
+func TestDiffNamespaces_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(&mockQueryClient{err: errors.New("unavailable")}, nil),
+	}
+	_, err := a.DiffNamespaces(t.Context(), someManifest().Namespaces)
+	require.ErrorContains(t, err, "unavailable")
+
+	_, err = a.DiffNamespaces(t.Context(), []NamespaceSpec{{Name: "ns1"}})
+	require.ErrorContains(t, err, "invalid namespaces[0]: invalid policy")
+}
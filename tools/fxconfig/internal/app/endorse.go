@@ -42,3 +42,21 @@ func (d *AdminApp) endorseTransaction(
 
 	return tx, nil
 }
+
+// ThresholdEndorseInput contains the transaction to endorse with the key of a threshold policy.
+type ThresholdEndorseInput struct {
+	TxID   string
+	Tx     *applicationpb.Tx
+	Signer transaction.ThresholdSigner
+	// Namespaces restricts the endorsed namespaces; all namespaces are endorsed if empty.
+	Namespaces []string
+}
+
+// EndorseTransactionWithKey endorses the namespaces of a transaction with the key of a threshold
+// policy. The MSP identity is not used.
+func (*AdminApp) EndorseTransactionWithKey(
+	_ context.Context,
+	input *ThresholdEndorseInput,
+) (*applicationpb.Tx, error) {
+	return transaction.EndorseThreshold(input.Signer, input.TxID, input.Tx, input.Namespaces)
+}
@@ -78,3 +78,28 @@ func TestEndorseTransaction_Success(t *testing.T) {
 	require.NotNil(t, result)
 	require.Len(t, result.Endorsements, 1)
 }
+
+// testThresholdSigner is a fixed-signature mock of transaction.ThresholdSigner.
+type testThresholdSigner struct{}
+
+func (testThresholdSigner) Scheme() string                      { return "ECDSA" }
+func (testThresholdSigner) SignDigest(_ []byte) ([]byte, error) { return []byte("threshold-sig"), nil }
+
+func TestEndorseTransactionWithKey(t *testing.T) {
+	t.Parallel()
+
+	// the MSP identity is not needed
+	a := &AdminApp{}
+	tx := &applicationpb.Tx{Namespaces: []*applicationpb.TxNamespace{{NsId: "payments"}, {NsId: "assets"}}}
+
+	endorsed, err := a.EndorseTransactionWithKey(t.Context(), &ThresholdEndorseInput{
+		TxID:       "tx-1",
+		Tx:         tx,
+		Signer:     testThresholdSigner{},
+		Namespaces: []string{"assets"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, endorsed.GetEndorsements()[0].GetEndorsementsWithIdentity())
+	require.Equal(t, []byte("threshold-sig"),
+		endorsed.GetEndorsements()[1].GetEndorsementsWithIdentity()[0].GetEndorsement())
+}
@@ -0,0 +1,65 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// SignEnvelope wraps a transaction in an envelope for the channel and signs it with the local
+// MSP identity, as the transaction would be signed on submission. No service is contacted.
+func (d *AdminApp) SignEnvelope(
+	_ context.Context,
+	channel string,
+	txID string,
+	tx *applicationpb.Tx,
+) (*cb.Envelope, error) {
+	if txID == "" {
+		return nil, errors.New("missing transaction ID")
+	}
+
+	sid, err := d.MspProvider.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signing identity: %w", err)
+	}
+
+	return transaction.CreateSignedEnvelope(sid, channel, txID, tx)
+}
+
+// BroadcastEnvelopes sends pre-signed envelopes to the ordering service over a single broadcast
+// stream. No signing identity is loaded. If wait is set, the final statuses of the transactions
+// are awaited as in SubmitTransactions.
+func (d *AdminApp) BroadcastEnvelopes(
+	ctx context.Context,
+	envs []adapters.SignedEnvelope,
+	wait bool,
+) ([]TxSubmissionResult, error) {
+	oc, err := d.OrdererProvider.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orderer client: %w", err)
+	}
+	defer func() {
+		_ = oc.Close()
+	}()
+
+	txIDs := make([]string, len(envs))
+	for i, e := range envs {
+		txIDs[i] = e.TxID
+	}
+
+	return d.broadcastBatch(ctx, txIDs, wait, func() []error {
+		return oc.BroadcastEnvelopeBatch(ctx, envs)
+	})
+}
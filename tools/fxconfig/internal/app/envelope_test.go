@@ -0,0 +1,84 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func TestSignEnvelope(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{MspProvider: makeMSPProvider(&testSigningIdentity{}, nil)}
+
+	env, err := a.SignEnvelope(t.Context(), "mychannel", "tx-1", someTx())
+	require.NoError(t, err)
+	require.Equal(t, []byte("mock-sig"), env.GetSignature())
+
+	txID, _, err := transaction.UnwrapEnvelope(protoutil.MarshalOrPanic(env))
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", txID)
+}
+
+func TestSignEnvelope_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{MspProvider: makeMSPProvider(&testSigningIdentity{}, nil)}
+	_, err := a.SignEnvelope(t.Context(), "mychannel", "", someTx())
+	require.EqualError(t, err, "missing transaction ID")
+
+	a = &AdminApp{MspProvider: makeMSPProvider(nil, errors.New("msp unavailable"))}
+	_, err = a.SignEnvelope(t.Context(), "mychannel", "tx-1", someTx())
+	require.ErrorContains(t, err, "msp unavailable")
+
+	a = &AdminApp{MspProvider: makeMSPProvider(&testSigningIdentity{signErr: errors.New("sign failed")}, nil)}
+	_, err = a.SignEnvelope(t.Context(), "mychannel", "tx-1", someTx())
+	require.ErrorContains(t, err, "sign failed")
+}
+
+func someSignedEnvelopes() []adapters.SignedEnvelope {
+	return []adapters.SignedEnvelope{{TxID: "tx-1"}, {TxID: "tx-2"}}
+}
+
+func TestBroadcastEnvelopes(t *testing.T) {
+	t.Parallel()
+
+	oc := &mockOrdererClient{batchErrs: map[string]error{"tx-2": errors.New("rejected")}}
+	// no MSP provider: pre-signed envelopes are sent without a signing identity
+	a := &AdminApp{
+		OrdererProvider: makeOrdererProvider(oc, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{
+			status: committerpb.Status_COMMITTED,
+		}, nil),
+	}
+
+	results, err := a.BroadcastEnvelopes(t.Context(), someSignedEnvelopes(), true)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "tx-1", results[0].TxID)
+	require.NoError(t, results[0].Err)
+	require.True(t, results[0].Status.Committed())
+	require.ErrorContains(t, results[1].Err, "rejected")
+	require.Len(t, oc.sent, 2)
+}
+
+func TestBroadcastEnvelopes_OrdererError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{OrdererProvider: makeOrdererProvider(nil, errors.New("orderer unavailable"))}
+
+	_, err := a.BroadcastEnvelopes(t.Context(), someSignedEnvelopes(), false)
+	require.ErrorContains(t, err, "orderer unavailable")
+}
@@ -0,0 +1,103 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// EventsInput selects the committed blocks to stream and the transactions to report.
+type EventsInput struct {
+	FromBlock uint64
+	// ToBlock is the last block to stream; nil follows new blocks until the context is done.
+	ToBlock *uint64
+	// Namespaces reports only transactions touching any of the namespaces; empty reports all.
+	Namespaces []string
+}
+
+// BlockEvents are the reported transactions of a committed block.
+type BlockEvents struct {
+	BlockNum uint64
+	Txs      []TxEvent
+}
+
+// TxEvent is a transaction of a committed block with its validation status.
+type TxEvent struct {
+	TxNum      uint32
+	TxID       string
+	HeaderType cb.HeaderType
+	// Namespaces are the namespaces read or written by an application transaction.
+	Namespaces []string
+	Status     committerpb.Status
+	// Err is set if the transaction could not be decoded.
+	Err error
+}
+
+// StreamEvents streams committed blocks from the committer and passes the decoded transactions of
+// every block to handle, in commit order. handle is called for every block, also if no transaction
+// of the block is reported, so that the progress can be recorded. Streaming stops without error
+// once the context is done, e.g., on interrupt, or with the error of handle.
+func (d *AdminApp) StreamEvents(ctx context.Context, input *EventsInput, handle func(*BlockEvents) error) error {
+	if input.ToBlock != nil && input.FromBlock > *input.ToBlock {
+		return fmt.Errorf("from block %d is after the to block %d", input.FromBlock, *input.ToBlock)
+	}
+
+	// get block query service instance
+	bc, err := d.BlockQueryProvider.Get()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = bc.Close()
+	}()
+
+	err = bc.DeliverBlocks(ctx, input.FromBlock, input.ToBlock, func(block *cb.Block) error {
+		return handle(blockEvents(block, input.Namespaces))
+	})
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot stream blocks: %w", err)
+	}
+
+	return nil
+}
+
+// blockEvents returns the transactions of a block that touch any of the namespaces.
+func blockEvents(block *cb.Block, namespaces []string) *BlockEvents {
+	events := &BlockEvents{BlockNum: block.GetHeader().GetNumber()}
+
+	for _, btx := range transaction.DecodeBlockTxs(block) {
+		e := TxEvent{
+			TxNum:      btx.TxNum,
+			TxID:       btx.TxID,
+			HeaderType: btx.HeaderType,
+			Status:     btx.Status,
+			Err:        btx.Err,
+		}
+		for _, ns := range btx.Tx.GetNamespaces() {
+			e.Namespaces = append(e.Namespaces, ns.GetNsId())
+		}
+
+		if len(namespaces) > 0 && !slices.ContainsFunc(e.Namespaces, func(ns string) bool {
+			return slices.Contains(namespaces, ns)
+		}) {
+			continue
+		}
+		events.Txs = append(events.Txs, e)
+	}
+
+	return events
+}
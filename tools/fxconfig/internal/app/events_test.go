@@ -0,0 +1,198 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// someAppEnvelope returns an envelope of an application transaction touching the given namespaces.
+func someAppEnvelope(txID string, namespaces ...string) []byte {
+	tx := &applicationpb.Tx{}
+	for _, ns := range namespaces {
+		tx.Namespaces = append(tx.Namespaces, &applicationpb.TxNamespace{NsId: ns})
+	}
+
+	chdr := protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, "mychannel", 0)
+	chdr.TxId = txID
+	payload := &cb.Payload{
+		Header: protoutil.MakePayloadHeader(chdr, &cb.SignatureHeader{}),
+		Data:   protoutil.MarshalOrPanic(tx),
+	}
+
+	return protoutil.MarshalOrPanic(&cb.Envelope{Payload: protoutil.MarshalOrPanic(payload)})
+}
+
+func someEventBlocks(t *testing.T) []*cb.Block {
+	t.Helper()
+
+	return append(someHistoryBlocks(t),
+		someBlock(4, [][]byte{
+			someAppEnvelope("tx-pay", "payments"),
+			someAppEnvelope("tx-swap", "payments", "assets"),
+		}, committerpb.Status_COMMITTED, committerpb.Status_ABORTED_MVCC_CONFLICT),
+	)
+}
+
+// txIDsByBlock returns the txIDs of the reported transactions of each block.
+func txIDsByBlock(events []*BlockEvents) map[uint64][]string {
+	ids := make(map[uint64][]string, len(events))
+	for _, e := range events {
+		ids[e.BlockNum] = []string{}
+		for _, tx := range e.Txs {
+			ids[e.BlockNum] = append(ids[e.BlockNum], tx.TxID)
+		}
+	}
+	return ids
+}
+
+func TestStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	from, to := uint64(3), uint64(4)
+
+	tests := []struct {
+		name        string
+		input       EventsInput
+		clientErr   error
+		handleErr   error
+		expectTxIDs map[uint64][]string
+		expectError string
+	}{
+		{
+			name:  "range",
+			input: EventsInput{FromBlock: from, ToBlock: &to},
+			expectTxIDs: map[uint64][]string{
+				3: {"", "tx-update"},
+				4: {"tx-pay", "tx-swap"},
+			},
+		},
+		{
+			name:  "namespace filter",
+			input: EventsInput{FromBlock: 1, ToBlock: &to, Namespaces: []string{"assets", "unknown"}},
+			expectTxIDs: map[uint64][]string{
+				1: {},
+				2: {},
+				3: {},
+				4: {"tx-swap"},
+			},
+		},
+		{
+			name:  "meta-namespace filter",
+			input: EventsInput{FromBlock: 1, ToBlock: &to, Namespaces: []string{committerpb.MetaNamespaceID}},
+			expectTxIDs: map[uint64][]string{
+				1: {"tx-other", "tx-create"},
+				2: {"tx-conflict"},
+				3: {"tx-update"},
+				4: {},
+			},
+		},
+		{
+			name:        "from after to",
+			input:       EventsInput{FromBlock: 5, ToBlock: &to},
+			expectError: "from block 5 is after the to block 4",
+		},
+		{
+			name:        "client error",
+			input:       EventsInput{ToBlock: &to},
+			clientErr:   errors.New("unavailable"),
+			expectError: "cannot stream blocks: unavailable",
+		},
+		{
+			name:        "handle error",
+			input:       EventsInput{FromBlock: from, ToBlock: &to},
+			handleErr:   errors.New("cannot write checkpoint"),
+			expectError: "cannot stream blocks: cannot write checkpoint",
+			expectTxIDs: map[uint64][]string{3: {"", "tx-update"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &AdminApp{
+				BlockQueryProvider: makeBlockQueryProvider(
+					&mockBlockQueryClient{blocks: someEventBlocks(t), err: tt.clientErr}, nil),
+			}
+
+			var events []*BlockEvents
+			err := a.StreamEvents(t.Context(), &tt.input, func(e *BlockEvents) error {
+				events = append(events, e)
+				return tt.handleErr
+			})
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectTxIDs != nil {
+				require.Equal(t, tt.expectTxIDs, txIDsByBlock(events))
+			}
+		})
+	}
+}
+
+func TestStreamEvents_Decoded(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someEventBlocks(t)}, nil),
+	}
+
+	var events []*BlockEvents
+	to := uint64(4)
+	err := a.StreamEvents(t.Context(), &EventsInput{FromBlock: 3, ToBlock: &to}, func(e *BlockEvents) error {
+		events = append(events, e)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	garbage := events[0].Txs[0]
+	require.Error(t, garbage.Err)
+	require.Equal(t, committerpb.Status_MALFORMED_BAD_ENVELOPE, garbage.Status)
+
+	swap := events[1].Txs[1]
+	require.NoError(t, swap.Err)
+	require.Equal(t, uint32(1), swap.TxNum)
+	require.Equal(t, cb.HeaderType_MESSAGE, swap.HeaderType)
+	require.Equal(t, []string{"payments", "assets"}, swap.Namespaces)
+	require.Equal(t, committerpb.Status_ABORTED_MVCC_CONFLICT, swap.Status)
+}
+
+func TestStreamEvents_Follow(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someEventBlocks(t)}, nil),
+	}
+
+	// without a to block, new blocks are streamed until the context is done
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var blocks []uint64
+	err := a.StreamEvents(ctx, &EventsInput{FromBlock: 2}, func(e *BlockEvents) error {
+		blocks = append(blocks, e.BlockNum)
+		if e.BlockNum == 4 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3, 4}, blocks)
+}
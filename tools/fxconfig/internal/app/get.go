@@ -0,0 +1,40 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNamespaceNotFound is returned if a namespace is not installed.
+var ErrNamespaceNotFound = errors.New("namespace not found")
+
+// GetNamespace queries the committer service for a single namespace.
+// It reads the namespace entry from the meta-namespace and decodes its policy.
+func (d *AdminApp) GetNamespace(ctx context.Context, nsID string) (*NamespaceQueryResult, error) {
+	// get query service instance
+	qc, err := d.QueryProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = qc.Close()
+	}()
+
+	item, err := qc.GetNamespacePolicy(ctx, nsID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot query namespace: %w", err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNamespaceNotFound, nsID)
+	}
+
+	r := newNamespaceQueryResult(item)
+	return &r, nil
+}
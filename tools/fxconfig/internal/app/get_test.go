@@ -0,0 +1,61 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func TestGetNamespace(t *testing.T) {
+	t.Parallel()
+
+	policy, err := transaction.CreateMspPolicy("OR('Org1MSP.member', 'Org2MSP.member')")
+	require.NoError(t, err)
+
+	policies := &applicationpb.NamespacePolicies{
+		Policies: []*applicationpb.PolicyItem{
+			{Namespace: "ns1", Version: 3, Policy: protoutil.MarshalOrPanic(policy)},
+			{Namespace: "broken", Version: 0, Policy: []byte("garbage")},
+		},
+	}
+	a := &AdminApp{
+		QueryProvider: makeQueryProvider(&mockQueryClient{policies: policies}, nil),
+	}
+
+	ns, err := a.GetNamespace(t.Context(), "ns1")
+	require.NoError(t, err)
+	require.Equal(t, "ns1", ns.NsID)
+	require.Equal(t, 3, ns.Version)
+	require.Equal(t, transaction.PolicyTypeMSP, ns.Policy.Type)
+	require.Equal(t, "OR('Org1MSP.member', 'Org2MSP.member')", ns.Policy.Expression)
+
+	_, err = a.GetNamespace(t.Context(), "missing")
+	require.ErrorIs(t, err, ErrNamespaceNotFound)
+
+	ns, err = a.GetNamespace(t.Context(), "broken")
+	require.NoError(t, err)
+	require.Nil(t, ns.Policy)
+	require.Contains(t, ns.PolicyError, "cannot unmarshal namespace policy")
+}
+
+func TestGetNamespace_QueryError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		QueryProvider: makeQueryProvider(&mockQueryClient{err: errors.New("unavailable")}, nil),
+	}
+
+	_, err := a.GetNamespace(t.Context(), "ns1")
+	require.ErrorContains(t, err, "unavailable")
+}
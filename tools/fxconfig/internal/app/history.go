@@ -0,0 +1,149 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// NamespaceHistoryInput selects the namespace and the range of blocks to scan.
+type NamespaceHistoryInput struct {
+	NsID      string
+	FromBlock uint64
+	// ToBlock is the last block to scan; nil scans up to the latest committed block.
+	ToBlock *uint64
+}
+
+// NamespacePolicyChange is a committed change of a namespace policy.
+// It records the version the namespace got, where and when the change was committed,
+// who submitted and endorsed it, and the new policy.
+type NamespacePolicyChange struct {
+	Version   int       `json:"version" yaml:"version"`
+	BlockNum  uint64    `json:"block" yaml:"block"`
+	TxNum     uint32    `json:"txNum" yaml:"txNum"`
+	TxID      string    `json:"txID" yaml:"txID"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Submitter string    `json:"submitter" yaml:"submitter"`
+	Endorsers []string  `json:"endorsers" yaml:"endorsers"`
+	// Policy is nil if the written policy cannot be decoded.
+	Policy *transaction.PolicyDescription `json:"policy" yaml:"policy"`
+}
+
+// NamespaceHistory scans committed blocks for changes of a namespace policy.
+// The blocks are streamed from the deliver service of the committer sidecar.
+// The meta-namespace only holds the current policy, hence all earlier versions are
+// reconstructed from the committed transactions writing the namespace entry.
+// Only transactions with status COMMITTED are reported, in commit order.
+func (d *AdminApp) NamespaceHistory(
+	ctx context.Context,
+	input *NamespaceHistoryInput,
+) ([]NamespacePolicyChange, error) {
+	// get block query service instance
+	bc, err := d.BlockQueryProvider.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = bc.Close()
+	}()
+
+	info, err := bc.GetBlockchainInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot query ledger height: %w", err)
+	}
+	if info.GetHeight() == 0 {
+		return nil, nil
+	}
+
+	last := info.GetHeight() - 1
+	if input.ToBlock != nil && *input.ToBlock < last {
+		last = *input.ToBlock
+	}
+	if input.FromBlock > last {
+		return nil, fmt.Errorf("from block %d is after the last block %d", input.FromBlock, last)
+	}
+
+	// stream the range in a single deliver request instead of fetching each block
+	var changes []NamespacePolicyChange
+	err = bc.DeliverBlocks(ctx, input.FromBlock, &last, func(block *cb.Block) error {
+		changes = append(changes, namespaceChanges(block, input.NsID)...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch blocks %d-%d: %w", input.FromBlock, last, err)
+	}
+
+	return changes, nil
+}
+
+// namespaceChanges returns the committed changes of the namespace policy within a block.
+func namespaceChanges(block *cb.Block, nsID string) []NamespacePolicyChange {
+	var changes []NamespacePolicyChange
+
+	for _, btx := range transaction.DecodeBlockTxs(block) {
+		if btx.Err != nil || btx.Tx == nil || btx.Status != committerpb.Status_COMMITTED {
+			continue
+		}
+
+		for nsIdx, ns := range btx.Tx.GetNamespaces() {
+			if ns.GetNsId() != committerpb.MetaNamespaceID {
+				continue
+			}
+
+			for _, rw := range ns.GetReadWrites() {
+				if string(rw.GetKey()) != nsID {
+					continue
+				}
+
+				change := NamespacePolicyChange{
+					Version:   0,
+					BlockNum:  block.GetHeader().GetNumber(),
+					TxNum:     btx.TxNum,
+					TxID:      btx.TxID,
+					Timestamp: btx.Timestamp,
+					Submitter: btx.Creator.GetMspId(),
+					Endorsers: endorserMSPIDs(btx.Tx, nsIdx),
+				}
+				// a write to an existing entry increments its version
+				if rw.Version != nil {
+					change.Version = int(rw.GetVersion()) + 1 //nolint:gosec
+				}
+				if policy, err := transaction.DescribePolicy(rw.GetValue()); err == nil {
+					change.Policy = policy
+				}
+
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	return changes
+}
+
+// endorserMSPIDs returns the distinct MSP IDs that endorsed the namespace at nsIdx, in endorsement order.
+func endorserMSPIDs(tx *applicationpb.Tx, nsIdx int) []string {
+	if nsIdx >= len(tx.GetEndorsements()) {
+		return nil
+	}
+
+	var ids []string
+	for _, e := range tx.GetEndorsements()[nsIdx].GetEndorsementsWithIdentity() {
+		if id := e.GetIdentity().GetMspId(); id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,239 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/provider"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+type mockBlockQueryClient struct {
+	blocks []*cb.Block
+	err    error
+	// deliverErr fails DeliverBlocks only.
+	deliverErr error
+	// fetched counts the blocks fetched one by one with GetBlockByNumber.
+	fetched int
+}
+
+func (m *mockBlockQueryClient) GetBlockchainInfo(_ context.Context) (*cb.BlockchainInfo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &cb.BlockchainInfo{Height: uint64(len(m.blocks))}, nil
+}
+
+func (m *mockBlockQueryClient) GetBlockByNumber(_ context.Context, number uint64) (*cb.Block, error) {
+	m.fetched++
+	if number >= uint64(len(m.blocks)) {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+	return m.blocks[number], nil
+}
+
+func (m *mockBlockQueryClient) DeliverBlocks(
+	ctx context.Context,
+	from uint64,
+	to *uint64,
+	deliver func(*cb.Block) error,
+) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.deliverErr != nil {
+		return m.deliverErr
+	}
+	for num := from; to == nil || num <= *to; num++ {
+		if num >= uint64(len(m.blocks)) {
+			// follow mode: block until the stream is canceled
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if err := deliver(m.blocks[num]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*mockBlockQueryClient) Close() error { return nil }
+
+func makeBlockQueryProvider(
+	client adapters.BlockQueryClient,
+	err error,
+) *provider.Provider[adapters.BlockQueryClient, *config.NotificationsConfig] {
+	cfg := &config.NotificationsConfig{
+		EndpointServiceConfig: config.EndpointServiceConfig{
+			Address:           "localhost:7050",
+			ConnectionTimeout: 30 * time.Second,
+		},
+		WaitingTimeout: 30 * time.Second,
+	}
+	return provider.New(func(_ *config.NotificationsConfig) (adapters.BlockQueryClient, error) {
+		return client, err
+	}, cfg, fakeValidationContext())
+}
+
+// someNamespaceEnvelope returns an envelope that deploys the namespace with the given MSP policy.
+func someNamespaceEnvelope(t *testing.T, txID, nsID string, version int, policy string, endorsers ...string) []byte {
+	t.Helper()
+
+	p, err := transaction.CreateMspPolicy(policy)
+	require.NoError(t, err)
+
+	tx := transaction.CreateNamespacesTx(p, nsID, version)
+	endorsements := &applicationpb.Endorsements{}
+	for _, mspID := range endorsers {
+		endorsements.EndorsementsWithIdentity = append(endorsements.EndorsementsWithIdentity,
+			&applicationpb.EndorsementWithIdentity{Identity: &msppb.Identity{MspId: mspID}})
+	}
+	tx.Endorsements = []*applicationpb.Endorsements{endorsements}
+
+	chdr := protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, "mychannel", 0)
+	chdr.TxId = txID
+	shdr := &cb.SignatureHeader{Creator: protoutil.MarshalOrPanic(&msppb.Identity{MspId: endorsers[0]})}
+	payload := &cb.Payload{
+		Header: protoutil.MakePayloadHeader(chdr, shdr),
+		Data:   protoutil.MarshalOrPanic(tx),
+	}
+
+	return protoutil.MarshalOrPanic(&cb.Envelope{Payload: protoutil.MarshalOrPanic(payload)})
+}
+
+func someBlock(number uint64, envs [][]byte, statuses ...committerpb.Status) *cb.Block {
+	block := protoutil.NewBlock(number, nil)
+	block.Data.Data = envs
+	filter := make([]byte, len(statuses))
+	for i, s := range statuses {
+		filter[i] = byte(s)
+	}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = filter
+	return block
+}
+
+func someHistoryBlocks(t *testing.T) []*cb.Block {
+	t.Helper()
+
+	return []*cb.Block{
+		someBlock(0, nil),
+		someBlock(1, [][]byte{
+			someNamespaceEnvelope(t, "tx-other", "other", -1, "OR('Org1MSP.member')", "Org1MSP"),
+			someNamespaceEnvelope(t, "tx-create", "ns1", -1, "OR('Org1MSP.member')", "Org1MSP"),
+		}, committerpb.Status_COMMITTED, committerpb.Status_COMMITTED),
+		someBlock(2, [][]byte{
+			someNamespaceEnvelope(t, "tx-conflict", "ns1", 0, "OR('Org3MSP.member')", "Org1MSP"),
+		}, committerpb.Status_ABORTED_MVCC_CONFLICT),
+		someBlock(3, [][]byte{
+			[]byte("garbage"),
+			someNamespaceEnvelope(t, "tx-update", "ns1", 0,
+				"AND('Org1MSP.member', 'Org2MSP.member')", "Org2MSP", "Org1MSP", "Org2MSP"),
+		}, committerpb.Status_MALFORMED_BAD_ENVELOPE, committerpb.Status_COMMITTED),
+	}
+}
+
+func TestNamespaceHistory(t *testing.T) {
+	t.Parallel()
+
+	bc := &mockBlockQueryClient{blocks: someHistoryBlocks(t)}
+	a := &AdminApp{BlockQueryProvider: makeBlockQueryProvider(bc, nil)}
+
+	changes, err := a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1"})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	// the blocks are streamed rather than fetched one by one
+	require.Zero(t, bc.fetched)
+
+	require.Equal(t, 0, changes[0].Version)
+	require.Equal(t, uint64(1), changes[0].BlockNum)
+	require.Equal(t, uint32(1), changes[0].TxNum)
+	require.Equal(t, "tx-create", changes[0].TxID)
+	require.Equal(t, "Org1MSP", changes[0].Submitter)
+	require.Equal(t, []string{"Org1MSP"}, changes[0].Endorsers)
+	require.False(t, changes[0].Timestamp.IsZero())
+	require.Equal(t, "msp OR('Org1MSP.member')", changes[0].Policy.String())
+
+	require.Equal(t, 1, changes[1].Version)
+	require.Equal(t, uint64(3), changes[1].BlockNum)
+	require.Equal(t, "tx-update", changes[1].TxID)
+	require.Equal(t, "Org2MSP", changes[1].Submitter)
+	require.Equal(t, []string{"Org2MSP", "Org1MSP"}, changes[1].Endorsers)
+	require.Equal(t, "msp AND('Org1MSP.member', 'Org2MSP.member')", changes[1].Policy.String())
+}
+
+func TestNamespaceHistory_BlockRange(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someHistoryBlocks(t)}, nil),
+	}
+
+	to := uint64(2)
+	changes, err := a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1", ToBlock: &to})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "tx-create", changes[0].TxID)
+
+	changes, err = a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1", FromBlock: 2})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "tx-update", changes[0].TxID)
+
+	_, err = a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1", FromBlock: 4})
+	require.ErrorContains(t, err, "from block 4 is after the last block 3")
+}
+
+func TestNamespaceHistory_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(nil, errors.New("connection refused")),
+	}
+	_, err := a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1"})
+	require.ErrorContains(t, err, "connection refused")
+
+	a = &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{err: errors.New("unavailable")}, nil),
+	}
+	_, err = a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1"})
+	require.ErrorContains(t, err, "cannot query ledger height")
+
+	a = &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{
+			blocks:     someHistoryBlocks(t),
+			deliverErr: errors.New("deliver error: unavailable"),
+		}, nil),
+	}
+	_, err = a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1"})
+	require.ErrorContains(t, err, "cannot fetch blocks 0-3: deliver error: unavailable")
+}
+
+func TestNamespaceHistory_EmptyLedger(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{}, nil),
+	}
+
+	changes, err := a.NamespaceHistory(t.Context(), &NamespaceHistoryInput{NsID: "ns1"})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
@@ -0,0 +1,24 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// InspectTransaction decodes a transaction into a readable report.
+// It does not require any service connection.
+func (*AdminApp) InspectTransaction(
+	_ context.Context,
+	txID string,
+	tx *applicationpb.Tx,
+) (*transaction.TxReport, error) {
+	return transaction.Inspect(txID, tx)
+}
@@ -0,0 +1,28 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectTransaction(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{}
+
+	report, err := a.InspectTransaction(t.Context(), "tx-1", someVerifyTx("payments"))
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", report.TxID)
+	require.Len(t, report.Namespaces, 1)
+	require.Equal(t, "payments", report.Namespaces[0].NsID)
+
+	_, err = a.InspectTransaction(t.Context(), "tx-1", nil)
+	require.Error(t, err)
+}
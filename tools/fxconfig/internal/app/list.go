@@ -9,11 +9,14 @@ package app
 import (
 	"context"
 	"fmt"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 // ListNamespaces queries the committer service for installed namespaces.
-// It connects to the query service, retrieves all namespace policies, and formats
-// the Output showing namespace names, versions, and policy data in hexadecimal.
+// It connects to the query service, retrieves all namespace policies, and decodes
+// each policy into a human-readable description.
 func (d *AdminApp) ListNamespaces(ctx context.Context) ([]NamespaceQueryResult, error) {
 	// get query service instance
 	qc, err := d.QueryProvider.Get()
@@ -31,42 +34,35 @@ func (d *AdminApp) ListNamespaces(ctx context.Context) ([]NamespaceQueryResult,
 
 	results := make([]NamespaceQueryResult, len(res.GetPolicies()))
 	for i, p := range res.GetPolicies() {
-		results[i] = NamespaceQueryResult{
-			NsID:    p.GetNamespace(),
-			Version: int(p.GetVersion()), //nolint:gosec
-			Policy:  p.GetPolicy(),
-		}
+		results[i] = newNamespaceQueryResult(p)
 	}
 
 	return results, nil
 }
 
 // NamespaceQueryResult represents a namespace retrieved from the query service.
+// A policy that cannot be decoded does not fail the query; instead, Policy is nil
+// and PolicyError describes the decoding failure.
 type NamespaceQueryResult struct {
-	NsID    string `json:"name" yaml:"name"`
-	Version int    `json:"version" yaml:"version"`
-	Policy  []byte `json:"policy" yaml:"policy"`
+	NsID        string                         `json:"name" yaml:"name"`
+	Version     int                            `json:"version" yaml:"version"`
+	Policy      *transaction.PolicyDescription `json:"policy,omitempty" yaml:"policy,omitempty"`
+	PolicyError string                         `json:"policyError,omitempty" yaml:"policyError,omitempty"`
 }
 
-// parsePolicy extracts and formats policy information from serialized bytes.
-// Returns base64-encoded public key for threshold policies or string representation for MSP policies.
-// func parsePolicy(b []byte) string {
-//	var p applicationpb.NamespacePolicy
-//	if err := proto.Unmarshal(b, &p); err != nil {
-//		panic(err)
-//	}
-//
-//	switch r := p.Rule.(type) {
-//	case *applicationpb.NamespacePolicy_ThresholdRule:
-//		return base64.StdEncoding.EncodeToString(r.ThresholdRule.GetPublicKey())
-//	case *applicationpb.NamespacePolicy_MspRule:
-//		var en common.SignaturePolicy
-//		if err := proto.Unmarshal(r.MspRule, &en); err != nil {
-//			panic(err)
-//		}
-//		// TODO: some pretty print would be beautiful
-//		return en.String()
-//	default:
-//		return "error parsing policy"
-//	}
-// }
+// newNamespaceQueryResult decodes a meta-namespace policy item.
+func newNamespaceQueryResult(item *applicationpb.PolicyItem) NamespaceQueryResult {
+	r := NamespaceQueryResult{
+		NsID:    item.GetNamespace(),
+		Version: int(item.GetVersion()), //nolint:gosec
+	}
+
+	policy, err := transaction.DescribePolicy(item.GetPolicy())
+	if err != nil {
+		r.PolicyError = err.Error()
+		return r
+	}
+	r.Policy = policy
+
+	return r
+}
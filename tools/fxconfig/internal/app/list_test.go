@@ -15,13 +15,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/provider"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 type mockQueryClient struct {
 	policies *applicationpb.NamespacePolicies
+	statuses []*committerpb.TxStatus
 	err      error
 }
 
@@ -29,6 +33,19 @@ func (m *mockQueryClient) GetNamespacePolicies(_ context.Context) (*applicationp
 	return m.policies, m.err
 }
 
+func (m *mockQueryClient) GetNamespacePolicy(_ context.Context, nsID string) (*applicationpb.PolicyItem, error) {
+	for _, item := range m.policies.GetPolicies() {
+		if item.GetNamespace() == nsID {
+			return item, m.err
+		}
+	}
+	return nil, m.err
+}
+
+func (m *mockQueryClient) GetTransactionStatus(_ context.Context, _ []string) ([]*committerpb.TxStatus, error) {
+	return m.statuses, m.err
+}
+
 func (*mockQueryClient) Close() error { return nil }
 
 func makeQueryProvider(
@@ -80,6 +97,45 @@ func TestListNamespaces_WithResults(t *testing.T) {
 	require.Equal(t, 2, results[1].Version)
 }
 
+func TestListNamespaces_DecodesPolicies(t *testing.T) {
+	t.Parallel()
+
+	mspPolicy, err := transaction.CreateMspPolicy("AND('Org1MSP.member', 'Org2MSP.member')")
+	require.NoError(t, err)
+	thresholdPolicy := &applicationpb.NamespacePolicy{
+		Rule: &applicationpb.NamespacePolicy_ThresholdRule{
+			ThresholdRule: &applicationpb.ThresholdRule{Scheme: "ECDSA", PublicKey: []byte("der-key")},
+		},
+	}
+
+	policies := &applicationpb.NamespacePolicies{
+		Policies: []*applicationpb.PolicyItem{
+			{Namespace: "msp", Version: 0, Policy: protoutil.MarshalOrPanic(mspPolicy)},
+			{Namespace: "threshold", Version: 1, Policy: protoutil.MarshalOrPanic(thresholdPolicy)},
+			{Namespace: "broken", Version: 2, Policy: []byte{0xff}},
+		},
+	}
+	a := &AdminApp{
+		QueryProvider: makeQueryProvider(&mockQueryClient{policies: policies}, nil),
+	}
+
+	results, err := a.ListNamespaces(t.Context())
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, transaction.PolicyTypeMSP, results[0].Policy.Type)
+	require.Equal(t, "AND('Org1MSP.member', 'Org2MSP.member')", results[0].Policy.Expression)
+	require.Empty(t, results[0].PolicyError)
+
+	require.Equal(t, transaction.PolicyTypeThreshold, results[1].Policy.Type)
+	require.Equal(t, "ECDSA", results[1].Policy.Scheme)
+	require.Contains(t, results[1].Policy.PublicKey, "BEGIN PUBLIC KEY")
+	require.Equal(t, transaction.KeyFingerprint([]byte("der-key")), results[1].Policy.Fingerprint)
+
+	require.Nil(t, results[2].Policy)
+	require.Contains(t, results[2].PolicyError, "cannot unmarshal namespace policy")
+}
+
 func TestListNamespaces_QueryError(t *testing.T) {
 	t.Parallel()
 
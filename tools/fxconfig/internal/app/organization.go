@@ -0,0 +1,190 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/common/channelconfig"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x-common/tools/configtxgen"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// endorsementPolicyKey is the name of the endorsement policy of application organizations.
+const endorsementPolicyKey = "Endorsement"
+
+// OrganizationDefinition defines an organization in the format of the organizations of a
+// configtx.yaml, e.g., as printed for configtxgen -printOrg.
+//
+// Example:
+//
+//	Name: Org3MSP
+//	ID: Org3MSP
+//	MSPDir: crypto-config/peerOrganizations/org3.example.com/msp
+//	AnchorPeers:
+//	  - peer0.org3.example.com:7051
+type OrganizationDefinition struct {
+	// Name is the key of the organization in the config groups; it defaults to ID.
+	Name string `json:"Name,omitempty" yaml:"Name,omitempty"`
+	// ID is the MSP ID of the organization.
+	ID string `json:"ID" yaml:"ID"`
+	// MSPDir is the MSP directory of the organization, e.g., as generated by cryptogen.
+	MSPDir string `json:"MSPDir" yaml:"MSPDir"`
+	// MSPType is the MSP type; it defaults to bccsp.
+	MSPType string `json:"MSPType,omitempty" yaml:"MSPType,omitempty"`
+	// Policies are the policies of the organization; the default policies are used if empty.
+	Policies map[string]*configtxgen.Policy `json:"Policies,omitempty" yaml:"Policies,omitempty"`
+	// AnchorPeers are the "host:port" anchor peers of an application organization.
+	AnchorPeers []string `json:"AnchorPeers,omitempty" yaml:"AnchorPeers,omitempty"`
+}
+
+// AddOrganizationInput contains parameters for adding an organization to the channel config.
+type AddOrganizationInput struct {
+	// Channel is the channel of the update. If empty, the channel of the fetched config block is used.
+	Channel string
+	// Original is the channel config to update. If nil, the config of the latest config block is fetched.
+	Original *cb.Config
+	// Org is the organization to add.
+	Org *OrganizationDefinition
+	// Application adds the organization to the application group.
+	Application bool
+	// Orderer adds the organization to the orderer group, which is not supported; see errOrdererParty.
+	Orderer bool
+}
+
+// errOrdererParty is returned when adding an organization to the orderer group. In Fabric-X, an
+// orderer party is not defined by an organization group with endpoints alone, but by the consenter
+// mapping of the orderer group, the shared config of the Arma consensus type, and the BlockValidation
+// policy, which have to be changed together.
+var errOrdererParty = errors.New("cannot add an organization to the orderer group: " +
+	"a Fabric-X party is defined by the consenters, the Arma shared config, and the BlockValidation " +
+	"policy of the orderer group; edit them with 'fxconfig channel config update'")
+
+// AddOrganization computes the unsigned config update that adds an organization to the application
+// group of the channel config. Without an original config, the committed channel config is fetched.
+func (d *AdminApp) AddOrganization(
+	ctx context.Context,
+	input *AddOrganizationInput,
+) (*cb.ConfigUpdateEnvelope, error) {
+	if input.Orderer {
+		return nil, errOrdererParty
+	}
+	if !input.Application {
+		return nil, errors.New("no config group selected: want application")
+	}
+	if input.Org == nil {
+		return nil, errors.New("missing organization")
+	}
+	org, err := input.Org.organization()
+	if err != nil {
+		return nil, fmt.Errorf("invalid organization: %w", err)
+	}
+
+	channel, original, err := d.originalConfig(ctx, input.Channel, input.Original)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := proto.CloneOf(original)
+	group, err := configtxgen.NewApplicationOrgGroup(applicationOrganization(org))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create application organization %s: %w", org.Name, err)
+	}
+	if err := addApplicationOrg(updated, org.Name, group); err != nil {
+		return nil, err
+	}
+
+	return transaction.ComputeConfigUpdate(channel, original, updated)
+}
+
+// organization returns the configtxgen organization with defaults applied.
+func (o *OrganizationDefinition) organization() (*configtxgen.Organization, error) {
+	if o.ID == "" {
+		return nil, errors.New("missing MSP ID")
+	}
+	if o.MSPDir == "" {
+		return nil, errors.New("missing MSP directory")
+	}
+
+	org := &configtxgen.Organization{
+		Name:     o.Name,
+		ID:       o.ID,
+		MSPDir:   o.MSPDir,
+		MSPType:  o.MSPType,
+		Policies: o.Policies,
+	}
+	if org.Name == "" {
+		org.Name = o.ID
+	}
+	if org.MSPType == "" {
+		org.MSPType = msp.ProviderTypeToString(msp.FABRIC)
+	}
+
+	for _, p := range o.AnchorPeers {
+		host, portStr, err := net.SplitHostPort(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor peer %q: %w", p, err)
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid anchor peer %q: invalid port", p)
+		}
+		org.AnchorPeers = append(org.AnchorPeers, &configtxgen.AnchorPeer{Host: host, Port: port})
+	}
+
+	return org, nil
+}
+
+// applicationOrganization returns the organization with the default policies of an application
+// organization if no policies are defined.
+func applicationOrganization(org *configtxgen.Organization) *configtxgen.Organization {
+	app := *org
+	if len(app.Policies) == 0 {
+		app.Policies = defaultOrgPolicies(org.ID)
+		app.Policies[endorsementPolicyKey] = signaturePolicy("OR('%s.member')", org.ID)
+	}
+	return &app
+}
+
+// defaultOrgPolicies returns the organization policies of the sample configtx.yaml: members read
+// and write, and admins administer.
+func defaultOrgPolicies(mspID string) map[string]*configtxgen.Policy {
+	return map[string]*configtxgen.Policy{
+		channelconfig.ReadersPolicyKey: signaturePolicy("OR('%s.member')", mspID),
+		channelconfig.WritersPolicyKey: signaturePolicy("OR('%s.member')", mspID),
+		channelconfig.AdminsPolicyKey:  signaturePolicy("OR('%s.admin')", mspID),
+	}
+}
+
+func signaturePolicy(format, mspID string) *configtxgen.Policy {
+	return &configtxgen.Policy{Type: configtxgen.SignaturePolicyType, Rule: fmt.Sprintf(format, mspID)}
+}
+
+// addApplicationOrg adds the organization group to the application group of the channel config.
+func addApplicationOrg(config *cb.Config, name string, org *cb.ConfigGroup) error {
+	groupKey := channelconfig.ApplicationGroupKey
+	group, ok := config.GetChannelGroup().GetGroups()[groupKey]
+	if !ok {
+		return fmt.Errorf("channel config has no %s group", groupKey)
+	}
+	if _, exists := group.GetGroups()[name]; exists {
+		return fmt.Errorf("organization %s already exists in the %s group", name, groupKey)
+	}
+	if group.Groups == nil {
+		group.Groups = make(map[string]*cb.ConfigGroup)
+	}
+	group.Groups[name] = org
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/common/channelconfig"
+	"github.com/hyperledger/fabric-x-common/common/configtx"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x-common/tools/configtxgen"
+)
+
+// testMSPDir is an MSP directory with the layout generated by cryptogen.
+const testMSPDir = "../msp/testdata/msp"
+
+// writeSetOrg returns the group of the organization in the write set of a config update.
+func writeSetOrg(t *testing.T, env *cb.ConfigUpdateEnvelope, groupKey, name string) *cb.ConfigGroup {
+	t.Helper()
+
+	cu, err := configtx.UnmarshalConfigUpdate(env.GetConfigUpdate())
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", cu.GetChannelId())
+	return cu.GetWriteSet().GetGroups()[groupKey].GetGroups()[name]
+}
+
+func TestAddOrganization(t *testing.T) {
+	t.Parallel()
+
+	org3 := &OrganizationDefinition{
+		ID:          "Org3MSP",
+		MSPDir:      testMSPDir,
+		AnchorPeers: []string{"peer0.org3.example.com:7051"},
+	}
+
+	a := &AdminApp{BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someConfigBlocks()}, nil)}
+
+	t.Run("application", func(t *testing.T) {
+		t.Parallel()
+
+		env, err := a.AddOrganization(t.Context(), &AddOrganizationInput{Org: org3, Application: true})
+		require.NoError(t, err)
+
+		org := writeSetOrg(t, env, channelconfig.ApplicationGroupKey, "Org3MSP")
+		require.NotNil(t, org)
+		require.Equal(t, channelconfig.AdminsPolicyKey, org.GetModPolicy())
+		require.ElementsMatch(t, []string{"Readers", "Writers", "Admins", "Endorsement"},
+			slices.Collect(maps.Keys(org.GetPolicies())))
+		require.Contains(t, org.GetValues(), channelconfig.MSPKey)
+		require.Contains(t, org.GetValues(), channelconfig.AnchorPeersKey)
+		require.NotContains(t, org.GetValues(), channelconfig.EndpointsKey)
+	})
+
+	t.Run("custom name and policies", func(t *testing.T) {
+		t.Parallel()
+
+		policies := map[string]*configtxgen.Policy{
+			"Readers": {Type: configtxgen.SignaturePolicyType, Rule: "OR('Org3MSP.member')"},
+			"Writers": {Type: configtxgen.SignaturePolicyType, Rule: "OR('Org3MSP.client')"},
+			"Admins":  {Type: configtxgen.SignaturePolicyType, Rule: "OR('Org3MSP.admin')"},
+		}
+		env, err := a.AddOrganization(t.Context(), &AddOrganizationInput{
+			Org:         &OrganizationDefinition{Name: "Org3", ID: "Org3MSP", MSPDir: testMSPDir, Policies: policies},
+			Application: true,
+		})
+		require.NoError(t, err)
+
+		org := writeSetOrg(t, env, channelconfig.ApplicationGroupKey, "Org3")
+		require.NotNil(t, org)
+		require.ElementsMatch(t, []string{"Readers", "Writers", "Admins"}, slices.Collect(maps.Keys(org.GetPolicies())))
+	})
+}
+
+func TestAddOrganization_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       AddOrganizationInput
+		expectError string
+	}{
+		{
+			name:        "no group",
+			input:       AddOrganizationInput{Org: &OrganizationDefinition{ID: "Org3MSP", MSPDir: testMSPDir}},
+			expectError: "no config group selected: want application",
+		},
+		{
+			name:        "missing organization",
+			input:       AddOrganizationInput{Application: true},
+			expectError: "missing organization",
+		},
+		{
+			name:        "missing MSP ID",
+			input:       AddOrganizationInput{Org: &OrganizationDefinition{MSPDir: testMSPDir}, Application: true},
+			expectError: "invalid organization: missing MSP ID",
+		},
+		{
+			name:        "missing MSP directory",
+			input:       AddOrganizationInput{Org: &OrganizationDefinition{ID: "Org3MSP"}, Application: true},
+			expectError: "invalid organization: missing MSP directory",
+		},
+		{
+			name: "invalid anchor peer",
+			input: AddOrganizationInput{
+				Org:         &OrganizationDefinition{ID: "Org3MSP", MSPDir: testMSPDir, AnchorPeers: []string{"peer0"}},
+				Application: true,
+			},
+			expectError: `invalid organization: invalid anchor peer "peer0"`,
+		},
+		{
+			// a Fabric-X party requires changes to the consenters and the Arma shared config
+			name: "orderer group",
+			input: AddOrganizationInput{
+				Org:         &OrganizationDefinition{ID: "Org3MSP", MSPDir: testMSPDir},
+				Application: true,
+				Orderer:     true,
+			},
+			expectError: "cannot add an organization to the orderer group",
+		},
+		{
+			name: "no application group",
+			input: AddOrganizationInput{
+				Original:    &cb.Config{ChannelGroup: protoutil.NewConfigGroup()},
+				Channel:     "mychannel",
+				Org:         &OrganizationDefinition{ID: "Org3MSP", MSPDir: testMSPDir},
+				Application: true,
+			},
+			expectError: "channel config has no Application group",
+		},
+		{
+			name: "existing organization",
+			input: AddOrganizationInput{
+				Org:         &OrganizationDefinition{ID: "Org1MSP", MSPDir: testMSPDir},
+				Application: true,
+			},
+			expectError: "organization Org1MSP already exists in the Application group",
+		},
+		{
+			name: "invalid MSP directory",
+			input: AddOrganizationInput{
+				Org:         &OrganizationDefinition{ID: "Org3MSP", MSPDir: t.TempDir()},
+				Application: true,
+			},
+			expectError: "cannot create application organization Org3MSP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &AdminApp{
+				BlockQueryProvider: makeBlockQueryProvider(&mockBlockQueryClient{blocks: someConfigBlocks()}, nil),
+			}
+			_, err := a.AddOrganization(t.Context(), &tt.input)
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
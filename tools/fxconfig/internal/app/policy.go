@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
 )
 
@@ -23,14 +24,16 @@ type PolicyConfig struct {
 }
 
 // Set parses and configures the policy from a string.
-// Supports "threshold:<path>" format or MSP DSL expressions.
+// Supports "threshold:[<scheme>:]<path>" format or MSP DSL expressions.
 func (c *PolicyConfig) Set(policy string) {
 	policy = strings.TrimSpace(policy)
 
 	if k, ok := strings.CutPrefix(policy, "threshold:"); ok {
+		scheme, path := transaction.SplitSchemePath(strings.TrimSpace(k))
 		c.Type = thresholdPolicyType
 		c.Threshold = &ThresholdPolicyConfig{
-			VerificationKeyPath: strings.TrimSpace(k),
+			Scheme:              scheme,
+			VerificationKeyPath: strings.TrimSpace(path),
 		}
 		return
 	}
@@ -45,8 +48,10 @@ type MSPPolicyConfig struct {
 	Expression string `mapstructure:"expression"`
 }
 
-// ThresholdPolicyConfig holds threshold ECDSA policy configuration.
+// ThresholdPolicyConfig holds threshold policy configuration.
+// The signature scheme is ECDSA, EDDSA, or BLS; ECDSA if empty.
 type ThresholdPolicyConfig struct {
+	Scheme              string `mapstructure:"scheme"`
 	VerificationKeyPath string `mapstructure:"verificationKeyPath"`
 }
 
@@ -75,8 +80,12 @@ func (c *PolicyConfig) Validate(ctx validation.Context) error {
 }
 
 // Validate validates threshold policy configuration.
-// Ensures the verification key path exists and is accessible.
+// Ensures the signature scheme is supported and the verification key path exists and is accessible.
 func (c *ThresholdPolicyConfig) Validate(vctx validation.Context) error {
+	if _, err := transaction.ParseThresholdScheme(c.Scheme); err != nil {
+		return fmt.Errorf("invalid threshold policy: %w", err)
+	}
+
 	if c.VerificationKeyPath == "" {
 		return errors.New("threshold policy key path must not be empty")
 	}
@@ -0,0 +1,183 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Roles of the principals of a policy template.
+var policyRoles = []string{"member", "admin", "peer", "client"}
+
+// PolicyDocument is a policy file read with --policy-file. It declares a single policy, or named
+// policies under "policies" that are selected by namespace name.
+//
+// Example:
+//
+//	policies:
+//	  payments:
+//	    majority-of: [Org1MSP, Org2MSP, Org3MSP]
+//	  audit:
+//	    all-of: [Org1MSP, Org2MSP]
+//	    role: admin
+type PolicyDocument struct {
+	PolicyTemplate `yaml:",inline"`
+
+	Policies map[string]PolicyTemplate `json:"policies,omitempty" yaml:"policies,omitempty"`
+}
+
+// PolicyTemplate declares an endorsement policy as a DSL expression, a threshold key, or a
+// template over a list of MSP IDs. Exactly one of them must be set.
+type PolicyTemplate struct {
+	// Expression is a policy DSL expression, e.g., "OR('Org1MSP.member')".
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
+	// Threshold is the "[<scheme>:]<path>" of the public key of a threshold policy.
+	Threshold string `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+
+	// AllOf requires an endorsement of every listed MSP.
+	AllOf []string `json:"all-of,omitempty" yaml:"all-of,omitempty"`
+	// AnyOf requires an endorsement of one of the listed MSPs.
+	AnyOf []string `json:"any-of,omitempty" yaml:"any-of,omitempty"`
+	// MajorityOf requires endorsements of more than half of the listed MSPs.
+	MajorityOf []string `json:"majority-of,omitempty" yaml:"majority-of,omitempty"`
+	// OutOf requires endorsements of N of the listed MSPs.
+	OutOf *OutOfTemplate `json:"out-of,omitempty" yaml:"out-of,omitempty"`
+
+	// Role is the role of the principals of a template: member (default), admin, peer, or client.
+	// A list entry such as "Org1MSP.admin" selects the role of a single principal.
+	Role string `json:"role,omitempty" yaml:"role,omitempty"`
+}
+
+// OutOfTemplate requires endorsements of N of the listed MSPs.
+type OutOfTemplate struct {
+	N  int      `json:"n" yaml:"n"`
+	Of []string `json:"of" yaml:"of"`
+}
+
+// Policy returns the policy for the namespace in the syntax of the --policy flag.
+// A document with named policies must declare a policy for the namespace.
+func (d *PolicyDocument) Policy(nsID string) (string, error) {
+	if len(d.Policies) == 0 {
+		return d.PolicyTemplate.Policy()
+	}
+
+	if !d.PolicyTemplate.isEmpty() {
+		return "", errors.New("policy document must declare either a policy or named policies")
+	}
+
+	t, ok := d.Policies[nsID]
+	if !ok {
+		return "", fmt.Errorf("policy document declares no policy for namespace %s", nsID)
+	}
+
+	p, err := t.Policy()
+	if err != nil {
+		return "", fmt.Errorf("invalid policy %s: %w", nsID, err)
+	}
+	return p, nil
+}
+
+// Policy returns the policy in the syntax of the --policy flag.
+// Templates are expanded to policy DSL expressions.
+func (t *PolicyTemplate) Policy() (string, error) {
+	var forms []string
+	for name, set := range map[string]bool{
+		"expression":  t.Expression != "",
+		"threshold":   t.Threshold != "",
+		"all-of":      len(t.AllOf) > 0,
+		"any-of":      len(t.AnyOf) > 0,
+		"majority-of": len(t.MajorityOf) > 0,
+		"out-of":      t.OutOf != nil,
+	} {
+		if set {
+			forms = append(forms, name)
+		}
+	}
+	slices.Sort(forms)
+
+	if len(forms) == 0 {
+		return "", errors.New("policy must declare one of expression, threshold, all-of, any-of, majority-of, out-of")
+	}
+	if len(forms) > 1 {
+		return "", fmt.Errorf("policy must declare only one of %s", strings.Join(forms, ", "))
+	}
+
+	if t.Role != "" && (t.Expression != "" || t.Threshold != "") {
+		return "", fmt.Errorf("role is not supported with %s", forms[0])
+	}
+
+	switch {
+	case t.Expression != "":
+		return t.Expression, nil
+	case t.Threshold != "":
+		return "threshold:" + t.Threshold, nil
+	case len(t.AllOf) > 0:
+		return t.expand("AND", 0, t.AllOf)
+	case len(t.AnyOf) > 0:
+		return t.expand("OR", 0, t.AnyOf)
+	case len(t.MajorityOf) > 0:
+		return t.expand("OutOf", len(t.MajorityOf)/2+1, t.MajorityOf)
+	default:
+		if t.OutOf.N < 1 || t.OutOf.N > len(t.OutOf.Of) {
+			return "", fmt.Errorf("out-of: n must be between 1 and the number of MSPs (%d)", len(t.OutOf.Of))
+		}
+		return t.expand("OutOf", t.OutOf.N, t.OutOf.Of)
+	}
+}
+
+// expand returns the DSL expression of the operator over the principals of the MSP IDs.
+// OutOf expressions are prefixed with the number n of required endorsements.
+func (t *PolicyTemplate) expand(op string, n int, mspIDs []string) (string, error) {
+	role := t.Role
+	if role == "" {
+		role = "member"
+	}
+	if !slices.Contains(policyRoles, role) {
+		return "", fmt.Errorf("unsupported role %q (want %s)", role, strings.Join(policyRoles, "|"))
+	}
+
+	args := make([]string, 0, len(mspIDs)+1)
+	if op == "OutOf" {
+		args = append(args, fmt.Sprint(n))
+	}
+
+	seen := make(map[string]struct{}, len(mspIDs))
+	for _, id := range mspIDs {
+		principal, err := templatePrincipal(strings.TrimSpace(id), role)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := seen[principal]; ok {
+			return "", fmt.Errorf("duplicate principal %s", principal)
+		}
+		seen[principal] = struct{}{}
+		args = append(args, "'"+principal+"'")
+	}
+
+	return fmt.Sprintf("%s(%s)", op, strings.Join(args, ", ")), nil
+}
+
+// templatePrincipal returns the principal "<mspID>.<role>" of a template list entry.
+// Entries of the form "<mspID>.<role>" keep their role.
+func templatePrincipal(entry, role string) (string, error) {
+	if i := strings.LastIndex(entry, "."); i >= 0 && slices.Contains(policyRoles, entry[i+1:]) {
+		entry, role = entry[:i], entry[i+1:]
+	}
+
+	if entry == "" || strings.ContainsAny(entry, "'\", ()") {
+		return "", fmt.Errorf("invalid MSP ID %q", entry)
+	}
+	return entry + "." + role, nil
+}
+
+func (t *PolicyTemplate) isEmpty() bool {
+	return t.Expression == "" && t.Threshold == "" && len(t.AllOf) == 0 && len(t.AnyOf) == 0 &&
+		len(t.MajorityOf) == 0 && t.OutOf == nil && t.Role == ""
+}
@@ -0,0 +1,149 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
+)
+
+func TestPolicyTemplate_Policy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		template     PolicyTemplate
+		expectPolicy string
+		expectError  string
+	}{
+		{
+			name:         "expression",
+			template:     PolicyTemplate{Expression: "OR('Org1MSP.member')"},
+			expectPolicy: "OR('Org1MSP.member')",
+		},
+		{
+			name:         "threshold",
+			template:     PolicyTemplate{Threshold: "bls:/keys/key.pem"},
+			expectPolicy: "threshold:bls:/keys/key.pem",
+		},
+		{
+			name:         "all-of",
+			template:     PolicyTemplate{AllOf: []string{"Org1MSP", "Org2MSP"}},
+			expectPolicy: "AND('Org1MSP.member', 'Org2MSP.member')",
+		},
+		{
+			name:         "any-of with role",
+			template:     PolicyTemplate{AnyOf: []string{"Org1MSP", "Org2MSP"}, Role: "peer"},
+			expectPolicy: "OR('Org1MSP.peer', 'Org2MSP.peer')",
+		},
+		{
+			name:         "majority-of odd",
+			template:     PolicyTemplate{MajorityOf: []string{"Org1MSP", "Org2MSP", "Org3MSP"}},
+			expectPolicy: "OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+		},
+		{
+			name:         "majority-of even",
+			template:     PolicyTemplate{MajorityOf: []string{"Org1MSP", "Org2MSP", "Org3MSP", "Org4MSP"}, Role: "admin"},
+			expectPolicy: "OutOf(3, 'Org1MSP.admin', 'Org2MSP.admin', 'Org3MSP.admin', 'Org4MSP.admin')",
+		},
+		{
+			name:         "out-of with principal role",
+			template:     PolicyTemplate{OutOf: &OutOfTemplate{N: 1, Of: []string{"Org1MSP.client", "Org2MSP"}}},
+			expectPolicy: "OutOf(1, 'Org1MSP.client', 'Org2MSP.member')",
+		},
+		{
+			name:         "single MSP",
+			template:     PolicyTemplate{AllOf: []string{"Org1MSP"}},
+			expectPolicy: "AND('Org1MSP.member')",
+		},
+		{
+			name:        "empty",
+			expectError: "policy must declare one of expression, threshold, all-of, any-of, majority-of, out-of",
+		},
+		{
+			name:        "several forms",
+			template:    PolicyTemplate{AllOf: []string{"Org1MSP"}, Expression: "OR('Org1MSP.member')"},
+			expectError: "policy must declare only one of all-of, expression",
+		},
+		{
+			name:        "role with expression",
+			template:    PolicyTemplate{Expression: "OR('Org1MSP.member')", Role: "admin"},
+			expectError: "role is not supported with expression",
+		},
+		{
+			name:        "unknown role",
+			template:    PolicyTemplate{AnyOf: []string{"Org1MSP"}, Role: "orderer"},
+			expectError: `unsupported role "orderer" (want member|admin|peer|client)`,
+		},
+		{
+			name:        "out-of n too large",
+			template:    PolicyTemplate{OutOf: &OutOfTemplate{N: 3, Of: []string{"Org1MSP", "Org2MSP"}}},
+			expectError: "out-of: n must be between 1 and the number of MSPs (2)",
+		},
+		{
+			name:        "duplicate MSP",
+			template:    PolicyTemplate{AnyOf: []string{"Org1MSP", "Org1MSP.member"}},
+			expectError: "duplicate principal Org1MSP.member",
+		},
+		{
+			name:        "invalid MSP ID",
+			template:    PolicyTemplate{AnyOf: []string{"Org1MSP')"}},
+			expectError: `invalid MSP ID "Org1MSP')"`,
+		},
+	}
+
+	checker := validation.PolicyDSLChecker{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := tt.template.Policy()
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectPolicy, policy)
+
+			// expanded templates are valid policy expressions
+			if tt.template.Threshold == "" {
+				require.NoError(t, checker.Check(policy))
+			}
+		})
+	}
+}
+
+func TestPolicyDocument_Policy(t *testing.T) {
+	t.Parallel()
+
+	named := PolicyDocument{Policies: map[string]PolicyTemplate{
+		"payments": {AnyOf: []string{"Org1MSP"}},
+		"invalid":  {},
+	}}
+
+	policy, err := named.Policy("payments")
+	require.NoError(t, err)
+	require.Equal(t, "OR('Org1MSP.member')", policy)
+
+	_, err = named.Policy("tokens")
+	require.EqualError(t, err, "policy document declares no policy for namespace tokens")
+
+	_, err = named.Policy("invalid")
+	require.ErrorContains(t, err, "invalid policy invalid: policy must declare one of")
+
+	single := PolicyDocument{PolicyTemplate: PolicyTemplate{AllOf: []string{"Org1MSP"}}}
+	policy, err = single.Policy("any")
+	require.NoError(t, err)
+	require.Equal(t, "AND('Org1MSP.member')", policy)
+
+	both := PolicyDocument{PolicyTemplate: single.PolicyTemplate, Policies: named.Policies}
+	_, err = both.Policy("payments")
+	require.EqualError(t, err, "policy document must declare either a policy or named policies")
+}
@@ -36,6 +36,24 @@ func TestValidatePolicy(t *testing.T) {
 			expectError: false,
 			description: "Valid policy path should pass",
 		},
+		{
+			name:        "policy path with scheme",
+			policy:      "threshold:eddsa:/path/to/policy.pem",
+			expectError: false,
+			description: "Policy path with a supported scheme should pass",
+		},
+		{
+			name:        "unsupported scheme",
+			policy:      "threshold:rsa:/path/to/policy.pem",
+			expectError: true,
+			description: "Policy path with an unsupported scheme should fail",
+		},
+		{
+			name:        "scheme without path",
+			policy:      "threshold:bls:",
+			expectError: true,
+			description: "Scheme without a policy path should fail",
+		},
 		{
 			name:        "whitespace-only policy path",
 			policy:      "   ",
@@ -67,6 +85,17 @@ func TestValidatePolicy(t *testing.T) {
 	}
 }
 
+func TestPolicyConfig_SetThresholdScheme(t *testing.T) {
+	t.Parallel()
+
+	var pc PolicyConfig
+	pc.Set("threshold:BLS:/path/to/policy.pem")
+	require.Equal(t, &ThresholdPolicyConfig{Scheme: "BLS", VerificationKeyPath: "/path/to/policy.pem"}, pc.Threshold)
+
+	pc.Set("threshold: /path/to/policy.pem")
+	require.Equal(t, &ThresholdPolicyConfig{VerificationKeyPath: "/path/to/policy.pem"}, pc.Threshold)
+}
+
 type FakePolicyChecker struct{}
 
 func (FakePolicyChecker) Check(_ string) error {
@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+)
+
+// TxStatusInput contains the parameters for querying the status of submitted transactions.
+type TxStatusInput struct {
+	TxIDs []string
+	// Wait waits for the final status of transactions that are not committed yet,
+	// bounded by the waiting timeout of the notification service.
+	Wait bool
+	// Timeout bounds the wait instead of the waiting timeout of the notification service, and may
+	// exceed it; transactions not finalized in time are reported as timed out. Zero waits up to the
+	// waiting timeout of the notification service.
+	Timeout time.Duration
+}
+
+// TransactionStatus queries the final status of already submitted transactions from the query
+// service. Transactions unknown to the query service are reported as not found or, with Wait,
+// awaited with the notification service. The subscriptions are made before querying, so that no
+// commit is missed in between. Results are returned in input order.
+func (d *AdminApp) TransactionStatus(ctx context.Context, input *TxStatusInput) ([]TxSubmissionResult, error) {
+	if input == nil || len(input.TxIDs) == 0 {
+		return nil, errors.New("no transaction IDs")
+	}
+
+	var (
+		nc            adapters.NotificationClient
+		subscriptions []chan TxStatus
+		err           error
+	)
+	if input.Wait {
+		nc, err = d.NotificationProvider.Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notification client: %w", err)
+		}
+		defer func() {
+			_ = nc.Close()
+		}()
+
+		subscriptions, err = nc.SubscribeBatch(ctx, input.TxIDs, input.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to subscribe to transaction events: %w", err)
+		}
+	}
+
+	qc, err := d.QueryProvider.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get query client: %w", err)
+	}
+	defer func() {
+		_ = qc.Close()
+	}()
+
+	statuses, err := qc.GetTransactionStatus(ctx, input.TxIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction status: %w", err)
+	}
+
+	committed := make(map[string]TxStatus, len(statuses))
+	for _, s := range statuses {
+		ref := s.GetRef()
+		committed[ref.GetTxId()] = TxStatus{
+			TxID:     ref.GetTxId(),
+			State:    adapters.TxStateFinal,
+			Status:   s.GetStatus(),
+			BlockNum: ref.GetBlockNum(),
+			TxNum:    ref.GetTxNum(),
+		}
+	}
+
+	results := make([]TxSubmissionResult, len(input.TxIDs))
+	var wg sync.WaitGroup
+	for i, txID := range input.TxIDs {
+		results[i].TxID = txID
+		if s, ok := committed[txID]; ok {
+			results[i].Status = s
+			continue
+		}
+
+		results[i].Status = TxStatus{TxID: txID, State: adapters.TxStateNotFound}
+		if !input.Wait {
+			continue
+		}
+		wg.Go(func() {
+			status, err := nc.WaitForEvent(ctx, subscriptions[i], input.Timeout)
+			if err != nil {
+				results[i].Err = fmt.Errorf("failed to wait for transaction status event: %w", err)
+				return
+			}
+			status.TxID = txID
+			results[i].Status = status
+		})
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+)
+
+func committedStatus(txID string, status committerpb.Status, blockNum uint64) *committerpb.TxStatus {
+	return &committerpb.TxStatus{Ref: &committerpb.TxRef{TxId: txID, BlockNum: blockNum, TxNum: 1}, Status: status}
+}
+
+func TestTransactionStatus_Query(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		QueryProvider: makeQueryProvider(&mockQueryClient{statuses: []*committerpb.TxStatus{
+			committedStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT, 8),
+			committedStatus("tx-1", committerpb.Status_COMMITTED, 7),
+		}}, nil),
+	}
+
+	results, err := a.TransactionStatus(t.Context(), &TxStatusInput{TxIDs: []string{"tx-1", "tx-2", "tx-3"}})
+	require.NoError(t, err)
+	require.Equal(t, []TxSubmissionResult{
+		{TxID: "tx-1", Status: TxStatus{
+			TxID: "tx-1", State: adapters.TxStateFinal, Status: committerpb.Status_COMMITTED, BlockNum: 7, TxNum: 1,
+		}},
+		{TxID: "tx-2", Status: TxStatus{
+			TxID: "tx-2", State: adapters.TxStateFinal, Status: committerpb.Status_ABORTED_MVCC_CONFLICT, BlockNum: 8, TxNum: 1,
+		}},
+		// not committed yet
+		{TxID: "tx-3", Status: TxStatus{TxID: "tx-3", State: adapters.TxStateNotFound}},
+	}, results)
+}
+
+func TestTransactionStatus_Wait(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		QueryProvider: makeQueryProvider(&mockQueryClient{statuses: []*committerpb.TxStatus{
+			committedStatus("tx-1", committerpb.Status_COMMITTED, 7),
+		}}, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{statuses: map[string]committerpb.Status{
+			"tx-2": committerpb.Status_ABORTED_SIGNATURE_INVALID,
+			"tx-3": committerpb.Status_COMMITTED,
+		}}, nil),
+	}
+
+	results, err := a.TransactionStatus(t.Context(), &TxStatusInput{TxIDs: []string{"tx-1", "tx-2", "tx-3"}, Wait: true})
+	require.NoError(t, err)
+	require.True(t, results[0].Status.Committed())
+	require.Equal(t, uint64(7), results[0].Status.BlockNum)
+	require.Equal(t, adapters.TxStateFinal, results[1].Status.State)
+	require.Equal(t, committerpb.Status_ABORTED_SIGNATURE_INVALID, results[1].Status.Status)
+	require.Equal(t, "tx-2", results[1].Status.TxID)
+	require.True(t, results[2].Status.Committed())
+}
+
+func TestTransactionStatus_WaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	nc := &mockNotificationClient{
+		status:  committerpb.Status_COMMITTED,
+		pending: []string{"tx-2"},
+	}
+	a := &AdminApp{
+		QueryProvider:        makeQueryProvider(&mockQueryClient{}, nil),
+		NotificationProvider: makeNotificationProvider(nc, nil),
+	}
+
+	results, err := a.TransactionStatus(t.Context(), &TxStatusInput{
+		TxIDs:   []string{"tx-1", "tx-2"},
+		Wait:    true,
+		Timeout: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	// the timeout is requested from the notification service
+	require.Equal(t, 10*time.Millisecond, nc.timeout)
+	require.True(t, results[0].Status.Committed())
+	require.NoError(t, results[1].Err)
+	require.Equal(t, TxStatus{TxID: "tx-2", State: adapters.TxStateTimedOut}, results[1].Status)
+}
+
+func TestTransactionStatus_WaitError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		QueryProvider:        makeQueryProvider(&mockQueryClient{}, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{waitErr: context.Canceled}, nil),
+	}
+
+	results, err := a.TransactionStatus(t.Context(), &TxStatusInput{TxIDs: []string{"tx-1"}, Wait: true})
+	require.NoError(t, err)
+	require.Equal(t, adapters.TxStateNotFound, results[0].Status.State)
+	require.ErrorIs(t, results[0].Err, context.Canceled)
+}
+
+func TestTransactionStatus_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		QueryProvider:        makeQueryProvider(&mockQueryClient{err: errors.New("unavailable")}, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{subscribeErr: errors.New("closed")}, nil),
+	}
+
+	_, err := a.TransactionStatus(t.Context(), &TxStatusInput{})
+	require.EqualError(t, err, "no transaction IDs")
+
+	_, err = a.TransactionStatus(t.Context(), &TxStatusInput{TxIDs: []string{"tx-1"}})
+	require.ErrorContains(t, err, "failed to query transaction status: unavailable")
+
+	_, err = a.TransactionStatus(t.Context(), &TxStatusInput{TxIDs: []string{"tx-1"}, Wait: true})
+	require.ErrorContains(t, err, "failed to subscribe to transaction events: closed")
+}
@@ -9,17 +9,16 @@ package app
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/msp"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 )
 
-// TxStatus represents the finality status of a submitted transaction.
-type TxStatus = int
-
-// UnknownStatus indicates transaction status is not yet determined.
-const UnknownStatus TxStatus = 0
+// TxStatus represents the finality status of a submitted transaction; the zero value indicates
+// that the status is not yet determined.
+type TxStatus = adapters.TxStatus
 
 // SubmitTransaction receives a transaction and sends it to the ordering service.
 func (d *AdminApp) SubmitTransaction(ctx context.Context, txID string, tx *applicationpb.Tx) error {
@@ -44,7 +43,7 @@ func (d *AdminApp) SubmitTransactionWithWait(ctx context.Context, txID string, t
 	// get orderer client and signing identity
 	sc, err := d.prepareSubmission(ctx)
 	if err != nil {
-		return UnknownStatus, fmt.Errorf("failed to prepare submission: %w", err)
+		return TxStatus{}, fmt.Errorf("failed to prepare submission: %w", err)
 	}
 	defer func() {
 		_ = sc.ordererClient.Close()
@@ -53,7 +52,7 @@ func (d *AdminApp) SubmitTransactionWithWait(ctx context.Context, txID string, t
 	// get notification client
 	nc, err := d.NotificationProvider.Get()
 	if err != nil {
-		return UnknownStatus, fmt.Errorf("failed to get notification client: %w", err)
+		return TxStatus{}, fmt.Errorf("failed to get notification client: %w", err)
 	}
 
 	defer func() {
@@ -62,21 +61,123 @@ func (d *AdminApp) SubmitTransactionWithWait(ctx context.Context, txID string, t
 
 	subscription, err := nc.Subscribe(ctx, txID)
 	if err != nil {
-		return UnknownStatus, fmt.Errorf("failed to subscribe to transaction events: %w", err)
+		return TxStatus{}, fmt.Errorf("failed to subscribe to transaction events: %w", err)
 	}
 
 	if err := sc.ordererClient.Broadcast(ctx, sc.signingIdentity, txID, tx); err != nil {
-		return UnknownStatus, fmt.Errorf("failed to broadcast transaction: %w", err)
+		return TxStatus{}, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
-	status, err := nc.WaitForEvent(ctx, subscription)
+	status, err := nc.WaitForEvent(ctx, subscription, 0)
 	if err != nil {
-		return UnknownStatus, fmt.Errorf("failed to wait for transaction status event: %w", err)
+		return TxStatus{}, fmt.Errorf("failed to wait for transaction status event: %w", err)
 	}
+	status.TxID = txID
 
 	return status, nil
 }
 
+// TxSubmissionResult reports the outcome of a single transaction within a batch submission.
+type TxSubmissionResult struct {
+	TxID   string
+	Status TxStatus
+	Err    error
+}
+
+// SubmitTransactions sends a batch of transactions to the ordering service over a single
+// broadcast stream. If wait is set, all transactions are subscribed with one notification
+// request before broadcasting and their final statuses are awaited concurrently.
+// Per-transaction failures are reported in the results; the returned error is reserved
+// for failures that affect the whole batch.
+func (d *AdminApp) SubmitTransactions(
+	ctx context.Context,
+	txs []adapters.Transaction,
+	wait bool,
+) ([]TxSubmissionResult, error) {
+	// get orderer client and signing identity
+	sc, err := d.prepareSubmission(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare submission: %w", err)
+	}
+	defer func() {
+		_ = sc.ordererClient.Close()
+	}()
+
+	txIDs := make([]string, len(txs))
+	for i, t := range txs {
+		txIDs[i] = t.TxID
+	}
+
+	return d.broadcastBatch(ctx, txIDs, wait, func() []error {
+		return sc.ordererClient.BroadcastBatch(ctx, sc.signingIdentity, txs)
+	})
+}
+
+// broadcastBatch invokes broadcast, which sends the transactions with the given IDs and returns
+// one error per transaction. If wait is set, all transactions are subscribed with one notification
+// request before broadcasting and their final statuses are awaited concurrently.
+func (d *AdminApp) broadcastBatch(
+	ctx context.Context,
+	txIDs []string,
+	wait bool,
+	broadcast func() []error,
+) ([]TxSubmissionResult, error) {
+	results := make([]TxSubmissionResult, len(txIDs))
+	for i, txID := range txIDs {
+		results[i].TxID = txID
+	}
+
+	var (
+		nc            adapters.NotificationClient
+		subscriptions []chan TxStatus
+		err           error
+	)
+	if wait {
+		// get notification client
+		nc, err = d.NotificationProvider.Get()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notification client: %w", err)
+		}
+		defer func() {
+			_ = nc.Close()
+		}()
+
+		subscriptions, err = nc.SubscribeBatch(ctx, txIDs, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to subscribe to transaction events: %w", err)
+		}
+	}
+
+	for i, err := range broadcast() {
+		if err != nil {
+			results[i].Err = fmt.Errorf("failed to broadcast transaction: %w", err)
+		}
+	}
+
+	if !wait {
+		return results, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := range results {
+		if results[i].Err != nil {
+			continue
+		}
+		wg.Go(func() {
+			status, err := nc.WaitForEvent(ctx, subscriptions[i], 0)
+			if err != nil {
+				results[i].Err = fmt.Errorf("failed to wait for transaction status event: %w", err)
+				return
+			}
+			status.TxID = results[i].TxID
+			results[i].Status = status
+		})
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
 type submissionContext struct {
 	signingIdentity msp.SigningIdentity
 	ordererClient   adapters.OrdererClient
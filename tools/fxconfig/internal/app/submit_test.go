@@ -9,12 +9,14 @@ package app
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
 	"github.com/hyperledger/fabric-x-common/msp"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
@@ -23,34 +25,111 @@ import (
 
 type mockOrdererClient struct {
 	broadcastErr error
+	// batchErrs is keyed by txID and overrides broadcastErr for batch submissions.
+	batchErrs map[string]error
+	// sent records the transactions of batch submissions.
+	sent []adapters.Transaction
 }
 
 func (m *mockOrdererClient) Broadcast(_ context.Context, _ msp.SigningIdentity, _ string, _ *applicationpb.Tx) error {
 	return m.broadcastErr
 }
 
+func (m *mockOrdererClient) BroadcastBatch(
+	_ context.Context,
+	_ msp.SigningIdentity,
+	txs []adapters.Transaction,
+) []error {
+	m.sent = append(m.sent, txs...)
+	errs := make([]error, len(txs))
+	for i, t := range txs {
+		errs[i] = m.broadcastErr
+		if err, ok := m.batchErrs[t.TxID]; ok {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+func (m *mockOrdererClient) BroadcastEnvelopeBatch(_ context.Context, envs []adapters.SignedEnvelope) []error {
+	errs := make([]error, len(envs))
+	for i, e := range envs {
+		m.sent = append(m.sent, adapters.Transaction{TxID: e.TxID})
+		errs[i] = m.broadcastErr
+		if err, ok := m.batchErrs[e.TxID]; ok {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
 func (*mockOrdererClient) Close() error { return nil }
 
 type mockNotificationClient struct {
 	subscribeErr error
 	waitErr      error
-	status       int
+	status       committerpb.Status
+	// statuses is keyed by txID and overrides status for batch subscriptions.
+	statuses map[string]committerpb.Status
+	// pending lists txIDs of batch subscriptions that never receive a status.
+	pending []string
+	// timeout records the waiting timeout requested by SubscribeBatch.
+	timeout time.Duration
 }
 
-func (m *mockNotificationClient) Subscribe(_ context.Context, _ string) (chan int, error) {
+func (m *mockNotificationClient) Subscribe(_ context.Context, _ string) (chan TxStatus, error) {
 	if m.subscribeErr != nil {
 		return nil, m.subscribeErr
 	}
-	ch := make(chan int, 1)
-	ch <- m.status
+	ch := make(chan TxStatus, 1)
+	ch <- TxStatus{State: adapters.TxStateFinal, Status: m.status}
 	return ch, nil
 }
 
-func (m *mockNotificationClient) WaitForEvent(_ context.Context, ch chan int) (int, error) {
+func (m *mockNotificationClient) SubscribeBatch(
+	_ context.Context,
+	txIDs []string,
+	timeout time.Duration,
+) ([]chan TxStatus, error) {
+	if m.subscribeErr != nil {
+		return nil, m.subscribeErr
+	}
+	m.timeout = timeout
+	chs := make([]chan TxStatus, len(txIDs))
+	for i, txID := range txIDs {
+		chs[i] = make(chan TxStatus, 1)
+		if slices.Contains(m.pending, txID) {
+			continue
+		}
+		status := m.status
+		if s, ok := m.statuses[txID]; ok {
+			status = s
+		}
+		chs[i] <- TxStatus{State: adapters.TxStateFinal, Status: status}
+	}
+	return chs, nil
+}
+
+func (m *mockNotificationClient) WaitForEvent(
+	ctx context.Context,
+	ch chan TxStatus,
+	timeout time.Duration,
+) (TxStatus, error) {
 	if m.waitErr != nil {
-		return 0, m.waitErr
+		return TxStatus{}, m.waitErr
+	}
+	var expired <-chan time.Time
+	if timeout > 0 {
+		expired = time.After(timeout)
+	}
+	select {
+	case <-ctx.Done():
+		return TxStatus{}, ctx.Err()
+	case <-expired:
+		return TxStatus{State: adapters.TxStateTimedOut}, nil
+	case status := <-ch:
+		return status, nil
 	}
-	return <-ch, nil
 }
 
 func (*mockNotificationClient) Close() error { return nil }
@@ -182,7 +261,7 @@ func TestSubmitTransactionWithWait_NotificationProviderError(t *testing.T) {
 
 	status, err := a.SubmitTransactionWithWait(t.Context(), "tx-1", someTx())
 	require.Error(t, err)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestSubmitTransactionWithWait_SubscribeError(t *testing.T) {
@@ -198,7 +277,7 @@ func TestSubmitTransactionWithWait_SubscribeError(t *testing.T) {
 
 	status, err := a.SubmitTransactionWithWait(t.Context(), "tx-1", someTx())
 	require.Error(t, err)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestSubmitTransactionWithWait_BroadcastError(t *testing.T) {
@@ -214,7 +293,7 @@ func TestSubmitTransactionWithWait_BroadcastError(t *testing.T) {
 
 	status, err := a.SubmitTransactionWithWait(t.Context(), "tx-1", someTx())
 	require.Error(t, err)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestSubmitTransactionWithWait_WaitForEventError(t *testing.T) {
@@ -230,13 +309,13 @@ func TestSubmitTransactionWithWait_WaitForEventError(t *testing.T) {
 
 	status, err := a.SubmitTransactionWithWait(t.Context(), "tx-1", someTx())
 	require.Error(t, err)
-	require.Equal(t, UnknownStatus, status)
+	require.Equal(t, TxStatus{}, status)
 }
 
 func TestSubmitTransactionWithWait_Success(t *testing.T) {
 	t.Parallel()
 
-	const expectedStatus = 42
+	const expectedStatus = committerpb.Status_ABORTED_SIGNATURE_INVALID
 
 	a := &AdminApp{
 		MspProvider:          makeMSPProvider(&testSigningIdentity{}, nil),
@@ -246,5 +325,87 @@ func TestSubmitTransactionWithWait_Success(t *testing.T) {
 
 	status, err := a.SubmitTransactionWithWait(t.Context(), "tx-1", someTx())
 	require.NoError(t, err)
-	require.Equal(t, expectedStatus, status)
+	require.Equal(t, TxStatus{TxID: "tx-1", State: adapters.TxStateFinal, Status: expectedStatus}, status)
+}
+
+// SubmitTransactions tests
+
+func someBatch() []adapters.Transaction {
+	return []adapters.Transaction{
+		{TxID: "tx-1", Tx: someTx()},
+		{TxID: "tx-2", Tx: someTx()},
+		{TxID: "tx-3", Tx: someTx()},
+	}
+}
+
+func TestSubmitTransactions_PrepareError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		MspProvider:     makeMSPProvider(nil, errors.New("msp unavailable")),
+		OrdererProvider: makeOrdererProvider(&mockOrdererClient{}, nil),
+	}
+
+	_, err := a.SubmitTransactions(t.Context(), someBatch(), false)
+	require.Error(t, err)
+}
+
+func TestSubmitTransactions_NoWait(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		MspProvider: makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider: makeOrdererProvider(&mockOrdererClient{
+			batchErrs: map[string]error{"tx-2": errors.New("rejected")},
+		}, nil),
+	}
+
+	results, err := a.SubmitTransactions(t.Context(), someBatch(), false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, "tx-1", results[0].TxID)
+	require.NoError(t, results[0].Err)
+	require.ErrorContains(t, results[1].Err, "rejected")
+	require.NoError(t, results[2].Err)
+}
+
+func TestSubmitTransactions_WaitSubscribeError(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		MspProvider:     makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider: makeOrdererProvider(&mockOrdererClient{}, nil),
+		NotificationProvider: makeNotificationProvider(
+			&mockNotificationClient{subscribeErr: errors.New("subscribe failed")}, nil,
+		),
+	}
+
+	_, err := a.SubmitTransactions(t.Context(), someBatch(), true)
+	require.ErrorContains(t, err, "subscribe failed")
+}
+
+func TestSubmitTransactions_Wait(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		MspProvider: makeMSPProvider(&testSigningIdentity{}, nil),
+		OrdererProvider: makeOrdererProvider(&mockOrdererClient{
+			batchErrs: map[string]error{"tx-3": errors.New("rejected")},
+		}, nil),
+		NotificationProvider: makeNotificationProvider(&mockNotificationClient{
+			status:   committerpb.Status_COMMITTED,
+			statuses: map[string]committerpb.Status{"tx-2": committerpb.Status_ABORTED_MVCC_CONFLICT},
+		}, nil),
+	}
+
+	results, err := a.SubmitTransactions(t.Context(), someBatch(), true)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	require.True(t, results[0].Status.Committed())
+	require.Equal(t, "tx-1", results[0].Status.TxID)
+	require.NoError(t, results[1].Err)
+	require.Equal(t, committerpb.Status_ABORTED_MVCC_CONFLICT, results[1].Status.Status)
+	require.ErrorContains(t, results[2].Err, "failed to broadcast")
+	require.Equal(t, TxStatus{}, results[2].Status)
 }
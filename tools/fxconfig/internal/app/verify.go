@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// VerifyTransactionInput contains the transaction to verify and, optionally, local namespace policies.
+type VerifyTransactionInput struct {
+	TxID string
+	Tx   *applicationpb.Tx
+	// Policies declares the namespace policies for offline verification.
+	// If nil, the policies are resolved via the query service.
+	Policies *NamespaceManifest
+}
+
+// TxVerification reports whether the endorsements of a transaction satisfy its namespace policies.
+type TxVerification struct {
+	TxID       string                              `json:"txId" yaml:"txId"`
+	Namespaces []transaction.NamespaceVerification `json:"namespaces" yaml:"namespaces"`
+}
+
+// Satisfied reports whether the policies of all namespaces are satisfied.
+func (v *TxVerification) Satisfied() bool {
+	for _, ns := range v.Namespaces {
+		if !ns.Satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyTransaction verifies the endorsements of every namespace of the transaction
+// and evaluates them against the namespace policies, without submitting the transaction.
+// The config namespace is skipped, as its transactions are verified by the ordering service.
+func (d *AdminApp) VerifyTransaction(ctx context.Context, input *VerifyTransactionInput) (*TxVerification, error) {
+	if input.Tx == nil {
+		return nil, errors.New("nil transaction")
+	}
+
+	resolve, closeF, err := d.policyResolver(input.Policies)
+	if err != nil {
+		return nil, err
+	}
+	defer closeF()
+
+	res := &TxVerification{TxID: input.TxID}
+	for i, ns := range input.Tx.GetNamespaces() {
+		if ns.GetNsId() == committerpb.ConfigNamespaceID {
+			continue
+		}
+
+		nsPolicy, err := resolve(ctx, ns.GetNsId())
+		if err != nil {
+			return nil, err
+		}
+		if nsPolicy == nil {
+			res.Namespaces = append(res.Namespaces, transaction.NamespaceVerification{
+				NsID:  ns.GetNsId(),
+				Error: unknownPolicyError(ns.GetNsId(), input.Policies != nil),
+			})
+			continue
+		}
+
+		v, err := transaction.VerifyEndorsements(input.TxID, input.Tx, i, nsPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot verify namespace %s: %w", ns.GetNsId(), err)
+		}
+		res.Namespaces = append(res.Namespaces, *v)
+	}
+
+	return res, nil
+}
+
+// policyResolverFunc returns the policy of a namespace, or nil if the policy is unknown.
+type policyResolverFunc func(ctx context.Context, nsID string) (*applicationpb.NamespacePolicy, error)
+
+// policyResolver returns a resolver for the given local policies or, if nil, for the query service.
+// The returned function releases the resources of the resolver.
+func (d *AdminApp) policyResolver(policies *NamespaceManifest) (policyResolverFunc, func(), error) {
+	if policies != nil {
+		local, err := d.localPolicies(policies)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(_ context.Context, nsID string) (*applicationpb.NamespacePolicy, error) {
+			return local[nsID], nil
+		}, func() {}, nil
+	}
+
+	// get query service instance
+	qc, err := d.QueryProvider.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	closeF := func() {
+		_ = qc.Close()
+	}
+
+	return func(ctx context.Context, nsID string) (*applicationpb.NamespacePolicy, error) {
+		return queryPolicy(ctx, qc, nsID)
+	}, closeF, nil
+}
+
+// localPolicies creates the policies declared by the manifest.
+func (d *AdminApp) localPolicies(m *NamespaceManifest) (map[string]*applicationpb.NamespacePolicy, error) {
+	if err := m.Validate(d.Validators); err != nil {
+		return nil, err
+	}
+
+	policies := make(map[string]*applicationpb.NamespacePolicy, len(m.Namespaces))
+	for _, spec := range m.Namespaces {
+		input := spec.deployInput(-1)
+		p, err := createPolicy(input.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create policy of namespace %s: %w", spec.Name, err)
+		}
+		policies[spec.Name] = p
+	}
+	return policies, nil
+}
+
+// queryPolicy fetches and decodes the committed policy of a namespace.
+func queryPolicy(ctx context.Context, qc adapters.QueryClient, nsID string) (*applicationpb.NamespacePolicy, error) {
+	item, err := qc.GetNamespacePolicy(ctx, nsID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot query namespace: %w", err)
+	}
+	if item == nil {
+		return nil, nil //nolint:nilnil // namespace does not exist
+	}
+
+	var p applicationpb.NamespacePolicy
+	if err := proto.Unmarshal(item.GetPolicy(), &p); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal policy of namespace %s: %w", nsID, err)
+	}
+	return &p, nil
+}
+
+// unknownPolicyError explains why the policy of a namespace could not be resolved.
+func unknownPolicyError(nsID string, local bool) string {
+	switch {
+	case nsID == committerpb.MetaNamespaceID:
+		return "the meta-namespace is governed by the LifecycleEndorsement channel policy; " +
+			"declare its policy in a local policy file to verify it"
+	case local:
+		return "namespace is not declared in the policy file"
+	default:
+		return "namespace not found"
+	}
+}
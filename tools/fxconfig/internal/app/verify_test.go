@@ -0,0 +1,109 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// someVerifyTx returns an unendorsed transaction writing to the given namespaces.
+func someVerifyTx(nsIDs ...string) *applicationpb.Tx {
+	tx := &applicationpb.Tx{}
+	for _, nsID := range nsIDs {
+		tx.Namespaces = append(tx.Namespaces, &applicationpb.TxNamespace{NsId: nsID})
+	}
+	return tx
+}
+
+func TestVerifyTransaction_QueryService(t *testing.T) {
+	t.Parallel()
+
+	qc := installedNamespaces(t, map[string]string{"payments": "OR('Org1MSP.member')"})
+	// a namespace whose policy accepts any endorsement
+	qc.policies.Policies = append(qc.policies.Policies, &applicationpb.PolicyItem{
+		Namespace: "open",
+		Policy: protoutil.MarshalOrPanic(&applicationpb.NamespacePolicy{
+			Rule: &applicationpb.NamespacePolicy_ThresholdRule{
+				ThresholdRule: &applicationpb.ThresholdRule{Scheme: "NONE"},
+			},
+		}),
+	})
+	a := &AdminApp{QueryProvider: makeQueryProvider(qc, nil)}
+
+	tx := someVerifyTx("payments", "open", "unknown", committerpb.ConfigNamespaceID, committerpb.MetaNamespaceID)
+	res, err := a.VerifyTransaction(t.Context(), &VerifyTransactionInput{TxID: "tx-1", Tx: tx})
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", res.TxID)
+	require.False(t, res.Satisfied())
+
+	// the config namespace is skipped
+	require.Len(t, res.Namespaces, 4)
+
+	require.Equal(t, "payments", res.Namespaces[0].NsID)
+	require.False(t, res.Namespaces[0].Satisfied)
+	require.Equal(t, "no endorsements", res.Namespaces[0].Error)
+	require.Equal(t, "'Org1MSP.member'", res.Namespaces[0].Principals[0].Principal)
+
+	require.Equal(t, "open", res.Namespaces[1].NsID)
+	require.True(t, res.Namespaces[1].Satisfied)
+
+	require.Equal(t, "unknown", res.Namespaces[2].NsID)
+	require.False(t, res.Namespaces[2].Satisfied)
+	require.Equal(t, "namespace not found", res.Namespaces[2].Error)
+
+	require.Equal(t, committerpb.MetaNamespaceID, res.Namespaces[3].NsID)
+	require.Contains(t, res.Namespaces[3].Error, "LifecycleEndorsement")
+}
+
+func TestVerifyTransaction_LocalPolicies(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{Validators: fakeValidationContext()}
+	policies := &NamespaceManifest{Namespaces: []NamespaceSpec{
+		{Name: committerpb.MetaNamespaceID, Policy: "AND('Org1MSP.admin', 'Org2MSP.admin')"},
+	}}
+
+	tx := someVerifyTx(committerpb.MetaNamespaceID, "payments")
+	res, err := a.VerifyTransaction(t.Context(), &VerifyTransactionInput{TxID: "tx-1", Tx: tx, Policies: policies})
+	require.NoError(t, err)
+	require.Len(t, res.Namespaces, 2)
+
+	require.Equal(t, "no endorsements", res.Namespaces[0].Error)
+	require.Equal(t, "msp AND('Org1MSP.admin', 'Org2MSP.admin')", res.Namespaces[0].Policy.String())
+	require.Len(t, res.Namespaces[0].Principals, 2)
+
+	require.Equal(t, "namespace is not declared in the policy file", res.Namespaces[1].Error)
+}
+
+func TestVerifyTransaction_Errors(t *testing.T) {
+	t.Parallel()
+
+	a := &AdminApp{
+		Validators:    fakeValidationContext(),
+		QueryProvider: makeQueryProvider(&mockQueryClient{err: errors.New("query failed")}, nil),
+	}
+
+	_, err := a.VerifyTransaction(t.Context(), &VerifyTransactionInput{TxID: "tx-1"})
+	require.ErrorContains(t, err, "nil transaction")
+
+	_, err = a.VerifyTransaction(t.Context(), &VerifyTransactionInput{TxID: "tx-1", Tx: someVerifyTx("payments")})
+	require.ErrorContains(t, err, "query failed")
+
+	_, err = a.VerifyTransaction(t.Context(), &VerifyTransactionInput{
+		TxID:     "tx-1",
+		Tx:       someVerifyTx("payments"),
+		Policies: &NamespaceManifest{},
+	})
+	require.ErrorContains(t, err, "manifest declares no namespaces")
+}
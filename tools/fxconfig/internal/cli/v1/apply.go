@@ -0,0 +1,227 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// NewApplyCommand returns a command that reconciles namespaces with a manifest.
+// It computes a plan against the installed namespaces, asks for confirmation,
+// and then builds, endorses, and submits the required transactions.
+func NewApplyCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		file   string
+		yes    bool
+		dryRun bool
+		wait   waitFlag
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <manifest>",
+		Short: "Apply a namespace manifest",
+		Long: `Create and update namespaces to match a declarative manifest.
+
+The manifest lists namespaces with their endorsement policies. Policies use
+the same syntax as the --policy flag of 'fxconfig namespace create':
+
+  namespaces:
+    - name: payments
+      policy: "AND('Org1MSP.member', 'Org2MSP.member')"
+    - name: tokens
+      policy: "threshold:keys/tokens.pem"
+
+Relative threshold key paths are resolved against the manifest directory.
+
+apply compares the manifest with the installed namespaces and shows a plan:
+  • create    - the namespace does not exist yet
+  • update    - the namespace exists with a different policy
+  • unchanged - the namespace already has the declared policy
+
+Installed namespaces that are not listed in the manifest are left untouched.
+After confirmation, one transaction per created or updated namespace is
+endorsed with the local MSP and submitted to the ordering service. Updates
+use the current namespace version, so a concurrent change causes the update
+to fail instead of overwriting it.
+
+Examples:
+  # Show the plan without submitting anything
+  fxconfig apply -f namespaces.yaml --dry-run
+
+  # Apply after interactive confirmation and wait for the results
+  fxconfig apply -f namespaces.yaml --wait
+
+  # Apply without confirmation, e.g., in a CI pipeline
+  fxconfig apply -f namespaces.yaml --yes --wait`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			manifest, err := readManifest(file)
+			if err != nil {
+				return err
+			}
+
+			plan, err := ctx.App.PlanNamespaces(cmd.Context(), manifest)
+			if err != nil {
+				return err
+			}
+
+			ctx.Printer.Print(renderPlan(plan))
+
+			pending := plan.Pending()
+			if len(pending) == 0 {
+				ctx.Printer.Print("No changes. Namespaces are up to date.\n")
+				return nil
+			}
+			if dryRun {
+				return nil
+			}
+
+			if !yes {
+				ctx.Printer.Print(fmt.Sprintf("\nApply %d change(s)? [y/N]: ", len(pending)))
+				ok, err := confirm(cmd)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					ctx.Printer.Print("Apply cancelled.\n")
+					return nil
+				}
+			}
+
+			results, err := ctx.App.ApplyNamespaces(cmd.Context(), plan, bool(wait))
+			if err != nil {
+				return err
+			}
+
+			return printApplyResults(ctx, pending, results, bool(wait))
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Namespace manifest (yaml or json)")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Apply without asking for confirmation")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the plan")
+	wait.bind(cmd)
+
+	return cmd
+}
+
+// readManifest reads and strictly decodes a namespace manifest.
+// Relative threshold key paths are resolved against the manifest directory.
+func readManifest(path string) (*app.NamespaceManifest, error) {
+	data, err := cliio.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read manifest: %w", err)
+	}
+
+	var manifest app.NamespaceManifest
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("cannot decode manifest %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	for i, ns := range manifest.Namespaces {
+		manifest.Namespaces[i].Policy = resolveThresholdPath(ns.Policy, dir)
+	}
+
+	return &manifest, nil
+}
+
+// resolveThresholdPath resolves the relative key path of a threshold policy against dir.
+// Other policies are returned unchanged.
+func resolveThresholdPath(policy, dir string) string {
+	key, ok := strings.CutPrefix(strings.TrimSpace(policy), "threshold:")
+	if !ok {
+		return policy
+	}
+
+	scheme, key := transaction.SplitSchemePath(strings.TrimSpace(key))
+	key = strings.TrimSpace(key)
+	if key == "" || filepath.IsAbs(key) {
+		return policy
+	}
+
+	if scheme != "" {
+		scheme += ":"
+	}
+	return "threshold:" + scheme + filepath.Join(dir, key)
+}
+
+// renderPlan renders the planned changes as a table.
+func renderPlan(plan *app.ApplyPlan) string {
+	rows := make([][]string, len(plan.Changes))
+	for i, c := range plan.Changes {
+		var version, policy string
+		switch c.Action {
+		case app.ApplyActionCreate:
+			version = "0"
+			policy = c.Desired.String()
+		case app.ApplyActionUpdate:
+			version = fmt.Sprintf("%d -> %d", c.Version, c.Version+1)
+			current := "<undecodable>"
+			if c.Current != nil {
+				current = c.Current.String()
+			}
+			policy = current + " -> " + c.Desired.String()
+		default:
+			version = strconv.Itoa(c.Version)
+			policy = c.Desired.String()
+		}
+		rows[i] = []string{c.Action, c.NsID, version, policy}
+	}
+
+	return cliio.RenderTable([]string{"ACTION", "NAME", "VERSION", "POLICY"}, rows)
+}
+
+// confirm reads a yes/no answer from the command input.
+func confirm(cmd *cobra.Command) (bool, error) {
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || answer == "") {
+		return false, fmt.Errorf("cannot read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// printApplyResults prints a per-namespace status table.
+// Returns an error if any transaction was not submitted or, with wait, did not commit.
+func printApplyResults(
+	ctx *CLIContext,
+	pending []app.NamespaceChange,
+	results []app.TxSubmissionResult,
+	wait bool,
+) error {
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		rows[i] = append([]string{pending[i].Action, pending[i].NsID, r.TxID}, submissionColumns(r, wait)...)
+	}
+
+	ctx.Printer.Print(cliio.RenderTable([]string{"ACTION", "NAME", "TXID", "STATUS", "BLOCK", "TXNUM", "ERROR"}, rows))
+
+	return submissionsError(results, wait, "namespace changes")
+}
@@ -0,0 +1,204 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+const someManifestYAML = `namespaces:
+  - name: payments
+    policy: "AND('Org1MSP.member', 'Org2MSP.member')"
+  - name: tokens
+    policy: "threshold:keys/tokens.pem"
+  - name: absolute
+    policy: "threshold:/etc/keys/absolute.pem"
+`
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "namespaces.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func somePlan() *app.ApplyPlan {
+	msp := func(expr string) *transaction.PolicyDescription {
+		return &transaction.PolicyDescription{Type: transaction.PolicyTypeMSP, Expression: expr}
+	}
+	return &app.ApplyPlan{Changes: []app.NamespaceChange{
+		{Action: app.ApplyActionCreate, NsID: "payments", Version: -1, Desired: msp("OR('Org1MSP.member')")},
+		{
+			Action:  app.ApplyActionUpdate,
+			NsID:    "tokens",
+			Version: 2,
+			Current: msp("OR('Org1MSP.member')"),
+			Desired: msp("OR('Org2MSP.member')"),
+		},
+		{Action: app.ApplyActionUnchanged, NsID: "other", Version: 5, Desired: msp("OR('Org3MSP.member')")},
+	}}
+}
+
+func newTestApplyCommand(t *testing.T, mockApp *testApp, stdin string, args ...string) (*bytes.Buffer, error) {
+	t.Helper()
+
+	var out, errOut bytes.Buffer
+	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+	cmd := NewApplyCommand(&CLIContext{App: mockApp, Printer: printer})
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+
+	return &out, cmd.Execute()
+}
+
+func TestReadManifest(t *testing.T) {
+	t.Parallel()
+
+	path := writeManifest(t, someManifestYAML)
+
+	manifest, err := readManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, []app.NamespaceSpec{
+		{Name: "payments", Policy: "AND('Org1MSP.member', 'Org2MSP.member')"},
+		{Name: "tokens", Policy: "threshold:" + filepath.Join(filepath.Dir(path), "keys/tokens.pem")},
+		{Name: "absolute", Policy: "threshold:/etc/keys/absolute.pem"},
+	}, manifest.Namespaces)
+
+	// the scheme of a threshold policy is kept
+	path = writeManifest(t, "namespaces:\n  - name: tokens\n    policy: \"threshold:eddsa:keys/tokens.pem\"\n")
+	manifest, err = readManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, "threshold:eddsa:"+filepath.Join(filepath.Dir(path), "keys/tokens.pem"),
+		manifest.Namespaces[0].Policy)
+}
+
+func TestReadManifest_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := readManifest(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "cannot read manifest")
+
+	_, err = readManifest(writeManifest(t, "namespaces:\n  - name: ns1\n    polcy: x\n"))
+	require.ErrorContains(t, err, "field polcy not found")
+}
+
+func TestApplyCommand_Confirmed(t *testing.T) {
+	t.Parallel()
+
+	plan := somePlan()
+	results := []app.TxSubmissionResult{
+		{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+		{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_COMMITTED)},
+	}
+
+	mockApp := &testApp{}
+	mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(plan, nil)
+	mockApp.On("ApplyNamespaces", mock.Anything, plan, true).Return(results, nil)
+
+	out, err := newTestApplyCommand(t, mockApp, "yes\n", "-f", writeManifest(t, someManifestYAML), "--wait")
+
+	require.NoError(t, err)
+	output := out.String()
+	require.Contains(t, output, "msp OR('Org1MSP.member') -> msp OR('Org2MSP.member')")
+	require.Contains(t, output, "2 -> 3")
+	require.Contains(t, output, "unchanged")
+	require.Contains(t, output, "Apply 2 change(s)? [y/N]")
+	require.Contains(t, output, "tx-2")
+	require.Contains(t, output, "COMMITTED")
+	mockApp.AssertExpectations(t)
+}
+
+func TestApplyCommand_Declined(t *testing.T) {
+	t.Parallel()
+
+	for _, answer := range []string{"n\n", "\n", "whatever"} {
+		mockApp := &testApp{}
+		mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(somePlan(), nil)
+
+		out, err := newTestApplyCommand(t, mockApp, answer, "-f", writeManifest(t, someManifestYAML))
+
+		require.NoError(t, err)
+		require.Contains(t, out.String(), "Apply cancelled")
+		mockApp.AssertExpectations(t)
+		mockApp.AssertNotCalled(t, "ApplyNamespaces", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestApplyCommand_DryRun(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(somePlan(), nil)
+
+	out, err := newTestApplyCommand(t, mockApp, "", "-f", writeManifest(t, someManifestYAML), "--dry-run")
+
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "ACTION")
+	require.NotContains(t, out.String(), "[y/N]")
+	mockApp.AssertNotCalled(t, "ApplyNamespaces", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplyCommand_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	plan := &app.ApplyPlan{Changes: somePlan().Changes[2:]}
+	mockApp := &testApp{}
+	mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(plan, nil)
+
+	out, err := newTestApplyCommand(t, mockApp, "", "-f", writeManifest(t, someManifestYAML))
+
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "No changes")
+	mockApp.AssertNotCalled(t, "ApplyNamespaces", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplyCommand_Failures(t *testing.T) {
+	t.Parallel()
+
+	plan := somePlan()
+	results := []app.TxSubmissionResult{
+		{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+		{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT)},
+	}
+
+	mockApp := &testApp{}
+	mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(plan, nil)
+	mockApp.On("ApplyNamespaces", mock.Anything, plan, true).Return(results, nil)
+
+	out, err := newTestApplyCommand(t, mockApp, "", "-f", writeManifest(t, someManifestYAML), "--yes", "--wait")
+
+	require.EqualError(t, err, "1 of 2 namespace changes did not commit")
+	require.Contains(t, out.String(), "ABORTED_MVCC_CONFLICT")
+	mockApp.AssertExpectations(t)
+}
+
+func TestApplyCommand_PlanError(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("PlanNamespaces", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := newTestApplyCommand(t, mockApp, "", "-f", writeManifest(t, someManifestYAML))
+
+	require.ErrorContains(t, err, "connection refused")
+}
@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewBlockRootCommand returns the block command group.
+// This command provides subcommands for reading committed blocks: fetch and decode.
+func NewBlockRootCommand(ctx *CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block",
+		Short: "Fetch and decode committed blocks",
+		Long: `Fetch committed blocks from the committer and decode them for auditing.
+
+Blocks committed by Fabric-X carry Fabric-X transactions in their envelopes and
+the validation status of every transaction in their metadata. Generic tools
+show these as opaque bytes; 'fxconfig block decode' decodes them completely.
+
+Workflow:
+  1. Fetch: fxconfig block fetch config --output config.block
+  2. Decode: fxconfig block decode config.block --output config.json`,
+	}
+
+	cmd.AddCommand(
+		newBlockFetchCommand(ctx),
+		newBlockDecodeCommand(ctx),
+	)
+
+	return cmd
+}
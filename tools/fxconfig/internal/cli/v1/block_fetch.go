@@ -0,0 +1,61 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newBlockFetchCommand creates a command for fetching a committed block.
+func newBlockFetchCommand(ctx *CLIContext) *cobra.Command {
+	var output outputFlag
+
+	cmd := &cobra.Command{
+		Use:   "fetch <number|newest|oldest|config>",
+		Short: "Fetch a committed block",
+		Long: `Fetch a committed block from the deliver service of the committer sidecar
+(notifications endpoint) and write it as binary protobuf.
+
+The block is selected by its number or by position:
+  • newest - the latest committed block
+  • oldest - the genesis block
+  • config - the latest config block, as recorded in the newest block
+
+The block includes the validation status of every transaction in its metadata.
+Use 'fxconfig block decode' to decode it.
+
+Examples:
+  # Fetch block 42
+  fxconfig block fetch 42 --output 42.block
+
+  # Fetch the latest config block
+  fxconfig block fetch config --output config.block
+
+  # Fetch and decode the newest block
+  fxconfig block fetch newest | fxconfig block decode -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			block, err := ctx.App.FetchBlock(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(block)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+
+	return cmd
+}
@@ -0,0 +1,163 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func TestNewBlockRootCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewBlockRootCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "block", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+
+	subCmds := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		subCmds[sub.Name()] = true
+	}
+	require.True(t, subCmds["fetch"])
+	require.True(t, subCmds["decode"])
+}
+
+func TestBlockFetchCommand(t *testing.T) {
+	t.Parallel()
+
+	block := protoutil.NewBlock(42, []byte{0x01})
+
+	t.Run("output file", func(t *testing.T) {
+		t.Parallel()
+
+		mockApp := &testApp{}
+		mockApp.On("FetchBlock", mock.Anything, "config").Return(block, nil)
+
+		output := filepath.Join(t.TempDir(), "config.block")
+		cmd := newBlockFetchCommand(&CLIContext{App: mockApp})
+		cmd.SetArgs([]string{"config", "--output", output})
+		require.NoError(t, cmd.Execute())
+		mockApp.AssertExpectations(t)
+
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		written := &cb.Block{}
+		require.NoError(t, proto.Unmarshal(data, written))
+		require.True(t, proto.Equal(block, written))
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		t.Parallel()
+
+		mockApp := &testApp{}
+		mockApp.On("FetchBlock", mock.Anything, "42").Return(block, nil)
+
+		var out bytes.Buffer
+		cmd := newBlockFetchCommand(&CLIContext{App: mockApp})
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"42"})
+		require.NoError(t, cmd.Execute())
+
+		written := &cb.Block{}
+		require.NoError(t, proto.Unmarshal(out.Bytes(), written))
+		require.Equal(t, uint64(42), written.GetHeader().GetNumber())
+	})
+
+	t.Run("app error", func(t *testing.T) {
+		t.Parallel()
+
+		mockApp := &testApp{}
+		mockApp.On("FetchBlock", mock.Anything, "newest").Return(nil, errors.New("no block committed yet"))
+
+		cmd := newBlockFetchCommand(&CLIContext{App: mockApp})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"newest"})
+		require.EqualError(t, cmd.Execute(), "no block committed yet")
+	})
+}
+
+func TestBlockDecodeCommand(t *testing.T) {
+	t.Parallel()
+
+	block := protoutil.NewBlock(42, []byte{0x01})
+	data, err := proto.Marshal(block)
+	require.NoError(t, err)
+	report := &transaction.BlockReport{Number: 42, PreviousHash: "01", Transactions: []transaction.BlockTxReport{}}
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+
+		input := filepath.Join(t.TempDir(), "42.block")
+		require.NoError(t, os.WriteFile(input, data, 0o600))
+		output := filepath.Join(t.TempDir(), "42.json")
+
+		mockApp := &testApp{}
+		mockApp.On("DescribeBlock", mock.Anything, mock.MatchedBy(func(b *cb.Block) bool {
+			return b.GetHeader().GetNumber() == 42
+		})).Return(report, nil)
+
+		cmd := newBlockDecodeCommand(&CLIContext{App: mockApp})
+		cmd.SetArgs([]string{input, "--output", output})
+		require.NoError(t, cmd.Execute())
+		mockApp.AssertExpectations(t)
+
+		written, err := os.ReadFile(output)
+		require.NoError(t, err)
+		var decoded transaction.BlockReport
+		require.NoError(t, json.Unmarshal(written, &decoded))
+		require.Equal(t, *report, decoded)
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		t.Parallel()
+
+		mockApp := &testApp{}
+		mockApp.On("DescribeBlock", mock.Anything, mock.Anything).Return(report, nil)
+
+		var out bytes.Buffer
+		cmd := newBlockDecodeCommand(&CLIContext{App: mockApp})
+		cmd.SetIn(bytes.NewReader(data))
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"-"})
+		require.NoError(t, cmd.Execute())
+		require.Contains(t, out.String(), `"number": 42`)
+	})
+
+	t.Run("not a block", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := newBlockDecodeCommand(&CLIContext{App: &testApp{}})
+		cmd.SetIn(bytes.NewReader(nil))
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-"})
+		require.EqualError(t, cmd.Execute(), "cannot decode block: missing block header")
+	})
+
+	t.Run("invalid protobuf", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := newBlockDecodeCommand(&CLIContext{App: &testApp{}})
+		cmd.SetIn(bytes.NewReader([]byte("garbage")))
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"-"})
+		require.ErrorContains(t, cmd.Execute(), "cannot decode block")
+	})
+}
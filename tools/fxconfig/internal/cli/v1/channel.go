@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"fmt"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x-common/protolator"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// NewChannelRootCommand returns the channel command group.
+// This command provides the config subcommands for updating the channel config.
+func NewChannelRootCommand(ctx *CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel",
+		Short: "Manage the channel",
+		Long:  `Manage the Fabric-X channel, e.g., update its config.`,
+	}
+
+	cmd.AddCommand(newChannelConfigCommand(ctx))
+
+	return cmd
+}
+
+// newChannelConfigCommand returns the channel config command group.
+// This command provides subcommands for channel config updates: fetch, update, add-org, sign, merge,
+// and submit.
+func newChannelConfigCommand(ctx *CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Update the channel config",
+		Long: `Update the channel config, e.g., to add an organization or to rotate orderer certificates.
+
+A config update must satisfy the mod policies of all config elements it
+changes, e.g., the majority of the application organization admins. It is
+signed by each organization independently, and the signatures are collected
+like endorsements of a transaction.
+
+Config updates are written as binary protobuf (common.ConfigUpdateEnvelope), as
+the signatures cover the exact bytes of the update.
+
+Multi-Organization Workflow:
+  1. Fetch: fxconfig channel config fetch --output config.json
+  2. Edit: cp config.json modified.json, then edit modified.json
+  3. Compute: fxconfig channel config update modified.json --original config.json --output update.pb
+  4. Org1 signs: fxconfig channel config sign update.pb --output update_org1.pb
+  5. Org2 signs: fxconfig channel config sign update.pb --output update_org2.pb
+  6. Merge signatures: fxconfig channel config merge update_org1.pb update_org2.pb --output signed.pb
+  7. Submit: fxconfig channel config submit signed.pb
+
+Adding an Organization:
+  Instead of steps 1-3, compute the update from the MSP directory of the new
+  organization, e.g., as generated by cryptogen:
+  fxconfig channel config add-org --msp-id Org3MSP --msp-dir org3/msp --output update.pb`,
+	}
+
+	cmd.AddCommand(
+		newChannelConfigFetchCommand(ctx),
+		newChannelConfigUpdateCommand(ctx),
+		newChannelConfigAddOrgCommand(ctx),
+		newChannelConfigSignCommand(ctx),
+		newChannelConfigMergeCommand(ctx),
+		newChannelConfigSubmitCommand(ctx),
+	)
+
+	return cmd
+}
+
+// readChannelConfig reads a channel config (common.Config) in the JSON representation of configtxlator.
+func readChannelConfig(cmd *cobra.Command, arg string) (*cb.Config, error) {
+	data, err := cliio.ResolveInput(cmd, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &cb.Config{}
+	if err := protolator.DeepUnmarshalJSON(bytes.NewReader(data), config); err != nil {
+		return nil, fmt.Errorf("cannot decode channel config %s: %w", arg, err)
+	}
+	return config, nil
+}
+
+// readConfigUpdate reads a binary config update envelope.
+func readConfigUpdate(cmd *cobra.Command, arg string) (*cb.ConfigUpdateEnvelope, error) {
+	data, err := cliio.ResolveInput(cmd, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	env, _, err := transaction.UnmarshalConfigUpdateEnvelope(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode %s: %w", arg, err)
+	}
+	return env, nil
+}
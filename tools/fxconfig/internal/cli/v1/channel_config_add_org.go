@@ -0,0 +1,169 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// Config groups selected with --group.
+const (
+	orgGroupApplication = "application"
+	orgGroupOrderer     = "orderer"
+)
+
+// newChannelConfigAddOrgCommand creates a command for computing a config update that adds an organization.
+func newChannelConfigAddOrgCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		output   outputFlag
+		orgFile  string
+		org      app.OrganizationDefinition
+		groups   []string
+		anchors  []string
+		original string
+		channel  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add-org",
+		Short: "Compute a config update that adds an organization",
+		Long: `Compute the config update that adds an organization to the application group
+of the channel config, and write it unsigned as binary protobuf, ready to be
+signed with 'fxconfig channel config sign'.
+
+The organization is defined by its MSP directory, e.g., as generated by
+'cryptogen generate' or 'cryptogen extend', and its MSP ID, or by an
+organization file in the format of the organizations of a configtx.yaml:
+
+  Name: Org3MSP                 # key in the config groups (defaults to ID)
+  ID: Org3MSP
+  MSPDir: crypto-config/peerOrganizations/org3.example.com/msp
+  Policies: ...                 # optional, see below
+  AnchorPeers:
+    - peer0.org3.example.com:7051
+
+A relative MSPDir is resolved against the directory of the organization file.
+
+Without policies, the default policies are used:
+  • Readers and Writers - OR('<ID>.member')
+  • Admins - OR('<ID>.admin')
+  • Endorsement - OR('<ID>.member')
+
+Adding an orderer party (--group orderer) is rejected: in Fabric-X, a party is
+defined by the consenter mapping of the orderer group, the shared config of the
+Arma consensus type, and the BlockValidation policy, which add-org does not
+compute. Edit them in the fetched config and compute the update with
+'fxconfig channel config update'.
+
+The current config is the --original file, as written by 'fxconfig channel
+config fetch'. Without --original, the current config is fetched from the
+committer, and the channel defaults to the channel of the config block.
+Otherwise, the channel defaults to the orderer channel of the configuration.
+
+Examples:
+  # Add an application organization from its MSP directory
+  fxconfig channel config add-org --msp-id Org3MSP \
+    --msp-dir crypto-config/peerOrganizations/org3.example.com/msp --output update.pb
+
+  # Add an organization defined in a file, against a fetched config
+  fxconfig channel config add-org --org-file org3.yaml --original config.json --output update.pb`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			input := &app.AddOrganizationInput{Channel: channel, Org: &org}
+			for _, g := range groups {
+				switch g {
+				case orgGroupApplication:
+					input.Application = true
+				case orgGroupOrderer:
+					input.Orderer = true
+				default:
+					return fmt.Errorf("invalid group: %s (want %s|%s)", g, orgGroupApplication, orgGroupOrderer)
+				}
+			}
+
+			if orgFile != "" {
+				def, err := readOrganizationFile(orgFile)
+				if err != nil {
+					return err
+				}
+				input.Org = def
+			}
+			input.Org.AnchorPeers = append(input.Org.AnchorPeers, anchors...)
+
+			if original != "" {
+				var err error
+				input.Original, err = readChannelConfig(cmd, original)
+				if err != nil {
+					return err
+				}
+				if input.Channel == "" {
+					input.Channel = ctx.Config.Orderer.Channel
+				}
+			}
+
+			env, err := ctx.App.AddOrganization(cmd.Context(), input)
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(env)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+	cmd.Flags().StringVar(&orgFile, "org-file", "", "Organization file (yaml or json) in the format of configtx.yaml")
+	cmd.Flags().StringVar(&org.ID, "msp-id", "", "MSP ID of the organization")
+	cmd.Flags().StringVar(&org.MSPDir, "msp-dir", "", "MSP directory of the organization")
+	cmd.Flags().StringVar(&org.Name, "name", "", "Name of the organization in the config groups (defaults to the MSP ID)")
+	cmd.Flags().StringSliceVar(&groups, "group", []string{orgGroupApplication},
+		"Config groups to add the organization to (application; orderer is not supported)")
+	cmd.Flags().StringArrayVar(&anchors, "anchor-peer", nil, "Anchor peer host:port (can be repeated)")
+	cmd.Flags().StringVar(&original, "original", "",
+		"Current channel config (JSON); fetched from the committer if not specified")
+	cmd.Flags().StringVar(&channel, "channel", "", "Channel name of the update")
+	cmd.MarkFlagsMutuallyExclusive("org-file", "msp-id")
+	cmd.MarkFlagsMutuallyExclusive("org-file", "msp-dir")
+	cmd.MarkFlagsMutuallyExclusive("org-file", "name")
+	cmd.MarkFlagsOneRequired("org-file", "msp-id")
+	cmd.MarkFlagsRequiredTogether("msp-id", "msp-dir")
+
+	return cmd
+}
+
+// readOrganizationFile reads and strictly decodes an organization file. A relative MSP directory
+// is resolved against the directory of the organization file.
+func readOrganizationFile(path string) (*app.OrganizationDefinition, error) {
+	data, err := cliio.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read organization file: %w", err)
+	}
+
+	var org app.OrganizationDefinition
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&org); err != nil {
+		return nil, fmt.Errorf("cannot decode organization file %s: %w", path, err)
+	}
+
+	if org.MSPDir != "" && !filepath.IsAbs(org.MSPDir) {
+		org.MSPDir = filepath.Join(filepath.Dir(path), org.MSPDir)
+	}
+	return &org, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction/transactiontest"
+)
+
+func TestChannelConfigAddOrgCommand(t *testing.T) {
+	t.Parallel()
+
+	original := writeChannelConfig(t, "config.json", transactiontest.ChannelConfig("Org1MSP"))
+
+	dir := t.TempDir()
+	orgFile := filepath.Join(dir, "org3.yaml")
+	require.NoError(t, os.WriteFile(orgFile, []byte(`
+Name: Org3
+ID: Org3MSP
+MSPDir: crypto/org3/msp
+Policies:
+  Readers:
+    Type: Signature
+    Rule: OR('Org3MSP.peer')
+AnchorPeers:
+  - peer0.org3.example.com:7051
+`), 0o600))
+
+	tests := []struct {
+		name            string
+		args            []string
+		expectInput     app.AddOrganizationInput
+		expectOrg       app.OrganizationDefinition
+		expectPolicies  bool
+		expectOriginal  bool
+		expectNoChannel bool
+	}{
+		{
+			name:        "msp flags",
+			args:        []string{"--msp-id", "Org3MSP", "--msp-dir", "org3/msp"},
+			expectInput: app.AddOrganizationInput{Application: true},
+			expectOrg:   app.OrganizationDefinition{ID: "Org3MSP", MSPDir: "org3/msp"},
+		},
+		{
+			name: "orderer group",
+			args: []string{
+				"--msp-id", "Org3MSP", "--msp-dir", "org3/msp", "--name", "Org3",
+				"--group", "application,orderer",
+				"--anchor-peer", "peer0.org3.example.com:7051",
+			},
+			// the application rejects the orderer group
+			expectInput: app.AddOrganizationInput{Application: true, Orderer: true},
+			expectOrg: app.OrganizationDefinition{
+				Name:        "Org3",
+				ID:          "Org3MSP",
+				MSPDir:      "org3/msp",
+				AnchorPeers: []string{"peer0.org3.example.com:7051"},
+			},
+		},
+		{
+			name:        "org file",
+			args:        []string{"--org-file", orgFile, "--anchor-peer", "peer1.org3.example.com:7051"},
+			expectInput: app.AddOrganizationInput{Application: true},
+			expectOrg: app.OrganizationDefinition{
+				Name:        "Org3",
+				ID:          "Org3MSP",
+				MSPDir:      filepath.Join(dir, "crypto/org3/msp"),
+				AnchorPeers: []string{"peer0.org3.example.com:7051", "peer1.org3.example.com:7051"},
+			},
+			expectPolicies: true,
+		},
+		{
+			name:           "original file",
+			args:           []string{"--msp-id", "Org3MSP", "--msp-dir", "org3/msp", "--original", original},
+			expectInput:    app.AddOrganizationInput{Channel: "mychannel", Application: true},
+			expectOrg:      app.OrganizationDefinition{ID: "Org3MSP", MSPDir: "org3/msp"},
+			expectOriginal: true,
+		},
+		{
+			name: "channel flag",
+			args: []string{
+				"--msp-id", "Org3MSP", "--msp-dir", "org3/msp",
+				"--original", original, "--channel", "otherchannel",
+			},
+			expectInput:    app.AddOrganizationInput{Channel: "otherchannel", Application: true},
+			expectOrg:      app.OrganizationDefinition{ID: "Org3MSP", MSPDir: "org3/msp"},
+			expectOriginal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := someConfigUpdateEnvelope()
+			mockApp := &testApp{}
+			mockApp.On("AddOrganization", mock.Anything, mock.MatchedBy(func(in *app.AddOrganizationInput) bool {
+				hasOriginal := in.Original != nil && proto.Equal(in.Original, transactiontest.ChannelConfig("Org1MSP"))
+				org := *in.Org
+				hasPolicies := org.Policies != nil
+				org.Policies = nil
+				return in.Channel == tt.expectInput.Channel &&
+					in.Application == tt.expectInput.Application && in.Orderer == tt.expectInput.Orderer &&
+					hasOriginal == tt.expectOriginal && hasPolicies == tt.expectPolicies &&
+					equalOrg(org, tt.expectOrg)
+			})).Return(env, nil)
+
+			output := filepath.Join(t.TempDir(), "update.pb")
+			cmd := newChannelConfigAddOrgCommand(&CLIContext{
+				App:    mockApp,
+				Config: &config.Config{Orderer: config.OrdererConfig{Channel: "mychannel"}},
+			})
+			cmd.SetArgs(append([]string{"--output", output}, tt.args...))
+			require.NoError(t, cmd.Execute())
+			mockApp.AssertExpectations(t)
+
+			require.True(t, proto.Equal(env, readConfigUpdateFile(t, output)))
+		})
+	}
+}
+
+func TestChannelConfigAddOrgCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	invalidOrgFile := filepath.Join(t.TempDir(), "org.yaml")
+	require.NoError(t, os.WriteFile(invalidOrgFile, []byte("ID: Org3MSP\nMSPID: Org3MSP\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "missing organization",
+			expectedErr: "at least one of the flags in the group [org-file msp-id] is required",
+		},
+		{
+			name:        "missing msp dir",
+			args:        []string{"--msp-id", "Org3MSP"},
+			expectedErr: "if any flags in the group [msp-id msp-dir] are set they must all be set",
+		},
+		{
+			name:        "org file and msp flags",
+			args:        []string{"--org-file", invalidOrgFile, "--msp-id", "Org3MSP", "--msp-dir", "org3/msp"},
+			expectedErr: "are set none of the others can be",
+		},
+		{
+			name:        "invalid group",
+			args:        []string{"--msp-id", "Org3MSP", "--msp-dir", "org3/msp", "--group", "consortium"},
+			expectedErr: "invalid group: consortium (want application|orderer)",
+		},
+		{
+			name:        "invalid org file",
+			args:        []string{"--org-file", invalidOrgFile},
+			expectedErr: "cannot decode organization file " + invalidOrgFile,
+		},
+		{
+			name:        "missing org file",
+			args:        []string{"--org-file", filepath.Join(t.TempDir(), "missing.yaml")},
+			expectedErr: "cannot read organization file",
+		},
+		{
+			name:        "app error",
+			args:        []string{"--msp-id", "Org3MSP", "--msp-dir", "org3/msp"},
+			expectedErr: "organization Org3MSP already exists in the Application group",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("AddOrganization", mock.Anything, mock.Anything).
+				Return(nil, errors.New("organization Org3MSP already exists in the Application group"))
+
+			cmd := newChannelConfigAddOrgCommand(&CLIContext{App: mockApp})
+			cmd.SetArgs(tt.args)
+			require.ErrorContains(t, cmd.Execute(), tt.expectedErr)
+		})
+	}
+}
+
+func equalOrg(a, b app.OrganizationDefinition) bool {
+	return a.Name == b.Name && a.ID == b.ID && a.MSPDir == b.MSPDir && a.MSPType == b.MSPType &&
+		slices.Equal(a.AnchorPeers, b.AnchorPeers)
+}
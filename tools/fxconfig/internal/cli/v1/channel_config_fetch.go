@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x-common/protolator"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newChannelConfigFetchCommand creates a command for fetching the current channel config.
+func newChannelConfigFetchCommand(ctx *CLIContext) *cobra.Command {
+	var output outputFlag
+
+	cmd := &cobra.Command{
+		Use:   "fetch",
+		Short: "Fetch the current channel config",
+		Long: `Fetch the latest config block from the committer sidecar (notifications
+endpoint) and write its channel config as JSON.
+
+The JSON is the representation of configtxlator (common.Config), so it can be
+edited with any tool and passed to 'fxconfig channel config update'.
+
+Examples:
+  # Fetch the channel config
+  fxconfig channel config fetch --output config.json
+
+  # Show the application organizations
+  fxconfig channel config fetch | jq '.channel_group.groups.Application.groups | keys'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := ctx.App.FetchChannelConfig(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var buf bytes.Buffer
+			if err := protolator.DeepMarshalJSON(&buf, config.Config); err != nil {
+				return err
+			}
+			cmd.PrintErrf("Fetched the config of channel %s from block %d\n", config.Channel, config.BlockNum)
+
+			return cliio.WriteOutput(cmd, string(output), buf.Bytes())
+		},
+	}
+	output.bind(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/protolator"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction/transactiontest"
+)
+
+func TestChannelConfigFetchCommand(t *testing.T) {
+	t.Parallel()
+
+	config := transactiontest.ChannelConfig("Org1MSP")
+	mockApp := &testApp{}
+	mockApp.On("FetchChannelConfig", mock.Anything).
+		Return(&app.ChannelConfig{Channel: "mychannel", BlockNum: 4, Config: config}, nil)
+
+	var out, errOut bytes.Buffer
+	cmd := newChannelConfigFetchCommand(&CLIContext{App: mockApp})
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(nil)
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+
+	require.Equal(t, "Fetched the config of channel mychannel from block 4\n", errOut.String())
+	decoded := &cb.Config{}
+	require.NoError(t, protolator.DeepUnmarshalJSON(&out, decoded))
+	require.True(t, proto.Equal(config, decoded))
+}
+
+func TestChannelConfigFetchCommand_Error(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("FetchChannelConfig", mock.Anything).Return(nil, errors.New("no block committed yet"))
+
+	cmd := newChannelConfigFetchCommand(&CLIContext{App: mockApp})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs(nil)
+	require.EqualError(t, cmd.Execute(), "no block committed yet")
+}
@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newChannelConfigMergeCommand creates a command to merge the signatures of multiple
+// signed copies of the same config update.
+func newChannelConfigMergeCommand(ctx *CLIContext) *cobra.Command {
+	var output outputFlag
+
+	cmd := &cobra.Command{
+		Use:   "merge [update1.pb] [update2.pb] [updateN.pb...]",
+		Short: "Merge signatures of a config update",
+		Long: `Combine the signatures from multiple organizations into a single config update.
+
+All inputs must contain the same config update. Signatures are deduplicated by
+the MSP ID of the signer, as endorsements by 'fxconfig tx merge'.
+
+Examples:
+  # Merge the signatures of two organizations
+  fxconfig channel config merge update_org1.pb update_org2.pb --output signed.pb`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			envs := make([]*cb.ConfigUpdateEnvelope, 0, len(args))
+			for _, arg := range args {
+				env, err := readConfigUpdate(cmd, arg)
+				if err != nil {
+					return err
+				}
+				envs = append(envs, env)
+			}
+
+			merged, err := ctx.App.MergeConfigUpdates(cmd.Context(), envs)
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(merged)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChannelConfigMergeCommand(t *testing.T) {
+	t.Parallel()
+
+	org1 := writeConfigUpdate(t, "update_org1.pb", someConfigUpdateEnvelope("org1-sig"))
+	org2 := writeConfigUpdate(t, "update_org2.pb", someConfigUpdateEnvelope("org2-sig"))
+	merged := someConfigUpdateEnvelope("org1-sig", "org2-sig")
+
+	mockApp := &testApp{}
+	mockApp.On("MergeConfigUpdates", mock.Anything, mock.MatchedBy(func(envs []*cb.ConfigUpdateEnvelope) bool {
+		return len(envs) == 2 &&
+			proto.Equal(envs[0], someConfigUpdateEnvelope("org1-sig")) &&
+			proto.Equal(envs[1], someConfigUpdateEnvelope("org2-sig"))
+	})).Return(merged, nil)
+
+	output := filepath.Join(t.TempDir(), "signed.pb")
+	cmd := newChannelConfigMergeCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{org1, org2, "--output", output})
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+
+	require.True(t, proto.Equal(merged, readConfigUpdateFile(t, output)))
+}
+
+func TestChannelConfigMergeCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	org1 := writeConfigUpdate(t, "update_org1.pb", someConfigUpdateEnvelope("org1-sig"))
+
+	cmd := newChannelConfigMergeCommand(&CLIContext{App: &testApp{}})
+	cmd.SetArgs([]string{org1})
+	require.Error(t, cmd.Execute())
+
+	mockApp := &testApp{}
+	mockApp.On("MergeConfigUpdates", mock.Anything, mock.Anything).
+		Return(nil, errors.New("config update 1: content mismatch"))
+
+	cmd = newChannelConfigMergeCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{org1, org1})
+	require.EqualError(t, cmd.Execute(), "config update 1: content mismatch")
+}
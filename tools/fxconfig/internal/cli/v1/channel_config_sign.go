@@ -0,0 +1,59 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newChannelConfigSignCommand creates a command for signing a channel config update.
+func newChannelConfigSignCommand(ctx *CLIContext) *cobra.Command {
+	var output outputFlag
+
+	cmd := &cobra.Command{
+		Use:   "sign [update.pb|-]",
+		Short: "Sign a config update",
+		Long: `Add the signature of the local MSP identity to a config update.
+
+The signature is appended to the signatures of the update, so an update can be
+passed from one organization to the next. Alternatively, each organization signs
+the unsigned update and the signatures are combined with 'fxconfig channel config
+merge'. No service is contacted.
+
+Examples:
+  # Sign a config update
+  fxconfig channel config sign update.pb --output update_org1.pb
+
+  # Sign an update that is already signed by another organization
+  fxconfig channel config sign update_org1.pb --output update_org1_org2.pb`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			env, err := readConfigUpdate(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			signed, err := ctx.App.SignConfigUpdate(cmd.Context(), env)
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(signed)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+
+	return cmd
+}
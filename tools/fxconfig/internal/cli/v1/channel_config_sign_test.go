@@ -0,0 +1,51 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChannelConfigSignCommand(t *testing.T) {
+	t.Parallel()
+
+	input := writeConfigUpdate(t, "update.pb", someConfigUpdateEnvelope())
+	signed := someConfigUpdateEnvelope("org1-sig")
+
+	mockApp := &testApp{}
+	mockApp.On("SignConfigUpdate", mock.Anything, mock.MatchedBy(func(env *cb.ConfigUpdateEnvelope) bool {
+		return proto.Equal(env, someConfigUpdateEnvelope())
+	})).Return(signed, nil)
+
+	output := filepath.Join(t.TempDir(), "update_org1.pb")
+	cmd := newChannelConfigSignCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{input, "--output", output})
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+
+	require.True(t, proto.Equal(signed, readConfigUpdateFile(t, output)))
+}
+
+func TestChannelConfigSignCommand_Error(t *testing.T) {
+	t.Parallel()
+
+	input := writeConfigUpdate(t, "update.pb", someConfigUpdateEnvelope())
+
+	mockApp := &testApp{}
+	mockApp.On("SignConfigUpdate", mock.Anything, mock.Anything).Return(nil, errors.New("msp unavailable"))
+
+	cmd := newChannelConfigSignCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{input})
+	require.EqualError(t, cmd.Execute(), "msp unavailable")
+}
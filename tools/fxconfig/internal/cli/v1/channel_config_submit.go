@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newChannelConfigSubmitCommand creates a command for submitting a signed config update.
+func newChannelConfigSubmitCommand(ctx *CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [update.pb|-]",
+		Short: "Submit a signed config update to ordering service",
+		Long: `Submit a signed config update to the Fabric-X ordering service.
+
+The update is wrapped in a CONFIG_UPDATE envelope for the channel of the update,
+signed by the local MSP identity, and broadcast to the ordering service. The
+ordering service validates the signatures against the mod policies and, if
+they are satisfied, orders a new config block.
+
+The ordering service wraps the update in a new config transaction, so the
+update cannot be awaited by the printed transaction ID. Use 'fxconfig channel
+config fetch' or 'fxconfig block fetch config' to check the committed config.
+
+Examples:
+  # Submit a config update
+  fxconfig channel config submit signed.pb`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			env, err := readConfigUpdate(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			txID, err := ctx.App.SubmitConfigUpdate(cmd.Context(), env)
+			if err != nil {
+				return err
+			}
+
+			ctx.Printer.Print(fmt.Sprintf("Config update submitted: %s", txID))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+func TestChannelConfigSubmitCommand(t *testing.T) {
+	t.Parallel()
+
+	input := writeConfigUpdate(t, "signed.pb", someConfigUpdateEnvelope("org1-sig"))
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitConfigUpdate", mock.Anything, mock.MatchedBy(func(env *cb.ConfigUpdateEnvelope) bool {
+		return proto.Equal(env, someConfigUpdateEnvelope("org1-sig"))
+	})).Return("tx-config", nil)
+
+	var out bytes.Buffer
+	cmd := newChannelConfigSubmitCommand(&CLIContext{
+		App:     mockApp,
+		Printer: cliio.NewCLIPrinter(&out, &out, cliio.FormatTable),
+	})
+	cmd.SetArgs([]string{input})
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+
+	require.Contains(t, out.String(), "Config update submitted: tx-config")
+}
+
+func TestChannelConfigSubmitCommand_Error(t *testing.T) {
+	t.Parallel()
+
+	input := writeConfigUpdate(t, "signed.pb", someConfigUpdateEnvelope())
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitConfigUpdate", mock.Anything, mock.Anything).
+		Return("", errors.New("config update is not signed"))
+
+	cmd := newChannelConfigSubmitCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{input})
+	require.EqualError(t, cmd.Execute(), "config update is not signed")
+}
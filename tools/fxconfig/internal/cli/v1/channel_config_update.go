@@ -0,0 +1,82 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newChannelConfigUpdateCommand creates a command for computing a channel config update.
+func newChannelConfigUpdateCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		output   outputFlag
+		original string
+		channel  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update [modified.json|-]",
+		Short: "Compute a config update from a modified channel config",
+		Long: `Compute the config update that changes the current into the modified channel
+config, as 'configtxlator compute_update' does, and write it unsigned as binary
+protobuf.
+
+The current config is the --original file, as written by 'fxconfig channel
+config fetch'. Without --original, the current config is fetched from the
+committer, and the channel defaults to the channel of the config block.
+Otherwise, the channel defaults to the orderer channel of the configuration.
+
+Examples:
+  # Compute the update against the fetched config
+  fxconfig channel config update modified.json --original config.json --output update.pb
+
+  # Compute the update against the committed config
+  fxconfig channel config update modified.json --output update.pb`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input := &app.ConfigUpdateInput{Channel: channel}
+
+			var err error
+			input.Updated, err = readChannelConfig(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			if original != "" {
+				input.Original, err = readChannelConfig(cmd, original)
+				if err != nil {
+					return err
+				}
+				if input.Channel == "" {
+					input.Channel = ctx.Config.Orderer.Channel
+				}
+			}
+
+			env, err := ctx.App.ComputeConfigUpdate(cmd.Context(), input)
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(env)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+	cmd.Flags().StringVar(&original, "original", "",
+		"Current channel config (JSON); fetched from the committer if not specified")
+	cmd.Flags().StringVar(&channel, "channel", "", "Channel name of the update")
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction/transactiontest"
+)
+
+func TestChannelConfigUpdateCommand(t *testing.T) {
+	t.Parallel()
+
+	original := writeChannelConfig(t, "config.json", transactiontest.ChannelConfig("Org1MSP"))
+	modified := writeChannelConfig(t, "modified.json", transactiontest.ChannelConfig("Org1MSP", "Org2MSP"))
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectChannel  string
+		expectOriginal bool
+	}{
+		{name: "committed config"},
+		{name: "channel flag", args: []string{"--channel", "otherchannel"}, expectChannel: "otherchannel"},
+		{
+			name:           "original file",
+			args:           []string{"--original", original},
+			expectChannel:  "mychannel",
+			expectOriginal: true,
+		},
+		{
+			name:           "original file and channel flag",
+			args:           []string{"--original", original, "--channel", "otherchannel"},
+			expectChannel:  "otherchannel",
+			expectOriginal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := someConfigUpdateEnvelope()
+			mockApp := &testApp{}
+			mockApp.On("ComputeConfigUpdate", mock.Anything, mock.MatchedBy(func(in *app.ConfigUpdateInput) bool {
+				hasOriginal := in.Original != nil &&
+					proto.Equal(in.Original, transactiontest.ChannelConfig("Org1MSP"))
+				return in.Channel == tt.expectChannel && hasOriginal == tt.expectOriginal &&
+					proto.Equal(in.Updated, transactiontest.ChannelConfig("Org1MSP", "Org2MSP"))
+			})).Return(env, nil)
+
+			output := filepath.Join(t.TempDir(), "update.pb")
+			cmd := newChannelConfigUpdateCommand(&CLIContext{
+				App:    mockApp,
+				Config: &config.Config{Orderer: config.OrdererConfig{Channel: "mychannel"}},
+			})
+			cmd.SetArgs(append([]string{modified, "--output", output}, tt.args...))
+			require.NoError(t, cmd.Execute())
+			mockApp.AssertExpectations(t)
+
+			require.True(t, proto.Equal(env, readConfigUpdateFile(t, output)))
+		})
+	}
+}
+
+func TestChannelConfigUpdateCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	modified := writeChannelConfig(t, "modified.json", transactiontest.ChannelConfig("Org1MSP"))
+	invalid := writeConfigUpdate(t, "invalid.json", someConfigUpdateEnvelope())
+
+	mockApp := &testApp{}
+	mockApp.On("ComputeConfigUpdate", mock.Anything, mock.Anything).
+		Return(nil, errors.New("cannot compute config update: no differences detected"))
+
+	cmd := newChannelConfigUpdateCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{modified})
+	require.EqualError(t, cmd.Execute(), "cannot compute config update: no differences detected")
+
+	cmd = newChannelConfigUpdateCommand(&CLIContext{App: mockApp})
+	cmd.SetArgs([]string{invalid})
+	require.ErrorContains(t, cmd.Execute(), "cannot decode channel config "+invalid)
+}
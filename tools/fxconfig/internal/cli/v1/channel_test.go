@@ -0,0 +1,105 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/protolator"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// writeChannelConfig writes the channel config as JSON and returns the path of the file.
+func writeChannelConfig(t *testing.T, name string, config *cb.Config) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, protolator.DeepMarshalJSON(&buf, config))
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	return path
+}
+
+// someConfigUpdateEnvelope returns a config update envelope of mychannel with the given signatures.
+func someConfigUpdateEnvelope(signatures ...string) *cb.ConfigUpdateEnvelope {
+	env := &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: protoutil.MarshalOrPanic(&cb.ConfigUpdate{ChannelId: "mychannel"}),
+	}
+	for _, sig := range signatures {
+		env.Signatures = append(env.Signatures, &cb.ConfigSignature{Signature: []byte(sig)})
+	}
+	return env
+}
+
+// writeConfigUpdate writes a config update envelope and returns the path of the file.
+func writeConfigUpdate(t *testing.T, name string, env *cb.ConfigUpdateEnvelope) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, protoutil.MarshalOrPanic(env), 0o600))
+	return path
+}
+
+// readConfigUpdateFile reads a config update envelope written by a command.
+func readConfigUpdateFile(t *testing.T, path string) *cb.ConfigUpdateEnvelope {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	env := &cb.ConfigUpdateEnvelope{}
+	require.NoError(t, proto.Unmarshal(data, env))
+	return env
+}
+
+func TestNewChannelRootCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewChannelRootCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "channel", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+
+	configCmd, _, err := cmd.Find([]string{"config"})
+	require.NoError(t, err)
+	require.Equal(t, "config", configCmd.Name())
+
+	subCmds := make(map[string]bool)
+	for _, sub := range configCmd.Commands() {
+		subCmds[sub.Name()] = true
+	}
+	require.True(t, subCmds["fetch"])
+	require.True(t, subCmds["update"])
+	require.True(t, subCmds["add-org"])
+	require.True(t, subCmds["sign"])
+	require.True(t, subCmds["merge"])
+	require.True(t, subCmds["submit"])
+}
+
+func TestReadConfigUpdate_Errors(t *testing.T) {
+	t.Parallel()
+
+	garbage := filepath.Join(t.TempDir(), "garbage.pb")
+	require.NoError(t, os.WriteFile(garbage, []byte("garbage"), 0o600))
+
+	cmd := newChannelConfigSignCommand(&CLIContext{App: &testApp{}})
+	cmd.SetArgs([]string{garbage})
+	require.ErrorContains(t, cmd.Execute(), "cannot decode "+garbage)
+
+	noChannel := writeConfigUpdate(t, "update.pb", &cb.ConfigUpdateEnvelope{})
+	cmd = newChannelConfigSignCommand(&CLIContext{App: &testApp{}})
+	cmd.SetArgs([]string{noChannel})
+	require.ErrorContains(t, cmd.Execute(), "invalid config update: missing channel ID")
+}
@@ -5,12 +5,20 @@
 package cliio
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 // Codec handles encoding and decoding of transactions for CLI I/O.
@@ -73,3 +81,195 @@ func (*JSONCodec) Decode(data []byte) (string, *applicationpb.Tx, error) {
 	txID := carrier.TxID
 	return txID, &tx, nil
 }
+
+// YAMLCodec implements Codec using the structure of JSONCodec rendered as YAML.
+type YAMLCodec struct{}
+
+// Encode converts a transaction to YAML format with transaction ID.
+func (*YAMLCodec) Encode(txID string, tx *applicationpb.Tx) ([]byte, error) {
+	b, err := (&JSONCodec{}).Encode(txID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var output map[string]any
+	if err := json.Unmarshal(b, &output); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(output)
+}
+
+// Decode parses YAML data into transaction ID and transaction.
+func (*YAMLCodec) Decode(data []byte) (string, *applicationpb.Tx, error) {
+	var carrier map[string]any
+	if err := yaml.Unmarshal(data, &carrier); err != nil {
+		return "", nil, err
+	}
+
+	b, err := json.Marshal(carrier)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return (&JSONCodec{}).Decode(b)
+}
+
+// ProtoCodec implements Codec using the binary protobuf encoding of the transaction.
+// The format carries no transaction ID: it is dropped on encoding, and decoding returns an empty ID.
+type ProtoCodec struct{}
+
+// Encode converts a transaction to its binary protobuf encoding.
+func (*ProtoCodec) Encode(_ string, tx *applicationpb.Tx) ([]byte, error) {
+	if tx == nil {
+		return nil, errors.New("tx is nil")
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(tx)
+}
+
+// Decode parses a binary protobuf transaction.
+func (*ProtoCodec) Decode(data []byte) (string, *applicationpb.Tx, error) {
+	var tx applicationpb.Tx
+	if err := proto.Unmarshal(data, &tx); err != nil {
+		return "", nil, err
+	}
+	return "", &tx, nil
+}
+
+// EnvelopeCodec implements Codec using the signed envelope submitted to the ordering service.
+// Encoded transactions are wrapped and signed as for submission, so that they can be broadcast
+// later by any tool. Decoding does not verify the envelope signature.
+type EnvelopeCodec struct {
+	// Channel is the channel name written to the channel header.
+	Channel string
+	// Signer returns the identity signing the envelope; it is only invoked when encoding.
+	Signer func() (msp.SigningIdentity, error)
+}
+
+// Encode wraps the transaction in a signed envelope and returns its binary protobuf encoding.
+func (c *EnvelopeCodec) Encode(txID string, tx *applicationpb.Tx) ([]byte, error) {
+	if tx == nil {
+		return nil, errors.New("tx is nil")
+	}
+	if c.Signer == nil {
+		return nil, errors.New("require Signer")
+	}
+
+	signer, err := c.Signer()
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := transaction.CreateSignedEnvelope(signer, c.Channel, txID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(env)
+}
+
+// Decode unwraps the transaction ID and transaction of a signed envelope.
+func (*EnvelopeCodec) Decode(data []byte) (string, *applicationpb.Tx, error) {
+	return transaction.UnwrapEnvelope(data)
+}
+
+// TxFormat specifies the file format of transactions.
+type TxFormat string
+
+// Define available transaction formats.
+const (
+	TxFormatJSON     TxFormat = "json"
+	TxFormatYAML     TxFormat = "yaml"
+	TxFormatProto    TxFormat = "proto"
+	TxFormatEnvelope TxFormat = "envelope"
+)
+
+// ParseTxFormat returns the transaction format with the given name.
+func ParseTxFormat(name string) (TxFormat, error) {
+	switch f := TxFormat(name); f {
+	case TxFormatJSON, TxFormatYAML, TxFormatProto, TxFormatEnvelope:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid transaction format: %s (want json|yaml|proto|envelope)", name)
+	}
+}
+
+// DetectTxFormat determines the format of an encoded transaction.
+// JSON objects are detected by their leading brace, and envelopes by decoding them; other
+// printable text is taken as YAML, and any other data as binary protobuf. Empty input is
+// reported as JSON, which fails to decode.
+func DetectTxFormat(data []byte) TxFormat {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case len(trimmed) == 0 || bytes.HasPrefix(trimmed, []byte("{")):
+		return TxFormatJSON
+	case isEnvelope(data):
+		return TxFormatEnvelope
+	case isText(trimmed):
+		return TxFormatYAML
+	default:
+		return TxFormatProto
+	}
+}
+
+func isEnvelope(data []byte) bool {
+	_, _, err := transaction.UnwrapEnvelope(data)
+	return err == nil
+}
+
+func isText(data []byte) bool {
+	if !utf8.Valid(data) {
+		return false
+	}
+	for _, r := range string(data) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// AutoCodec implements Codec by encoding transactions with Encoder and decoding transactions
+// in any of the supported formats, as detected by DetectTxFormat.
+type AutoCodec struct {
+	Encoder Codec
+}
+
+// NewAutoCodec returns a codec encoding transactions in the given format.
+// The envelope codec is used to encode the envelope format.
+func NewAutoCodec(format TxFormat, envelope *EnvelopeCodec) (*AutoCodec, error) {
+	switch format {
+	case TxFormatJSON:
+		return &AutoCodec{Encoder: &JSONCodec{}}, nil
+	case TxFormatYAML:
+		return &AutoCodec{Encoder: &YAMLCodec{}}, nil
+	case TxFormatProto:
+		return &AutoCodec{Encoder: &ProtoCodec{}}, nil
+	case TxFormatEnvelope:
+		if envelope == nil {
+			envelope = &EnvelopeCodec{}
+		}
+		return &AutoCodec{Encoder: envelope}, nil
+	default:
+		return nil, fmt.Errorf("invalid transaction format: %s", format)
+	}
+}
+
+// Encode converts a transaction with the configured encoder.
+func (c *AutoCodec) Encode(txID string, tx *applicationpb.Tx) ([]byte, error) {
+	return c.Encoder.Encode(txID, tx)
+}
+
+// Decode detects the format of data and parses it into transaction ID and transaction.
+func (*AutoCodec) Decode(data []byte) (string, *applicationpb.Tx, error) {
+	switch DetectTxFormat(data) {
+	case TxFormatJSON:
+		return (&JSONCodec{}).Decode(data)
+	case TxFormatEnvelope:
+		return (&EnvelopeCodec{}).Decode(data)
+	case TxFormatYAML:
+		return (&YAMLCodec{}).Decode(data)
+	default:
+		return (&ProtoCodec{}).Decode(data)
+	}
+}
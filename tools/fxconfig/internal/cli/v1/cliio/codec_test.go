@@ -6,11 +6,16 @@ package cliio
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	fmsp "github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/msp"
 )
 
 func TestJSONCodec_Encode(t *testing.T) {
@@ -187,3 +192,144 @@ func TestJSONCodec_RoundTrip(t *testing.T) {
 	require.Equal(t, tx.GetNamespaces()[0].GetNsId(), decodedTx.GetNamespaces()[0].GetNsId())
 	require.Equal(t, tx.GetNamespaces()[0].GetBlindWrites()[0], decodedTx.GetNamespaces()[0].GetBlindWrites()[0])
 }
+
+func someCodecTx() *applicationpb.Tx {
+	return &applicationpb.Tx{
+		Namespaces: []*applicationpb.TxNamespace{{
+			NsId:      "some_namespace",
+			NsVersion: 1,
+			BlindWrites: []*applicationpb.Write{{
+				Key:   []byte("key"),
+				Value: []byte("value"),
+			}},
+		}},
+	}
+}
+
+// testEnvelopeCodec returns an envelope codec signing with the MSP testdata identity.
+func testEnvelopeCodec() *EnvelopeCodec {
+	return &EnvelopeCodec{
+		Channel: "mychannel",
+		Signer: func() (fmsp.SigningIdentity, error) {
+			return msp.GetSignerIdentityFromMSP(config.MSPConfig{
+				LocalMspID: "Org1MSP",
+				ConfigPath: "../../../msp/testdata/msp",
+			})
+		},
+	}
+}
+
+func TestCodecs_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		codec    Codec
+		format   TxFormat
+		wantTxID string
+	}{
+		{name: "json", codec: &JSONCodec{}, format: TxFormatJSON, wantTxID: "tx-1"},
+		{name: "yaml", codec: &YAMLCodec{}, format: TxFormatYAML, wantTxID: "tx-1"},
+		// the proto format carries no transaction ID
+		{name: "proto", codec: &ProtoCodec{}, format: TxFormatProto, wantTxID: ""},
+		{name: "envelope", codec: testEnvelopeCodec(), format: TxFormatEnvelope, wantTxID: "tx-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := someCodecTx()
+			data, err := tt.codec.Encode("tx-1", tx)
+			require.NoError(t, err)
+
+			txID, decoded, err := tt.codec.Decode(data)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTxID, txID)
+			require.True(t, proto.Equal(tx, decoded))
+
+			// the auto codec detects the format
+			require.Equal(t, tt.format, DetectTxFormat(data))
+			txID, decoded, err = (&AutoCodec{}).Decode(data)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTxID, txID)
+			require.True(t, proto.Equal(tx, decoded))
+		})
+	}
+}
+
+func TestYAMLCodec_Encode(t *testing.T) {
+	t.Parallel()
+
+	data, err := (&YAMLCodec{}).Encode("tx-1", someCodecTx())
+	require.NoError(t, err)
+	require.Contains(t, string(data), "txID: tx-1\n")
+	require.Contains(t, string(data), "ns_id: some_namespace\n")
+}
+
+func TestEnvelopeCodec_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&EnvelopeCodec{}).Encode("tx-1", someCodecTx())
+	require.EqualError(t, err, "require Signer")
+
+	codec := &EnvelopeCodec{Signer: func() (fmsp.SigningIdentity, error) {
+		return nil, errors.New("msp setup error")
+	}}
+	_, err = codec.Encode("tx-1", someCodecTx())
+	require.EqualError(t, err, "msp setup error")
+
+	_, err = testEnvelopeCodec().Encode("tx-1", nil)
+	require.EqualError(t, err, "tx is nil")
+
+	_, _, err = codec.Decode([]byte("txID: tx-1"))
+	require.ErrorContains(t, err, "invalid envelope")
+}
+
+func TestParseTxFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"json", "yaml", "proto", "envelope"} {
+		f, err := ParseTxFormat(name)
+		require.NoError(t, err)
+		require.Equal(t, TxFormat(name), f)
+	}
+
+	_, err := ParseTxFormat("xml")
+	require.EqualError(t, err, "invalid transaction format: xml (want json|yaml|proto|envelope)")
+}
+
+func TestNewAutoCodec(t *testing.T) {
+	t.Parallel()
+
+	codec, err := NewAutoCodec(TxFormatYAML, nil)
+	require.NoError(t, err)
+	require.IsType(t, &YAMLCodec{}, codec.Encoder)
+
+	// the envelope format cannot be encoded without a signer
+	codec, err = NewAutoCodec(TxFormatEnvelope, nil)
+	require.NoError(t, err)
+	_, err = codec.Encode("tx-1", someCodecTx())
+	require.EqualError(t, err, "require Signer")
+
+	_, err = NewAutoCodec("xml", nil)
+	require.Error(t, err)
+}
+
+func TestAutoCodec_Decode_Errors(t *testing.T) {
+	t.Parallel()
+
+	codec := &AutoCodec{}
+
+	_, _, err := codec.Decode(nil)
+	require.Error(t, err)
+
+	_, _, err = codec.Decode([]byte("{invalid json}"))
+	require.Error(t, err)
+
+	_, _, err = codec.Decode([]byte("- not a transaction"))
+	require.Error(t, err)
+
+	_, _, err = codec.Decode([]byte{0xff, 0xff, 0xff})
+	require.Error(t, err)
+}
@@ -5,6 +5,8 @@
 package cliio
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -30,35 +32,175 @@ func (f *IOFlags) Bind(cmd *cobra.Command) {
 }
 
 // ResolveInput reads input from file or stdin with size limits and security checks.
+// An input file "-" reads from stdin.
 func ResolveInput(cmd *cobra.Command, inputFile string) ([]byte, error) {
 	pipe := isInputFromPipe()
 	switch {
+	case inputFile == "-":
+		return ReadWithLimit(cmd.InOrStdin(), defaultMaxInputSize)
 	case inputFile != "" && pipe:
 		return nil, errors.New("cannot use --input and stdin together")
 	case inputFile != "":
-		// Prevent path traversal
-		inputFile = filepath.Clean(inputFile)
-		if strings.Contains(inputFile, "..") {
-			return nil, errors.New("path traversal not allowed")
+		return ReadFile(inputFile)
+	case pipe:
+		return ReadWithLimit(cmd.InOrStdin(), defaultMaxInputSize)
+	default:
+		return nil, errors.New("no input provided (use --input or pipe data via stdin)")
+	}
+}
+
+// Input is a single document read from a CLI input source.
+type Input struct {
+	// Source describes where the document was read from, e.g., "tx.json" or "batch.jsonl:3".
+	Source string
+	Data   []byte
+}
+
+// ResolveInputs expands CLI arguments into individual input documents.
+// Each argument is one of:
+//   - a file containing a single document
+//   - a JSONL file (.jsonl extension) containing one document per line
+//   - a directory, whose regular files are read in lexical order (non-recursive), skipping hidden
+//     files; .jsonl files are split as above, other files are single documents in any format
+//   - "-" to read from stdin: a JSONL stream if every line is a JSON document, otherwise a
+//     single document in any format
+func ResolveInputs(cmd *cobra.Command, args []string) ([]Input, error) {
+	var inputs []Input
+	for _, arg := range args {
+		in, err := resolveArg(cmd, arg)
+		if err != nil {
+			return nil, err
 		}
-		// read from file
-		file, err := os.Open(inputFile)
+		inputs = append(inputs, in...)
+	}
+
+	if len(inputs) == 0 {
+		return nil, errors.New("no input provided")
+	}
+
+	return inputs, nil
+}
+
+func resolveArg(cmd *cobra.Command, arg string) ([]Input, error) {
+	if arg == "-" {
+		data, err := ReadWithLimit(cmd.InOrStdin(), defaultMaxInputSize)
 		if err != nil {
 			return nil, err
 		}
-		defer func() {
-			_ = file.Close()
-		}()
-		// check file size
-		if info, err := file.Stat(); err == nil && info.Size() > defaultMaxInputSize {
-			return nil, fmt.Errorf("input file exceeds maximum allowed size of %d bytes", defaultMaxInputSize)
+		if isJSONLines(data) {
+			return splitLines("stdin", data), nil
 		}
-		return ReadWithLimit(file, defaultMaxInputSize)
-	case pipe:
-		return ReadWithLimit(cmd.InOrStdin(), defaultMaxInputSize)
-	default:
-		return nil, errors.New("no input provided (use --input or pipe data via stdin)")
+		return []Input{{Source: "stdin", Data: data}}, nil
+	}
+
+	arg, err := cleanPath(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return resolveFile(arg)
+	}
+
+	entries, err := os.ReadDir(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []Input
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		in, err := resolveFile(filepath.Join(arg, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, in...)
+	}
+
+	return inputs, nil
+}
+
+const jsonlExt = ".jsonl"
+
+func resolveFile(path string) ([]Input, error) {
+	data, err := ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(path) == jsonlExt {
+		return splitLines(path, data), nil
+	}
+
+	return []Input{{Source: path, Data: data}}, nil
+}
+
+// isJSONLines reports whether data is line-delimited JSON, i.e., every non-empty line is a
+// JSON document on its own. A pretty-printed JSON document, YAML, or binary data is not.
+func isJSONLines(data []byte) bool {
+	if DetectTxFormat(data) != TxFormatJSON {
+		return false
+	}
+	for line := range bytes.Lines(data) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && !json.Valid(line) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitLines splits a JSONL stream into one input per non-empty line.
+func splitLines(source string, data []byte) []Input {
+	var inputs []Input
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		inputs = append(inputs, Input{
+			Source: fmt.Sprintf("%s:%d", source, i+1),
+			Data:   []byte(line),
+		})
+	}
+	return inputs
+}
+
+// ReadFile reads a file with size limits and security checks.
+func ReadFile(path string) ([]byte, error) {
+	path, err := cleanPath(path)
+	if err != nil {
+		return nil, err
+	}
+	// read from file
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	// check file size
+	if info, err := file.Stat(); err == nil && info.Size() > defaultMaxInputSize {
+		return nil, fmt.Errorf("input file exceeds maximum allowed size of %d bytes", defaultMaxInputSize)
+	}
+	return ReadWithLimit(file, defaultMaxInputSize)
+}
+
+// cleanPath cleans path and rejects path traversal.
+func cleanPath(path string) (string, error) {
+	path = filepath.Clean(path)
+	if strings.Contains(path, "..") {
+		return "", errors.New("path traversal not allowed")
 	}
+	return path, nil
 }
 
 // WriteOutput writes data to file or stdout.
@@ -101,6 +101,16 @@ func TestResolveInput_NoInput(t *testing.T) {
 	require.Contains(t, err.Error(), "no input provided")
 }
 
+func TestResolveInput_Stdin(t *testing.T) {
+	t.Parallel()
+
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(`{"txID": "tx-1"}`))
+	data, err := ResolveInput(cmd, "-")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"txID": "tx-1"}`, string(data))
+}
+
 func TestWriteOutput_ToFile(t *testing.T) {
 	t.Parallel()
 
@@ -190,3 +200,86 @@ func TestReadWithLimit(t *testing.T) {
 		require.Empty(t, data)
 	})
 }
+
+func TestResolveInputs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(`{"b":1}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.jsonl"), []byte("{\"a\":1}\n\n{\"a\":2}\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.yaml"), []byte("txID: tx-1\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "d.pb"), []byte("\x0a\x04tx-1"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("ignored"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested"), 0o700))
+
+	single := filepath.Join(t.TempDir(), "tx.json")
+	require.NoError(t, os.WriteFile(single, []byte(`{"c":1}`), 0o600))
+
+	t.Run("files, directories and jsonl", func(t *testing.T) {
+		t.Parallel()
+
+		inputs, err := ResolveInputs(&cobra.Command{}, []string{single, dir})
+		require.NoError(t, err)
+		require.Len(t, inputs, 6)
+
+		require.Equal(t, single, inputs[0].Source)
+		require.Equal(t, filepath.Join(dir, "a.jsonl")+":1", inputs[1].Source)
+		require.JSONEq(t, `{"a":1}`, string(inputs[1].Data))
+		require.Equal(t, filepath.Join(dir, "a.jsonl")+":3", inputs[2].Source)
+		require.Equal(t, filepath.Join(dir, "b.json"), inputs[3].Source)
+		// files of any format are read; the codec decides how to decode them
+		require.Equal(t, filepath.Join(dir, "c.yaml"), inputs[4].Source)
+		require.Equal(t, filepath.Join(dir, "d.pb"), inputs[5].Source)
+		require.Equal(t, []byte("\x0a\x04tx-1"), inputs[5].Data)
+	})
+
+	t.Run("jsonl from stdin", func(t *testing.T) {
+		t.Parallel()
+
+		cmd := &cobra.Command{}
+		cmd.SetIn(strings.NewReader("{\"x\":1}\n{\"x\":2}"))
+
+		inputs, err := ResolveInputs(cmd, []string{"-"})
+		require.NoError(t, err)
+		require.Len(t, inputs, 2)
+		require.Equal(t, "stdin:2", inputs[1].Source)
+	})
+
+	t.Run("single document from stdin", func(t *testing.T) {
+		t.Parallel()
+
+		for name, data := range map[string]string{
+			"pretty-printed json": "{\n  \"x\": 1\n}\n",
+			"yaml":                "txID: tx-1\ntx:\n  namespaces: []\n",
+			"binary":              "\x0a\x04tx-1\n\x12\x00",
+		} {
+			cmd := &cobra.Command{}
+			cmd.SetIn(strings.NewReader(data))
+
+			inputs, err := ResolveInputs(cmd, []string{"-"})
+			require.NoError(t, err, name)
+			require.Equal(t, []Input{{Source: "stdin", Data: []byte(data)}}, inputs, name)
+		}
+	})
+
+	t.Run("error with empty directory", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ResolveInputs(&cobra.Command{}, []string{t.TempDir()})
+		require.ErrorContains(t, err, "no input provided")
+	})
+
+	t.Run("error with path traversal", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ResolveInputs(&cobra.Command{}, []string{"../../etc"})
+		require.ErrorContains(t, err, "path traversal not allowed")
+	})
+
+	t.Run("error with non-existent path", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ResolveInputs(&cobra.Command{}, []string{"/non/existent"})
+		require.Error(t, err)
+	})
+}
@@ -5,9 +5,13 @@
 package cliio
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
 
 	"gopkg.in/yaml.v3"
 )
@@ -20,8 +24,57 @@ const (
 	FormatTable Format = "table"
 	FormatJSON  Format = "json"
 	FormatYAML  Format = "yaml"
+	// FormatJSONL prints one compact JSON document per line, for output that is streamed.
+	FormatJSONL Format = "jsonl"
 )
 
+// QueryFormats are the output formats of commands printing the result of a query.
+var QueryFormats = []Format{FormatTable, FormatJSON, FormatYAML}
+
+// StreamFormats are the output formats of commands printing results as they arrive.
+var StreamFormats = []Format{FormatTable, FormatJSONL}
+
+// ParseFormat returns the format with the given name, which must be one of the supported formats.
+func ParseFormat(name string, supported []Format) (Format, error) {
+	if f := Format(name); slices.Contains(supported, f) {
+		return f, nil
+	}
+	return "", fmt.Errorf("invalid format: %s (want %s)", name, JoinFormats(supported))
+}
+
+// JoinFormats lists formats as in the help of a format flag, e.g., table|json|yaml.
+func JoinFormats(formats []Format) string {
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = string(f)
+	}
+	return strings.Join(names, "|")
+}
+
+// Marshal encodes v as indented JSON, as a line of compact JSON, or as YAML.
+func Marshal(format Format, v any) ([]byte, error) {
+	switch format {
+	case FormatJSONL:
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatJSON:
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case FormatYAML:
+		return yaml.Marshal(v)
+	default:
+		return nil, fmt.Errorf("cannot marshal to format: %s", format)
+	}
+}
+
 // Printer handles formatted output for CLI commands.
 type Printer interface {
 	Print(v any)
@@ -53,12 +106,8 @@ func (p *CLIPrinter) Print(v any) {
 
 func (p *CLIPrinter) print(v any) error {
 	switch p.format {
-	case FormatJSON:
-		enc := json.NewEncoder(p.out)
-		enc.SetIndent("", "  ")
-		return enc.Encode(v)
-	case FormatYAML:
-		data, err := yaml.Marshal(v)
+	case FormatJSON, FormatYAML:
+		data, err := Marshal(p.format, v)
 		if err != nil {
 			return err
 		}
@@ -84,3 +133,15 @@ func (p *CLIPrinter) PrintError(err error) {
 		"error": err.Error(),
 	})
 }
+
+// RenderTable renders rows as a tab-aligned table with the given header.
+func RenderTable(header []string, rows [][]string) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	_ = w.Flush()
+	return buf.String()
+}
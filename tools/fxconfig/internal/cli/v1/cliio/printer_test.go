@@ -196,4 +196,56 @@ func TestFormat_Constants(t *testing.T) {
 	require.Equal(t, FormatTable, Format("table"))
 	require.Equal(t, FormatJSON, Format("json"))
 	require.Equal(t, FormatYAML, Format("yaml"))
+	require.Equal(t, FormatJSONL, Format("jsonl"))
+}
+
+func TestRenderTable(t *testing.T) {
+	t.Parallel()
+
+	out := RenderTable([]string{"NAME", "VERSION"}, [][]string{{"payments", "0"}, {"ns", "12"}})
+
+	require.Equal(t, "NAME      VERSION\npayments  0\nns        12\n", out)
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"table", "json", "yaml"} {
+		f, err := ParseFormat(name, QueryFormats)
+		require.NoError(t, err)
+		require.Equal(t, Format(name), f)
+	}
+
+	_, err := ParseFormat("xml", QueryFormats)
+	require.ErrorContains(t, err, "invalid format: xml (want table|json|yaml)")
+
+	f, err := ParseFormat("jsonl", StreamFormats)
+	require.NoError(t, err)
+	require.Equal(t, FormatJSONL, f)
+
+	_, err = ParseFormat("jsonl", QueryFormats)
+	require.ErrorContains(t, err, "invalid format: jsonl (want table|json|yaml)")
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	v := struct {
+		Name string `json:"name" yaml:"name"`
+	}{Name: "ns1"}
+
+	data, err := Marshal(FormatJSON, v)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"ns1"}`, string(data))
+
+	data, err = Marshal(FormatJSONL, v)
+	require.NoError(t, err)
+	require.Equal(t, "{\"name\":\"ns1\"}\n", string(data))
+
+	data, err = Marshal(FormatYAML, v)
+	require.NoError(t, err)
+	require.Equal(t, "name: ns1\n", string(data))
+
+	_, err = Marshal(FormatTable, v)
+	require.Error(t, err)
 }
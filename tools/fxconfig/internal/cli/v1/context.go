@@ -5,6 +5,8 @@
 package v1
 
 import (
+	"io"
+
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
@@ -17,6 +19,9 @@ type CLIContext struct {
 	Config             *config.Config
 	Printer            cliio.Printer
 	IOTransactionCodec cliio.Codec
+	// ErrOut receives diagnostics, such as warnings, of the executing command; it is the
+	// error writer of the command.
+	ErrOut io.Writer
 	// Logger  logger.Logger
 
 	App app.Application
@@ -0,0 +1,240 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// NewEventsCommand creates a command for streaming the transactions of committed blocks.
+func NewEventsCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		from       uint64
+		to         int64
+		namespaces []string
+		checkpoint string
+		format     formatFlag
+	)
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Stream the transactions of committed blocks",
+		Long: `Stream committed blocks from the deliver service of the committer sidecar
+(notifications endpoint) and print every transaction with its validation status.
+
+For each transaction, displays:
+  • Block number and transaction number within the block
+  • Transaction ID
+  • Validation status recorded by the committer (e.g., COMMITTED, ABORTED_MVCC_CONFLICT)
+  • Namespaces read or written by the transaction
+
+Without --to, new blocks are streamed as they are committed until the command is
+interrupted. With --to, the command ends after that block, waiting for it to be
+committed if necessary. --namespace reports only transactions touching any of the
+given namespaces; namespace deployments touch the meta-namespace _meta.
+
+With --checkpoint, the number of the next block to stream is written to the given
+file after every block, and a later run without --from resumes from it. If the
+checkpoint is already past --to, there is nothing left to stream.
+
+Output formats (--format):
+  • table - one row per transaction (default)
+  • jsonl - one JSON object per transaction and line
+
+Examples:
+  # Follow all new transactions, starting at the genesis block
+  fxconfig events
+
+  # Print the transactions of blocks 100 to 200 touching namespace payments
+  fxconfig events --from=100 --to=200 --namespace=payments
+
+  # Tail transactions as JSON lines and resume after a restart
+  fxconfig events --checkpoint=events.checkpoint --format=jsonl`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.StreamFormats)
+			if err != nil {
+				return err
+			}
+
+			input := app.EventsInput{
+				FromBlock:  from,
+				Namespaces: namespaces,
+			}
+			if to >= 0 {
+				last := uint64(to)
+				input.ToBlock = &last
+			}
+			if checkpoint != "" && !cmd.Flags().Changed("from") {
+				next, ok, err := readCheckpoint(checkpoint)
+				if err != nil {
+					return err
+				}
+				if ok {
+					input.FromBlock = next
+				}
+				if ok && input.ToBlock != nil && next > *input.ToBlock {
+					// a previous run already streamed all blocks up to --to
+					return nil
+				}
+			}
+
+			if f == cliio.FormatTable {
+				ctx.Printer.Print(eventsTableRow("BLOCK", "TXNUM", "TXID", "STATUS", "NAMESPACES"))
+			}
+
+			return ctx.App.StreamEvents(cmd.Context(), &input, func(events *app.BlockEvents) error {
+				for _, e := range events.Txs {
+					if f == cliio.FormatTable {
+						ctx.Printer.Print(eventsTableRow(
+							strconv.FormatUint(events.BlockNum, 10),
+							strconv.FormatUint(uint64(e.TxNum), 10),
+							e.TxID,
+							e.Status.String(),
+							eventNamespaces(e),
+						))
+						continue
+					}
+
+					data, err := cliio.Marshal(f, newTxEventView(events.BlockNum, e))
+					if err != nil {
+						return err
+					}
+					ctx.Printer.Print(string(data))
+				}
+
+				if checkpoint != "" {
+					return writeCheckpoint(checkpoint, events.BlockNum+1)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(&from, "from", 0, "First block to stream")
+	cmd.Flags().Int64Var(&to, "to", -1, "Last block to stream (-1 follows new blocks)")
+	cmd.Flags().StringSliceVar(&namespaces, "namespace", nil,
+		"Only report transactions touching this namespace (repeatable)")
+	cmd.Flags().StringVar(&checkpoint, "checkpoint", "",
+		"Checkpoint file recording the next block to stream; resumes from it if --from is not set")
+	format.bindFormats(cmd, cliio.StreamFormats)
+
+	return cmd
+}
+
+// eventsTableRow renders a row of the events table. Blocks are printed as they arrive, hence the
+// columns have a fixed width instead of being aligned to their content.
+func eventsTableRow(block, txNum, txID, status, namespaces string) string {
+	return fmt.Sprintf("%-8s  %-6s  %-64s  %-25s  %s\n", block, txNum, txID, status, namespaces)
+}
+
+// eventNamespaces describes the namespaces of a transaction in the events table.
+func eventNamespaces(e app.TxEvent) string {
+	switch {
+	case e.Err != nil:
+		return "<undecodable>"
+	case e.HeaderType != cb.HeaderType_MESSAGE:
+		return "[" + e.HeaderType.String() + "]"
+	default:
+		return strings.Join(e.Namespaces, ",")
+	}
+}
+
+// txEventView is the JSON representation of a transaction of a committed block.
+type txEventView struct {
+	BlockNum uint64 `json:"block"`
+	TxNum    uint32 `json:"txNum"`
+	TxID     string `json:"txID"`
+	// Type is the header type of the envelope, e.g., MESSAGE for application transactions or CONFIG.
+	// It is omitted if the envelope cannot be decoded.
+	Type       string   `json:"type,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+	Status     string   `json:"status"`
+	Code       int32    `json:"code"`
+	Error      string   `json:"error,omitempty"`
+}
+
+func newTxEventView(blockNum uint64, e app.TxEvent) txEventView {
+	v := txEventView{
+		BlockNum:   blockNum,
+		TxNum:      e.TxNum,
+		TxID:       e.TxID,
+		Type:       e.HeaderType.String(),
+		Namespaces: e.Namespaces,
+		Status:     e.Status.String(),
+		Code:       int32(e.Status),
+	}
+	if e.Err != nil {
+		v.Type = ""
+		v.Error = e.Err.Error()
+	}
+	return v
+}
+
+// eventsCheckpoint is the content of a checkpoint file.
+type eventsCheckpoint struct {
+	NextBlock uint64 `json:"nextBlock"`
+}
+
+// readCheckpoint returns the next block to stream recorded in a checkpoint file;
+// false if the file does not exist yet.
+func readCheckpoint(path string) (uint64, bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("cannot read checkpoint: %w", err)
+	}
+
+	var c eventsCheckpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return 0, false, fmt.Errorf("cannot decode checkpoint %s: %w", path, err)
+	}
+	return c.NextBlock, true, nil
+}
+
+// writeCheckpoint records the next block to stream. The checkpoint is written to a temporary
+// file first and then renamed, so that an interrupt never leaves a partial checkpoint behind.
+func writeCheckpoint(path string, next uint64) error {
+	data, err := json.Marshal(eventsCheckpoint{NextBlock: next})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("cannot write checkpoint: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("cannot write checkpoint: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cannot write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("cannot write checkpoint: %w", err)
+	}
+	return nil
+}
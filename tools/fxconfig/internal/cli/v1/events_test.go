@@ -0,0 +1,235 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+func someBlockEvents() []*app.BlockEvents {
+	return []*app.BlockEvents{
+		{BlockNum: 7, Txs: []app.TxEvent{
+			{TxNum: 0, TxID: "tx-config", HeaderType: cb.HeaderType_CONFIG, Status: committerpb.Status_COMMITTED},
+		}},
+		{BlockNum: 8},
+		{BlockNum: 9, Txs: []app.TxEvent{
+			{
+				TxNum: 0, TxID: "tx-pay", HeaderType: cb.HeaderType_MESSAGE,
+				Namespaces: []string{"payments", "assets"}, Status: committerpb.Status_COMMITTED,
+			},
+			{TxNum: 1, Status: committerpb.Status_MALFORMED_BAD_ENVELOPE, Err: errors.New("bad envelope")},
+		}},
+	}
+}
+
+func newTestEventsCommand(mockApp *testApp, out *bytes.Buffer, args ...string) error {
+	cmd := NewEventsCommand(&CLIContext{
+		App:     mockApp,
+		Printer: cliio.NewCLIPrinter(out, out, cliio.FormatTable),
+	})
+	cmd.SetArgs(args)
+	return cmd.Execute()
+}
+
+func TestEventsCommand_Table(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("StreamEvents", mock.Anything, mock.MatchedBy(func(in *app.EventsInput) bool {
+		return in.FromBlock == 7 && in.ToBlock != nil && *in.ToBlock == 9 &&
+			strings.Join(in.Namespaces, ",") == "payments,assets"
+	})).Return(someBlockEvents(), nil)
+
+	var out bytes.Buffer
+	err := newTestEventsCommand(mockApp, &out, "--from=7", "--to=9", "--namespace=payments", "--namespace=assets")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	require.Equal(t, []string{"BLOCK", "TXNUM", "TXID", "STATUS", "NAMESPACES"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"7", "0", "tx-config", "COMMITTED", "[CONFIG]"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"9", "0", "tx-pay", "COMMITTED", "payments,assets"}, strings.Fields(lines[2]))
+	require.Equal(t, []string{"9", "1", "MALFORMED_BAD_ENVELOPE", "<undecodable>"}, strings.Fields(lines[3]))
+	mockApp.AssertExpectations(t)
+}
+
+func TestEventsCommand_JSONL(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("StreamEvents", mock.Anything, mock.MatchedBy(func(in *app.EventsInput) bool {
+		// without --to, new blocks are followed
+		return in.FromBlock == 0 && in.ToBlock == nil && len(in.Namespaces) == 0
+	})).Return(someBlockEvents(), nil)
+
+	var out bytes.Buffer
+	require.NoError(t, newTestEventsCommand(mockApp, &out, "--format=jsonl"))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+
+	var decoded []map[string]any
+	for _, line := range lines {
+		var v map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &v))
+		decoded = append(decoded, v)
+	}
+	require.Equal(t, []map[string]any{
+		{
+			"block": float64(7), "txNum": float64(0), "txID": "tx-config", "type": "CONFIG",
+			"status": "COMMITTED", "code": float64(1),
+		},
+		{
+			"block": float64(9), "txNum": float64(0), "txID": "tx-pay", "type": "MESSAGE",
+			"namespaces": []any{"payments", "assets"}, "status": "COMMITTED", "code": float64(1),
+		},
+		{
+			"block": float64(9), "txNum": float64(1), "txID": "",
+			"status": "MALFORMED_BAD_ENVELOPE", "code": float64(101), "error": "bad envelope",
+		},
+	}, decoded)
+}
+
+func TestEventsCommand_Checkpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		checkpoint string
+		args       []string
+		expectFrom uint64
+	}{
+		{
+			name:       "no checkpoint yet",
+			expectFrom: 3,
+			args:       []string{"--from=3"},
+		},
+		{
+			name:       "resume",
+			checkpoint: `{"nextBlock": 5}`,
+			expectFrom: 5,
+		},
+		{
+			name:       "from overrides checkpoint",
+			checkpoint: `{"nextBlock": 5}`,
+			args:       []string{"--from=3"},
+			expectFrom: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "events.checkpoint")
+			if tt.checkpoint != "" {
+				require.NoError(t, os.WriteFile(path, []byte(tt.checkpoint), 0o600))
+			}
+
+			mockApp := &testApp{}
+			mockApp.On("StreamEvents", mock.Anything, mock.MatchedBy(func(in *app.EventsInput) bool {
+				return in.FromBlock == tt.expectFrom
+			})).Return(someBlockEvents(), nil)
+
+			var out bytes.Buffer
+			err := newTestEventsCommand(mockApp, &out, append(tt.args, "--checkpoint", path)...)
+			require.NoError(t, err)
+			mockApp.AssertExpectations(t)
+
+			// the checkpoint records the block after the last streamed block
+			next, ok, err := readCheckpoint(path)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, uint64(10), next)
+
+			entries, err := os.ReadDir(filepath.Dir(path))
+			require.NoError(t, err)
+			require.Len(t, entries, 1, "temporary checkpoint files must be removed")
+		})
+	}
+}
+
+func TestEventsCommand_CheckpointPastTo(t *testing.T) {
+	t.Parallel()
+
+	// a previous bounded run already streamed blocks up to 9
+	path := filepath.Join(t.TempDir(), "events.checkpoint")
+	require.NoError(t, os.WriteFile(path, []byte(`{"nextBlock": 10}`), 0o600))
+
+	mockApp := &testApp{}
+	var out bytes.Buffer
+	require.NoError(t, newTestEventsCommand(mockApp, &out, "--to=9", "--checkpoint", path))
+	require.Empty(t, out.String())
+	mockApp.AssertNotCalled(t, "StreamEvents", mock.Anything, mock.Anything)
+
+	next, ok, err := readCheckpoint(path)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), next)
+}
+
+func TestEventsCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	corrupt := filepath.Join(t.TempDir(), "corrupt.checkpoint")
+	require.NoError(t, os.WriteFile(corrupt, []byte("not json"), 0o600))
+
+	tests := []struct {
+		name        string
+		args        []string
+		appErr      error
+		expectError string
+	}{
+		{
+			name:        "invalid format",
+			args:        []string{"--format=yaml"},
+			expectError: "invalid format: yaml (want table|jsonl)",
+		},
+		{
+			name:        "corrupt checkpoint",
+			args:        []string{"--checkpoint", corrupt},
+			expectError: "cannot decode checkpoint " + corrupt,
+		},
+		{
+			name:        "checkpoint in missing directory",
+			args:        []string{"--checkpoint", filepath.Join(t.TempDir(), "missing", "events.checkpoint")},
+			expectError: "cannot write checkpoint",
+		},
+		{
+			name:        "app error",
+			appErr:      errors.New("cannot stream blocks: unavailable"),
+			expectError: "cannot stream blocks: unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("StreamEvents", mock.Anything, mock.Anything).Return(someBlockEvents(), tt.appErr)
+
+			var out bytes.Buffer
+			err := newTestEventsCommand(mockApp, &out, tt.args...)
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+)
+
+// Exit codes of fxconfig. Commands that wait for transactions report their outcome in the exit
+// code; if transactions end differently, the lowest non-zero code applies.
+const (
+	// ExitOK indicates success, e.g., that all transactions committed.
+	ExitOK = 0
+	// ExitError indicates that the command failed, e.g., on invalid input, an unavailable service,
+	// or a transaction that could not be submitted.
+	ExitError = 1
+	// ExitTxRejected indicates that a transaction was finalized with a status other than COMMITTED.
+	ExitTxRejected = 2
+	// ExitTxTimedOut indicates that a transaction was not finalized within the waiting timeout.
+	ExitTxTimedOut = 3
+	// ExitTxNotFound indicates that a transaction is not known to the committer, e.g., not committed yet.
+	ExitTxNotFound = 4
+)
+
+// exitError is an error of a command that maps to a specific exit code.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// ExitCode returns the exit code of fxconfig for the error returned by a command.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var e *exitError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return ExitError
+}
+
+// statusExitCode returns the exit code of a transaction status.
+func statusExitCode(s adapters.TxStatus) int {
+	switch {
+	case s.Committed():
+		return ExitOK
+	case s.State == adapters.TxStateFinal:
+		return ExitTxRejected
+	case s.State == adapters.TxStateTimedOut:
+		return ExitTxTimedOut
+	case s.State == adapters.TxStateNotFound:
+		return ExitTxNotFound
+	default:
+		return ExitError
+	}
+}
+
+// resultsExitCode returns the exit code of a batch of transactions: the lowest non-zero exit code
+// of any transaction, where a transaction with an error maps to ExitError.
+func resultsExitCode(results []app.TxSubmissionResult) int {
+	code := ExitOK
+	for _, r := range results {
+		c := statusExitCode(r.Status)
+		if r.Err != nil {
+			c = ExitError
+		}
+		if c != ExitOK && (code == ExitOK || c < code) {
+			code = c
+		}
+	}
+	return code
+}
+
+// statusLabel returns the committer status of a finalized transaction, e.g., COMMITTED,
+// or TIMED_OUT, NOT_FOUND, or UNKNOWN otherwise.
+func statusLabel(s adapters.TxStatus) string {
+	switch s.State {
+	case adapters.TxStateFinal:
+		return s.Status.String()
+	case adapters.TxStateTimedOut:
+		return "TIMED_OUT"
+	case adapters.TxStateNotFound:
+		return "NOT_FOUND"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// positionColumns returns the block number and the transaction number within the block of a
+// finalized transaction; empty otherwise.
+func positionColumns(s adapters.TxStatus) (string, string) {
+	if s.State != adapters.TxStateFinal {
+		return "", ""
+	}
+	return strconv.FormatUint(s.BlockNum, 10), strconv.FormatUint(uint64(s.TxNum), 10)
+}
+
+// describeStatus describes the status of a single transaction, including its ledger position
+// once finalized, e.g., "COMMITTED (block 12, tx 0)".
+func describeStatus(s adapters.TxStatus) string {
+	if s.State != adapters.TxStateFinal {
+		return statusLabel(s)
+	}
+	return fmt.Sprintf("%s (block %d, tx %d)", statusLabel(s), s.BlockNum, s.TxNum)
+}
+
+// statusError returns an error with the exit code of the status of a transaction that did not
+// commit; nil if it committed.
+func statusError(s adapters.TxStatus) error {
+	if s.Committed() {
+		return nil
+	}
+	return &exitError{
+		code: statusExitCode(s),
+		err:  fmt.Errorf("transaction failed with status: %s", statusLabel(s)),
+	}
+}
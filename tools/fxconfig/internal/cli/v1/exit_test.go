@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+)
+
+// finalStatus returns the final status of a transaction committed in block 5.
+func finalStatus(txID string, status committerpb.Status) app.TxStatus {
+	return app.TxStatus{TxID: txID, State: adapters.TxStateFinal, Status: status, BlockNum: 5, TxNum: 1}
+}
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ExitOK, ExitCode(nil))
+	require.Equal(t, ExitError, ExitCode(errors.New("failed")))
+
+	err := fmt.Errorf("wrapped: %w", &exitError{code: ExitTxTimedOut, err: errors.New("timed out")})
+	require.Equal(t, ExitTxTimedOut, ExitCode(err))
+	require.EqualError(t, err, "wrapped: timed out")
+}
+
+func TestStatusExitCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status      app.TxStatus
+		expectCode  int
+		expectLabel string
+	}{
+		{finalStatus("tx-1", committerpb.Status_COMMITTED), ExitOK, "COMMITTED (block 5, tx 1)"},
+		{finalStatus("tx-1", committerpb.Status_ABORTED_MVCC_CONFLICT), ExitTxRejected,
+			"ABORTED_MVCC_CONFLICT (block 5, tx 1)"},
+		{app.TxStatus{State: adapters.TxStateTimedOut}, ExitTxTimedOut, "TIMED_OUT"},
+		{app.TxStatus{State: adapters.TxStateNotFound}, ExitTxNotFound, "NOT_FOUND"},
+		{app.TxStatus{}, ExitError, "UNKNOWN"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expectCode, statusExitCode(tt.status), tt.expectLabel)
+		require.Equal(t, tt.expectLabel, describeStatus(tt.status))
+		if tt.expectCode == ExitOK {
+			require.NoError(t, statusError(tt.status))
+		} else {
+			require.Equal(t, tt.expectCode, ExitCode(statusError(tt.status)))
+		}
+	}
+}
+
+func TestResultsExitCode(t *testing.T) {
+	t.Parallel()
+
+	committed := app.TxSubmissionResult{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)}
+	rejected := app.TxSubmissionResult{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT)}
+	timedOut := app.TxSubmissionResult{TxID: "tx-3", Status: app.TxStatus{State: adapters.TxStateTimedOut}}
+	notFound := app.TxSubmissionResult{TxID: "tx-4", Status: app.TxStatus{State: adapters.TxStateNotFound}}
+	failed := app.TxSubmissionResult{TxID: "tx-5", Err: errors.New("broadcast failed")}
+
+	require.Equal(t, ExitOK, resultsExitCode([]app.TxSubmissionResult{committed}))
+	require.Equal(t, ExitTxNotFound, resultsExitCode([]app.TxSubmissionResult{committed, notFound}))
+	require.Equal(t, ExitTxTimedOut, resultsExitCode([]app.TxSubmissionResult{notFound, timedOut}))
+	require.Equal(t, ExitTxRejected, resultsExitCode([]app.TxSubmissionResult{timedOut, rejected}))
+	require.Equal(t, ExitError, resultsExitCode([]app.TxSubmissionResult{rejected, failed}))
+}
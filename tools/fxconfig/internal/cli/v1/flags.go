@@ -4,7 +4,17 @@
 
 package v1
 
-import "github.com/spf13/cobra"
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
 
 // outputFlag represents an output file path flag.
 type outputFlag string
@@ -14,13 +24,62 @@ func (f *outputFlag) bind(cmd *cobra.Command) {
 		"Output file path (if not specified, writes to stdout)")
 }
 
-// policyFlag represents an endorsement policy flag.
-type policyFlag string
+// policyFlags groups the flags that declare an endorsement policy, inline or as a policy file.
+type policyFlags struct {
+	policy string
+	file   string
+}
 
-func (f *policyFlag) bind(cmd *cobra.Command) {
-	cmd.Flags().StringVar((*string)(f), "policy", "",
+func (f *policyFlags) bind(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.policy, "policy", "",
 		"Endorsement policy (e.g., \"OR('Org1MSP.member')\" or \"AND('Org1MSP.member', 'Org2MSP.member')\")")
-	_ = cmd.MarkFlagRequired("policy")
+	cmd.Flags().StringVar(&f.file, "policy-file", "",
+		"Policy file (yaml or json) with a policy expression, threshold key, or policy template")
+	cmd.MarkFlagsMutuallyExclusive("policy", "policy-file")
+	cmd.MarkFlagsOneRequired("policy", "policy-file")
+}
+
+// config returns the policy configuration of the namespace. Policies of a policy file are
+// printed to stderr after expansion, so that the policy can be reviewed before the transaction
+// is written or submitted.
+func (f *policyFlags) config(cmd *cobra.Command, nsID string) (app.PolicyConfig, error) {
+	policy := f.policy
+	if f.file != "" {
+		var err error
+		policy, err = readPolicyFile(f.file, nsID)
+		if err != nil {
+			return app.PolicyConfig{}, err
+		}
+		cmd.PrintErrf("Policy: %s\n", policy)
+	}
+
+	var p app.PolicyConfig
+	p.Set(policy)
+	return p, nil
+}
+
+// readPolicyFile reads and strictly decodes a policy file and returns the policy of the namespace
+// in the syntax of the --policy flag. A relative threshold key path is resolved against the
+// directory of the policy file.
+func readPolicyFile(path, nsID string) (string, error) {
+	data, err := cliio.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read policy file: %w", err)
+	}
+
+	var doc app.PolicyDocument
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil {
+		return "", fmt.Errorf("cannot decode policy file %s: %w", path, err)
+	}
+
+	policy, err := doc.Policy(nsID)
+	if err != nil {
+		return "", fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+
+	return resolveThresholdPath(policy, filepath.Dir(path)), nil
 }
 
 // versionFlag represents a namespace version number flag.
@@ -28,8 +87,7 @@ type versionFlag int
 
 func (f *versionFlag) bind(cmd *cobra.Command) {
 	cmd.Flags().IntVar((*int)(f), "version", 0,
-		"Current namespace version (required for updates to prevent conflicts)")
-	_ = cmd.MarkFlagRequired("version")
+		"Current namespace version (prevents concurrent modification conflicts)")
 }
 
 // namespaceDeployFlags groups flags for namespace deployment operations.
@@ -55,3 +113,17 @@ func (f *waitFlag) bind(cmd *cobra.Command) {
 	cmd.Flags().BoolVar((*bool)(f), "wait", false,
 		"Wait for transaction to be finalized and return status code")
 }
+
+// formatFlag represents the output format of a command.
+type formatFlag string
+
+// bind binds the flag for query commands.
+func (f *formatFlag) bind(cmd *cobra.Command) {
+	f.bindFormats(cmd, cliio.QueryFormats)
+}
+
+// bindFormats binds the flag for a command supporting the given formats.
+func (f *formatFlag) bindFormats(cmd *cobra.Command, formats []cliio.Format) {
+	cmd.Flags().StringVar((*string)(f), "format", string(cliio.FormatTable),
+		"Output format ("+cliio.JoinFormats(formats)+")")
+}
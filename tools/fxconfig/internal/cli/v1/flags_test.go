@@ -7,10 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package v1
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 )
 
 func TestOutputFlag_Bind(t *testing.T) {
@@ -25,21 +30,125 @@ func TestOutputFlag_Bind(t *testing.T) {
 	require.Empty(t, flag.DefValue)
 }
 
-func TestPolicyFlag_Bind(t *testing.T) {
+func TestPolicyFlags_Bind(t *testing.T) {
 	t.Parallel()
 
 	cmd := &cobra.Command{Use: "test"}
-	var f policyFlag
+	var f policyFlags
 	f.bind(cmd)
 
-	flag := cmd.Flags().Lookup("policy")
-	require.NotNil(t, flag)
-	require.Empty(t, flag.DefValue)
+	for _, name := range []string{"policy", "policy-file"} {
+		flag := cmd.Flags().Lookup(name)
+		require.NotNil(t, flag)
+		require.Empty(t, flag.DefValue)
+	}
+
+	// one of policy and policy-file is required
+	err := cmd.ValidateFlagGroups()
+	require.ErrorContains(t, err, "[policy policy-file]")
+
+	require.NoError(t, cmd.Flags().Set("policy", "OR('Org1MSP.member')"))
+	require.NoError(t, cmd.Flags().Set("policy-file", "policy.yaml"))
+	require.ErrorContains(t, cmd.ValidateFlagGroups(), "none of the others can be")
+}
+
+func TestPolicyFlags_Config(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	policyFile := filepath.Join(dir, "policies.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(`policies:
+  payments:
+    majority-of: [Org1MSP, Org2MSP, Org3MSP]
+  audit:
+    all-of: [Org1MSP, Org2MSP.client]
+    role: admin
+  tokens:
+    threshold: eddsa:keys/tokens.pem
+`), 0o600))
+
+	tests := []struct {
+		name         string
+		flags        policyFlags
+		nsID         string
+		expectPolicy string
+		expectError  string
+	}{
+		{
+			name:         "inline policy",
+			flags:        policyFlags{policy: "OR('Org1MSP.member')"},
+			nsID:         "payments",
+			expectPolicy: "OR('Org1MSP.member')",
+		},
+		{
+			name:         "majority template",
+			flags:        policyFlags{file: policyFile},
+			nsID:         "payments",
+			expectPolicy: "OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+		},
+		{
+			name:         "all-of template with roles",
+			flags:        policyFlags{file: policyFile},
+			nsID:         "audit",
+			expectPolicy: "AND('Org1MSP.admin', 'Org2MSP.client')",
+		},
+		{
+			name:         "relative threshold key",
+			flags:        policyFlags{file: policyFile},
+			nsID:         "tokens",
+			expectPolicy: "threshold:eddsa:" + filepath.Join(dir, "keys/tokens.pem"),
+		},
+		{
+			name:        "namespace not in file",
+			flags:       policyFlags{file: policyFile},
+			nsID:        "other",
+			expectError: "policy document declares no policy for namespace other",
+		},
+		{
+			name:        "missing file",
+			flags:       policyFlags{file: filepath.Join(dir, "missing.yaml")},
+			nsID:        "payments",
+			expectError: "cannot read policy file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var errOut bytes.Buffer
+			cmd := &cobra.Command{Use: "test"}
+			cmd.SetErr(&errOut)
+
+			p, err := tt.flags.config(cmd, tt.nsID)
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+
+			var want app.PolicyConfig
+			want.Set(tt.expectPolicy)
+			require.Equal(t, want, p)
+
+			// the expanded policy of a policy file is printed
+			if tt.flags.file != "" {
+				require.Equal(t, "Policy: "+tt.expectPolicy+"\n", errOut.String())
+			} else {
+				require.Empty(t, errOut.String())
+			}
+		})
+	}
+}
+
+func TestReadPolicyFile_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("any-of: [Org1MSP]\nrol: admin\n"), 0o600))
 
-	// policy is required
-	err := cmd.ValidateRequiredFlags()
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "policy")
+	_, err := readPolicyFile(path, "payments")
+	require.ErrorContains(t, err, "field rol not found")
 }
 
 func TestVersionFlag_Bind(t *testing.T) {
@@ -53,10 +162,8 @@ func TestVersionFlag_Bind(t *testing.T) {
 	require.NotNil(t, flag)
 	require.Equal(t, "0", flag.DefValue)
 
-	// version is required
-	err := cmd.ValidateRequiredFlags()
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "version")
+	// version is not required by itself; commands may offer alternatives to it
+	require.NoError(t, cmd.ValidateRequiredFlags())
 }
 
 func TestNamespaceDeployFlags_Bind(t *testing.T) {
@@ -12,7 +12,7 @@ import (
 
 // NewNsRootCommand returns the namespace command group.
 // This command provides subcommands for namespace lifecycle operations:
-// create, update, and list.
+// create, update, list, get, history, and diff.
 func NewNsRootCommand(ctx *CLIContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "namespace",
@@ -30,6 +30,9 @@ endorsement policies. Each namespace has:
 		newNsCreateCommand(ctx),
 		newNsUpdateCommand(ctx),
 		newNsListCommand(ctx),
+		newNsGetCommand(ctx),
+		newNsHistoryCommand(ctx),
+		newNsDiffCommand(ctx),
 	)
 
 	return cmd
@@ -11,7 +11,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/hyperledger/fabric-x-common/api/committerpb"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
@@ -20,7 +19,7 @@ import (
 // The deployNamespace function is injected to enable testing with mock implementations.
 func newNsCreateCommand(ctx *CLIContext) *cobra.Command {
 	var (
-		policy    policyFlag
+		policy    policyFlags
 		output    outputFlag
 		namespace namespaceDeployFlags
 	)
@@ -38,6 +37,17 @@ Policy Syntax:
   • AND('Org1MSP.member', 'Org2MSP.member') - Both Org1 and Org2
   • OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member') - 2 of 3 orgs
 
+Policy Files:
+  --policy-file reads the policy from a YAML document instead, either a
+  policy expression, a threshold key, or a template that expands to the DSL:
+    all-of: [Org1MSP, Org2MSP]       - AND of the members of all orgs
+    any-of: [Org1MSP, Org2MSP]       - OR of the members of the orgs
+    majority-of: [Org1MSP, Org2MSP, Org3MSP] - more than half of the orgs
+    out-of: {n: 2, of: [Org1MSP, Org2MSP, Org3MSP]} - n of the orgs
+    role: admin                      - member (default), admin, peer, client
+  A document with named policies under "policies" declares the policy of
+  each namespace by name. The expanded policy is printed to stderr.
+
 Transaction Lifecycle Flags:
   --endorse  Collect endorsement from local MSP
   --submit   Submit transaction to ordering service
@@ -58,11 +68,16 @@ Examples:
   # Complex policy with threshold
   fxconfig namespace create voting \
     --policy="OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')" \
-    --output=tx.json`,
+    --output=tx.json
+
+  # Policy from a policy file with a template
+  fxconfig namespace create voting --policy-file=policies.yaml --output=tx.json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			p := app.PolicyConfig{}
-			p.Set(string(policy))
+			p, err := policy.config(cmd, args[0])
+			if err != nil {
+				return err
+			}
 
 			input := app.DeployNamespaceInput{
 				NsID:    args[0],
@@ -79,10 +94,12 @@ Examples:
 			}
 
 			if res == nil {
-				ctx.Printer.Print(
-					fmt.Sprintf("Transaction status: %s", committerpb.Status_name[int32(status)]), //nolint:gosec
-				)
-				return nil
+				if !namespace.wait {
+					ctx.Printer.Print("Transaction status: SUBMITTED")
+					return nil
+				}
+				ctx.Printer.Print(fmt.Sprintf("Transaction status: %s", describeStatus(status)))
+				return statusError(status)
 			}
 
 			o, err := ctx.IOTransactionCodec.Encode(res.TxID, res.Tx)
@@ -9,14 +9,19 @@ package v1
 import (
 	"bytes"
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 func TestNewCreateCommand(t *testing.T) {
@@ -43,7 +48,7 @@ func TestNewCreateCommandRun_TxReturned(t *testing.T) {
 		TxID: "tx-123",
 		Tx:   &applicationpb.Tx{},
 	}
-	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(deployOut, app.UnknownStatus, nil)
+	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(deployOut, app.TxStatus{}, nil)
 
 	var printerOut, printerErr bytes.Buffer
 	printer := cliio.NewCLIPrinter(&printerOut, &printerErr, cliio.FormatTable)
@@ -68,7 +73,7 @@ func TestNewCreateCommandRun_NoTx(t *testing.T) {
 	t.Parallel()
 
 	mockApp := &testApp{}
-	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(nil, app.UnknownStatus, nil)
+	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(nil, app.TxStatus{}, nil)
 
 	var printerOut, printerErr bytes.Buffer
 	printer := cliio.NewCLIPrinter(&printerOut, &printerErr, cliio.FormatTable)
@@ -81,7 +86,31 @@ func TestNewCreateCommandRun_NoTx(t *testing.T) {
 	err := cmd.RunE(cmd, []string{"my-namespace"})
 
 	require.NoError(t, err)
-	require.Contains(t, printerOut.String(), "Transaction status: STATUS_UNSPECIFIED")
+	require.Contains(t, printerOut.String(), "Transaction status: SUBMITTED")
+	mockApp.AssertExpectations(t)
+}
+
+func TestNewCreateCommandRun_PolicyFile(t *testing.T) {
+	t.Parallel()
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte("all-of: [Org1MSP, Org2MSP]\n"), 0o600))
+
+	mockApp := &testApp{}
+	mockApp.On("DeployNamespace", mock.Anything, mock.MatchedBy(func(in *app.DeployNamespaceInput) bool {
+		return in.Policy.MSP != nil && in.Policy.MSP.Expression == "AND('Org1MSP.member', 'Org2MSP.member')"
+	})).Return(&app.DeployNamespaceOutput{TxID: "tx-123", Tx: &applicationpb.Tx{}}, app.TxStatus{}, nil)
+
+	cmd := newNsCreateCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.JSONCodec{}})
+	var cmdOut, cmdErr bytes.Buffer
+	cmd.SetOut(&cmdOut)
+	cmd.SetErr(&cmdErr)
+	cmd.SetArgs([]string{"my-namespace", "--policy-file", policyFile})
+
+	require.NoError(t, cmd.Execute())
+	// the expanded policy is printed to stderr, the transaction to stdout
+	require.Equal(t, "Policy: AND('Org1MSP.member', 'Org2MSP.member')\n", cmdErr.String())
+	require.Contains(t, cmdOut.String(), "tx-123")
 	mockApp.AssertExpectations(t)
 }
 
@@ -102,10 +131,11 @@ func (t *testApp) DeployNamespace(
 	input *app.DeployNamespaceInput,
 ) (*app.DeployNamespaceOutput, app.TxStatus, error) {
 	args := t.Called(ctx, input)
+	status := args.Get(1).(app.TxStatus) //nolint:errcheck,revive,forcetypeassert
 	if args.Get(0) == nil {
-		return nil, args.Int(1), args.Error(2)
+		return nil, status, args.Error(2)
 	}
-	return args.Get(0).(*app.DeployNamespaceOutput), args.Int(1), args.Error(2) //nolint:errcheck,revive,forcetypeassert
+	return args.Get(0).(*app.DeployNamespaceOutput), status, args.Error(2) //nolint:errcheck,revive,forcetypeassert
 }
 
 func (t *testApp) ListNamespaces(ctx context.Context) ([]app.NamespaceQueryResult, error) {
@@ -128,6 +158,17 @@ func (t *testApp) EndorseTransaction(
 	return args.Get(0).(*applicationpb.Tx), args.Error(1) //nolint:errcheck,revive,forcetypeassert
 }
 
+func (t *testApp) EndorseTransactionWithKey(
+	ctx context.Context,
+	input *app.ThresholdEndorseInput,
+) (*applicationpb.Tx, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*applicationpb.Tx), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
 func (t *testApp) SubmitTransaction(ctx context.Context, txID string, tx *applicationpb.Tx) error {
 	args := t.Called(ctx, txID, tx)
 	return args.Error(0)
@@ -139,5 +180,209 @@ func (t *testApp) SubmitTransactionWithWait(
 	tx *applicationpb.Tx,
 ) (app.TxStatus, error) {
 	args := t.Called(ctx, txID, tx)
-	return args.Int(0), args.Error(1)
+	return args.Get(0).(app.TxStatus), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) SubmitTransactions(
+	ctx context.Context,
+	txs []adapters.Transaction,
+	wait bool,
+) ([]app.TxSubmissionResult, error) {
+	args := t.Called(ctx, txs, wait)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.TxSubmissionResult), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) GetNamespace(ctx context.Context, nsID string) (*app.NamespaceQueryResult, error) {
+	args := t.Called(ctx, nsID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.NamespaceQueryResult), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) NamespaceHistory(
+	ctx context.Context,
+	input *app.NamespaceHistoryInput,
+) ([]app.NamespacePolicyChange, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.NamespacePolicyChange), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) PlanNamespaces(ctx context.Context, manifest *app.NamespaceManifest) (*app.ApplyPlan, error) {
+	args := t.Called(ctx, manifest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.ApplyPlan), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) ApplyNamespaces(
+	ctx context.Context,
+	plan *app.ApplyPlan,
+	wait bool,
+) ([]app.TxSubmissionResult, error) {
+	args := t.Called(ctx, plan, wait)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.TxSubmissionResult), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) DiffNamespaces(ctx context.Context, specs []app.NamespaceSpec) ([]app.NamespaceDiff, error) {
+	args := t.Called(ctx, specs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.NamespaceDiff), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) VerifyTransaction(
+	ctx context.Context,
+	input *app.VerifyTransactionInput,
+) (*app.TxVerification, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.TxVerification), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) InspectTransaction(
+	ctx context.Context,
+	txID string,
+	tx *applicationpb.Tx,
+) (*transaction.TxReport, error) {
+	args := t.Called(ctx, txID, tx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*transaction.TxReport), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) SignEnvelope(
+	ctx context.Context,
+	channel, txID string,
+	tx *applicationpb.Tx,
+) (*cb.Envelope, error) {
+	args := t.Called(ctx, channel, txID, tx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.Envelope), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) BroadcastEnvelopes(
+	ctx context.Context,
+	envs []adapters.SignedEnvelope,
+	wait bool,
+) ([]app.TxSubmissionResult, error) {
+	args := t.Called(ctx, envs, wait)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.TxSubmissionResult), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) TransactionStatus(ctx context.Context, input *app.TxStatusInput) ([]app.TxSubmissionResult, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]app.TxSubmissionResult), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) StreamEvents(
+	ctx context.Context,
+	input *app.EventsInput,
+	handle func(*app.BlockEvents) error,
+) error {
+	args := t.Called(ctx, input)
+	// the mock passes the given blocks to handle before returning the given error
+	if blocks, ok := args.Get(0).([]*app.BlockEvents); ok {
+		for _, b := range blocks {
+			if err := handle(b); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (t *testApp) FetchBlock(ctx context.Context, position string) (*cb.Block, error) {
+	args := t.Called(ctx, position)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.Block), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) DescribeBlock(ctx context.Context, block *cb.Block) (*transaction.BlockReport, error) {
+	args := t.Called(ctx, block)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*transaction.BlockReport), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) FetchChannelConfig(ctx context.Context) (*app.ChannelConfig, error) {
+	args := t.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.ChannelConfig), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) ComputeConfigUpdate(
+	ctx context.Context,
+	input *app.ConfigUpdateInput,
+) (*cb.ConfigUpdateEnvelope, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.ConfigUpdateEnvelope), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) SignConfigUpdate(
+	ctx context.Context,
+	env *cb.ConfigUpdateEnvelope,
+) (*cb.ConfigUpdateEnvelope, error) {
+	args := t.Called(ctx, env)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.ConfigUpdateEnvelope), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) MergeConfigUpdates(
+	ctx context.Context,
+	envs []*cb.ConfigUpdateEnvelope,
+) (*cb.ConfigUpdateEnvelope, error) {
+	args := t.Called(ctx, envs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.ConfigUpdateEnvelope), args.Error(1) //nolint:errcheck,revive,forcetypeassert
+}
+
+func (t *testApp) SubmitConfigUpdate(ctx context.Context, env *cb.ConfigUpdateEnvelope) (string, error) {
+	args := t.Called(ctx, env)
+	return args.String(0), args.Error(1)
+}
+
+func (t *testApp) AddOrganization(
+	ctx context.Context,
+	input *app.AddOrganizationInput,
+) (*cb.ConfigUpdateEnvelope, error) {
+	args := t.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cb.ConfigUpdateEnvelope), args.Error(1) //nolint:errcheck,revive,forcetypeassert
 }
@@ -0,0 +1,166 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newNsDiffCommand creates a command for comparing desired namespace policies with the committed ones.
+// The desired policy is taken from the --policy flag or from a namespace manifest.
+func newNsDiffCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		policy string
+		file   string
+		format formatFlag
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff [name]",
+		Short: "Compare a desired policy with the committed one",
+		Long: `Compare a desired endorsement policy with the committed namespace policy.
+
+The desired policy is given either with --policy for a single namespace, or
+with --file as a namespace manifest (see 'fxconfig apply'). With a manifest,
+all listed namespaces are compared unless a name is given.
+
+The comparison is semantic: the order of sub-policies does not matter.
+For each namespace, displays:
+  • Principals added to or removed from the policy
+  • Changed signature thresholds, e.g., "requires 1 of 2 -> 2 of 2"
+  • Changed scheme or public key of threshold policies
+  • The 'fxconfig namespace update' invocation with the current version
+
+Examples:
+  # Compare a single namespace
+  fxconfig namespace diff payments --policy="AND('Org1MSP.member', 'Org2MSP.member')"
+
+  # Compare all namespaces of a manifest
+  fxconfig namespace diff -f namespaces.yaml
+
+  # Compare as json
+  fxconfig namespace diff -f namespaces.yaml --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
+			specs, err := diffSpecs(policy, file, args)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := ctx.App.DiffNamespaces(cmd.Context(), specs)
+			if err != nil {
+				return err
+			}
+
+			if f != cliio.FormatTable {
+				data, err := cliio.Marshal(f, diffs)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(data))
+				return nil
+			}
+
+			for i, d := range diffs {
+				if i > 0 {
+					ctx.Printer.Print("\n")
+				}
+				ctx.Printer.Print(renderNamespaceDiff(d))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&policy, "policy", "",
+		"Desired endorsement policy (e.g., \"AND('Org1MSP.member', 'Org2MSP.member')\" or \"threshold:[<scheme>:]<path>\")")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Namespace manifest with the desired policies")
+	cmd.MarkFlagsMutuallyExclusive("policy", "file")
+	cmd.MarkFlagsOneRequired("policy", "file")
+	format.bind(cmd)
+
+	return cmd
+}
+
+// diffSpecs returns the desired namespace policies from the --policy flag or from a manifest.
+func diffSpecs(policy, file string, args []string) ([]app.NamespaceSpec, error) {
+	if file == "" {
+		if len(args) == 0 {
+			return nil, errors.New("namespace name is required with --policy")
+		}
+		return []app.NamespaceSpec{{Name: args[0], Policy: policy}}, nil
+	}
+
+	manifest, err := readManifest(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return manifest.Namespaces, nil
+	}
+
+	for _, spec := range manifest.Namespaces {
+		if spec.Name == args[0] {
+			return []app.NamespaceSpec{spec}, nil
+		}
+	}
+	return nil, fmt.Errorf("namespace %s not found in manifest %s", args[0], file)
+}
+
+// renderNamespaceDiff renders the comparison of a single namespace together with
+// the command that applies the desired policy.
+func renderNamespaceDiff(d app.NamespaceDiff) string {
+	var b strings.Builder
+
+	if !d.Exists {
+		fmt.Fprintf(&b, "Namespace %s does not exist\n", d.NsID)
+		fmt.Fprintf(&b, "  desired: %s\n", d.Desired)
+		fmt.Fprintf(&b, "\nTo create it, run:\n  fxconfig namespace create %s --policy=%s\n",
+			d.NsID, shellQuote(d.Policy))
+		return b.String()
+	}
+
+	if d.Diff.Empty() {
+		fmt.Fprintf(&b, "Namespace %s (version %d) is up to date\n", d.NsID, d.Version)
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "Namespace %s (version %d)\n", d.NsID, d.Version)
+	fmt.Fprintf(&b, "  current: %s\n", d.Diff.Current)
+	fmt.Fprintf(&b, "  desired: %s\n", d.Diff.Desired)
+	for _, p := range d.Diff.RemovedPrincipals {
+		fmt.Fprintf(&b, "  - principal %s\n", p)
+	}
+	for _, p := range d.Diff.AddedPrincipals {
+		fmt.Fprintf(&b, "  + principal %s\n", p)
+	}
+	for _, c := range d.Diff.Changes {
+		fmt.Fprintf(&b, "  ~ %s\n", c)
+	}
+	fmt.Fprintf(&b, "\nTo apply it, run:\n  fxconfig namespace update %s --policy=%s --version=%d\n",
+		d.NsID, shellQuote(d.Policy), d.Version)
+
+	return b.String()
+}
+
+// shellQuote quotes s as a single word for POSIX shells: s is enclosed in single quotes,
+// and each single quote within s closes the quoting, is escaped with a backslash, and reopens it.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,179 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func someNamespaceDiffs() []app.NamespaceDiff {
+	msp := func(expr string) *transaction.PolicyDescription {
+		return &transaction.PolicyDescription{Type: transaction.PolicyTypeMSP, Expression: expr}
+	}
+	return []app.NamespaceDiff{
+		{
+			NsID:    "payments",
+			Policy:  "AND('Org1MSP.member', 'Org2MSP.member')",
+			Exists:  true,
+			Version: 3,
+			Desired: msp("AND('Org1MSP.member', 'Org2MSP.member')"),
+			Diff: &transaction.PolicyDiff{
+				Current:           msp("OR('Org1MSP.member', 'Org3MSP.member')"),
+				Desired:           msp("AND('Org1MSP.member', 'Org2MSP.member')"),
+				AddedPrincipals:   []string{"'Org2MSP.member'"},
+				RemovedPrincipals: []string{"'Org3MSP.member'"},
+				Changes:           []string{"policy: requires 1 of 2 -> 2 of 2"},
+			},
+		},
+		{
+			NsID:    "tokens",
+			Policy:  "OR('Org1MSP.member')",
+			Version: -1,
+			Desired: msp("OR('Org1MSP.member')"),
+		},
+		{
+			NsID:    "other",
+			Policy:  "OR('Org1MSP.member')",
+			Exists:  true,
+			Version: 1,
+			Desired: msp("OR('Org1MSP.member')"),
+			Diff:    &transaction.PolicyDiff{Current: msp("OR('Org1MSP.member')"), Desired: msp("OR('Org1MSP.member')")},
+		},
+	}
+}
+
+func executeNsDiff(t *testing.T, mockApp *testApp, args ...string) (string, error) {
+	t.Helper()
+
+	var out, errOut bytes.Buffer
+	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+	cmd := newNsDiffCommand(&CLIContext{App: mockApp, Printer: printer})
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs(args)
+
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestNsDiffCommand_Policy(t *testing.T) {
+	t.Parallel()
+
+	specs := []app.NamespaceSpec{{Name: "payments", Policy: "AND('Org1MSP.member', 'Org2MSP.member')"}}
+	mockApp := &testApp{}
+	mockApp.On("DiffNamespaces", mock.Anything, specs).Return(someNamespaceDiffs()[:1], nil)
+
+	output, err := executeNsDiff(t, mockApp, "payments", "--policy=AND('Org1MSP.member', 'Org2MSP.member')")
+
+	require.NoError(t, err)
+	require.Contains(t, output, "Namespace payments (version 3)")
+	require.Contains(t, output, "current: msp OR('Org1MSP.member', 'Org3MSP.member')")
+	require.Contains(t, output, "- principal 'Org3MSP.member'")
+	require.Contains(t, output, "+ principal 'Org2MSP.member'")
+	require.Contains(t, output, "~ policy: requires 1 of 2 -> 2 of 2")
+	require.Contains(t, output,
+		`fxconfig namespace update payments --policy='AND('\''Org1MSP.member'\'', '\''Org2MSP.member'\'')' --version=3`)
+	mockApp.AssertExpectations(t)
+}
+
+func TestNsDiffCommand_Manifest(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("DiffNamespaces", mock.Anything, mock.MatchedBy(func(specs []app.NamespaceSpec) bool {
+		return len(specs) == 3
+	})).Return(someNamespaceDiffs(), nil)
+
+	output, err := executeNsDiff(t, mockApp, "-f", writeManifest(t, someManifestYAML))
+
+	require.NoError(t, err)
+	require.Contains(t, output, "Namespace tokens does not exist")
+	require.Contains(t, output, `fxconfig namespace create tokens --policy='OR('\''Org1MSP.member'\'')'`)
+	require.Contains(t, output, "Namespace other (version 1) is up to date")
+	mockApp.AssertExpectations(t)
+}
+
+func TestNsDiffCommand_ManifestWithName(t *testing.T) {
+	t.Parallel()
+
+	specs := []app.NamespaceSpec{{Name: "payments", Policy: "AND('Org1MSP.member', 'Org2MSP.member')"}}
+	mockApp := &testApp{}
+	mockApp.On("DiffNamespaces", mock.Anything, specs).Return(someNamespaceDiffs()[:1], nil)
+
+	output, err := executeNsDiff(t, mockApp, "payments", "-f", writeManifest(t, someManifestYAML), "--format=json")
+
+	require.NoError(t, err)
+	var decoded []app.NamespaceDiff
+	require.NoError(t, json.Unmarshal([]byte(output), &decoded))
+	require.Equal(t, someNamespaceDiffs()[:1], decoded)
+	mockApp.AssertExpectations(t)
+}
+
+func TestNsDiffCommand_InvalidArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "no policy", args: []string{"payments"}, expectError: "at least one of the flags"},
+		{
+			name:        "policy and file",
+			args:        []string{"payments", "--policy=OR('Org1MSP.member')", "-f", "x.yaml"},
+			expectError: "none of the others can be",
+		},
+		{name: "policy without name", args: []string{"--policy=OR('Org1MSP.member')"}, expectError: "name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := executeNsDiff(t, &testApp{}, tt.args...)
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestNsDiffCommand_NameNotInManifest(t *testing.T) {
+	t.Parallel()
+
+	_, err := executeNsDiff(t, &testApp{}, "missing", "-f", writeManifest(t, someManifestYAML))
+	require.ErrorContains(t, err, "namespace missing not found in manifest")
+}
+
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+
+	for _, policy := range []string{
+		"OR('Org1MSP.member')",
+		"AND('Org1MSP.member', OutOf(2, 'Org2MSP.peer', 'Org3MSP.peer'))",
+		`threshold "$HOME" \n ` + "`id`",
+		"",
+	} {
+		// the quoted policy must reach the command as a single, unchanged argument
+		out, err := exec.CommandContext(t.Context(), sh, "-c", "printf %s "+shellQuote(policy)).Output()
+		require.NoError(t, err)
+		require.Equal(t, policy, string(out))
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// newNsGetCommand creates a command for showing a single installed namespace.
+// It displays the current version and the decoded endorsement policy.
+func newNsGetCommand(ctx *CLIContext) *cobra.Command {
+	var format formatFlag
+
+	cmd := &cobra.Command{
+		Use:   "get [name]",
+		Short: "Show an installed namespace",
+		Long: `Query and display a single installed namespace.
+
+Displays:
+  • Name (namespace identifier)
+  • Version (current version number)
+  • Policy (decoded endorsement policy)
+
+MSP policies are shown in the policy language used by --policy, e.g.
+"OR('Org1MSP.member', 'Org2MSP.member')". Threshold policies are shown
+with their signature scheme, PEM-encoded public key, and its SHA-256
+fingerprint.
+
+Examples:
+  # Show namespace
+  fxconfig namespace get hello
+
+  # Show namespace with custom config
+  fxconfig namespace get hello --config /path/to/config.yaml
+
+  # Show namespace as json
+  fxconfig namespace get hello --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
+			ns, err := ctx.App.GetNamespace(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			if f != cliio.FormatTable {
+				data, err := cliio.Marshal(f, ns)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(data))
+				return nil
+			}
+
+			policy, fingerprint := policyColumns(*ns)
+			ctx.Printer.Print(fmt.Sprintf("Namespace: %s\n", ns.NsID))
+			ctx.Printer.Print(fmt.Sprintf("Version:   %d\n", ns.Version))
+			ctx.Printer.Print(fmt.Sprintf("Policy:    %s\n", policy))
+			if ns.Policy != nil && ns.Policy.Type == transaction.PolicyTypeThreshold {
+				ctx.Printer.Print(fmt.Sprintf("Key:       %s\n", fingerprint))
+				ctx.Printer.Print(ns.Policy.PublicKey)
+			}
+
+			return nil
+		},
+	}
+	format.bind(cmd)
+
+	return cmd
+}
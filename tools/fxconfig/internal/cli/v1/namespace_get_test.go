@@ -0,0 +1,108 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func TestNewNsGetCommandRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policy   *transaction.PolicyDescription
+		expected []string
+	}{
+		{
+			name: "msp policy",
+			policy: &transaction.PolicyDescription{
+				Type:       transaction.PolicyTypeMSP,
+				Expression: "OR('Org1MSP.member')",
+			},
+			expected: []string{"Namespace: ns1", "Version:   3", "Policy:    msp OR('Org1MSP.member')"},
+		},
+		{
+			name: "threshold policy",
+			policy: &transaction.PolicyDescription{
+				Type:        transaction.PolicyTypeThreshold,
+				Scheme:      "ECDSA",
+				PublicKey:   "-----BEGIN PUBLIC KEY-----\nabc\n-----END PUBLIC KEY-----\n",
+				Fingerprint: "SHA256:abcd",
+			},
+			expected: []string{"Policy:    threshold ECDSA", "Key:       SHA256:abcd", "-----BEGIN PUBLIC KEY-----"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("GetNamespace", mock.Anything, "ns1").
+				Return(&app.NamespaceQueryResult{NsID: "ns1", Version: 3, Policy: tt.policy}, nil)
+
+			var out, errOut bytes.Buffer
+			printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+			cmd := newNsGetCommand(&CLIContext{App: mockApp, Printer: printer})
+
+			err := cmd.RunE(cmd, []string{"ns1"})
+
+			require.NoError(t, err)
+			for _, e := range tt.expected {
+				require.Contains(t, out.String(), e)
+			}
+			mockApp.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewNsGetCommandRun_NotFound(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("GetNamespace", mock.Anything, "missing").Return(nil, app.ErrNamespaceNotFound)
+
+	cmd := newNsGetCommand(&CLIContext{App: mockApp})
+
+	err := cmd.RunE(cmd, []string{"missing"})
+
+	require.ErrorIs(t, err, app.ErrNamespaceNotFound)
+	mockApp.AssertExpectations(t)
+}
+
+func TestNewNsGetCommandRun_JSON(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("GetNamespace", mock.Anything, "ns1").Return(&app.NamespaceQueryResult{
+		NsID:    "ns1",
+		Version: 3,
+		Policy:  &transaction.PolicyDescription{Type: transaction.PolicyTypeMSP, Expression: "OR('Org1MSP.member')"},
+	}, nil)
+
+	var out, errOut bytes.Buffer
+	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+	cmd := newNsGetCommand(&CLIContext{App: mockApp, Printer: printer})
+	require.NoError(t, cmd.Flags().Set("format", "json"))
+
+	err := cmd.RunE(cmd, []string{"ns1"})
+
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"name":"ns1","version":3,"policy":{"type":"msp","expression":"OR('Org1MSP.member')"}}`,
+		out.String())
+	mockApp.AssertExpectations(t)
+}
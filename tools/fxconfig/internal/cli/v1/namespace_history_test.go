@@ -0,0 +1,99 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func TestNewNsHistoryCommandRun(t *testing.T) {
+	t.Parallel()
+
+	changes := []app.NamespacePolicyChange{
+		{
+			Version:   0,
+			BlockNum:  3,
+			TxID:      "tx-create",
+			Timestamp: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Submitter: "Org1MSP",
+			Endorsers: []string{"Org1MSP"},
+			Policy:    &transaction.PolicyDescription{Type: transaction.PolicyTypeMSP, Expression: "OR('Org1MSP.member')"},
+		},
+		{
+			Version:   1,
+			BlockNum:  7,
+			TxNum:     2,
+			TxID:      "tx-update",
+			Submitter: "Org2MSP",
+			Endorsers: []string{"Org1MSP", "Org2MSP"},
+		},
+	}
+
+	mockApp := &testApp{}
+	mockApp.On("NamespaceHistory", mock.Anything, &app.NamespaceHistoryInput{NsID: "ns1"}).Return(changes, nil)
+
+	var out, errOut bytes.Buffer
+	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+	cmd := newNsHistoryCommand(&CLIContext{App: mockApp, Printer: printer})
+
+	err := cmd.RunE(cmd, []string{"ns1"})
+
+	require.NoError(t, err)
+	output := out.String()
+	require.Contains(t, output, "SUBMITTER")
+	require.Contains(t, output, "tx-create")
+	require.Contains(t, output, "2025-01-02T03:04:05Z")
+	require.Contains(t, output, "msp OR('Org1MSP.member')")
+	require.Contains(t, output, "Org1MSP,Org2MSP")
+	require.Contains(t, output, "<undecodable>")
+	mockApp.AssertExpectations(t)
+}
+
+func TestNewNsHistoryCommandRun_BlockRange(t *testing.T) {
+	t.Parallel()
+
+	to := uint64(20)
+	mockApp := &testApp{}
+	mockApp.On("NamespaceHistory", mock.Anything, &app.NamespaceHistoryInput{NsID: "ns1", FromBlock: 10, ToBlock: &to}).
+		Return(nil, nil)
+
+	var out, errOut bytes.Buffer
+	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+	cmd := newNsHistoryCommand(&CLIContext{App: mockApp, Printer: printer})
+	require.NoError(t, cmd.Flags().Set("from-block", "10"))
+	require.NoError(t, cmd.Flags().Set("to-block", "20"))
+
+	err := cmd.RunE(cmd, []string{"ns1"})
+
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "No policy changes found")
+	mockApp.AssertExpectations(t)
+}
+
+func TestNewNsHistoryCommandRun_AppError(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("NamespaceHistory", mock.Anything, mock.Anything).Return(nil, context.DeadlineExceeded)
+
+	cmd := newNsHistoryCommand(&CLIContext{App: mockApp})
+
+	err := cmd.RunE(cmd, []string{"ns1"})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	mockApp.AssertExpectations(t)
+}
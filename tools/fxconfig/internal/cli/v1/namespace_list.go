@@ -8,14 +8,19 @@ package v1
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
 
 // newNsListCommand creates a command for listing installed namespaces.
-// It connects to the query service and displays namespace names, versions, and policies.
-// The listFunc is injected to enable testing with mock implementations.
+// It connects to the query service and displays namespace names, versions, and decoded policies.
 func newNsListCommand(ctx *CLIContext) *cobra.Command {
+	var format formatFlag
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List installed Namespaces",
@@ -24,12 +29,17 @@ func newNsListCommand(ctx *CLIContext) *cobra.Command {
 For each namespace, displays:
   • Name (namespace identifier)
   • Version (current version number)
-  • Policy (endorsement policy in hexadecimal format)
+  • Policy (decoded endorsement policy)
+
+MSP policies are shown in the policy language used by --policy, e.g.
+"AND('Org1MSP.member', 'Org2MSP.member')". Threshold policies are shown
+with their signature scheme and the SHA-256 fingerprint of the public key;
+the PEM-encoded public key is included in the json and yaml formats.
 
 Use this command to:
   • Verify namespace deployment
   • Check current version before updates
-  • Audit endorsement policies
+  • Audit and diff endorsement policies
 
 Examples:
   # List all namespaces
@@ -38,24 +48,49 @@ Examples:
   # List with custom config
   fxconfig namespace list --config /path/to/config.yaml
 
-  # List and save output to file
-  fxconfig namespace list > namespaces.txt`,
+  # List as yaml and save output to file
+  fxconfig namespace list --format yaml > namespaces.yaml`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
 			result, err := ctx.App.ListNamespaces(cmd.Context())
 			if err != nil {
 				return err
 			}
 
-			// print namespace policy information to the Output writer.
-			// Each namespace is displayed with its index, name, version, and policy in hexadecimal format.
-			ctx.Printer.Print(fmt.Sprintf("Installed namespaces (%d total):\n", len(result)))
-			for i, p := range result {
-				ctx.Printer.Print(fmt.Sprintf("%d) %v: version %d policy: %x\n", i, p.NsID, p.Version, p.Policy))
+			if f != cliio.FormatTable {
+				data, err := cliio.Marshal(f, result)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(data))
+				return nil
 			}
 
+			rows := make([][]string, len(result))
+			for i, ns := range result {
+				policy, fingerprint := policyColumns(ns)
+				rows[i] = []string{ns.NsID, strconv.Itoa(ns.Version), policy, fingerprint}
+			}
+
+			ctx.Printer.Print(fmt.Sprintf("Installed namespaces (%d total):\n", len(result)))
+			ctx.Printer.Print(cliio.RenderTable([]string{"NAME", "VERSION", "POLICY", "FINGERPRINT"}, rows))
+
 			return nil
 		},
 	}
+	format.bind(cmd)
 
 	return cmd
 }
+
+// policyColumns returns the policy summary and the public key fingerprint of a namespace.
+func policyColumns(ns app.NamespaceQueryResult) (string, string) {
+	if ns.Policy == nil {
+		return "<undecodable: " + ns.PolicyError + ">", ""
+	}
+	return ns.Policy.String(), ns.Policy.Fingerprint
+}
@@ -9,13 +9,16 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 func TestNewListCommand(t *testing.T) {
@@ -35,11 +38,7 @@ func TestNewListCommandRun(t *testing.T) {
 	t.Parallel()
 
 	mockApp := &testApp{}
-	namespaces := []app.NamespaceQueryResult{
-		{NsID: "ns1", Version: 1, Policy: []byte{0xde, 0xad}},
-		{NsID: "ns2", Version: 2, Policy: []byte{0xbe, 0xef}},
-	}
-	mockApp.On("ListNamespaces", mock.Anything).Return(namespaces, nil)
+	mockApp.On("ListNamespaces", mock.Anything).Return(someNamespaces(), nil)
 
 	var out, errOut bytes.Buffer
 	printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
@@ -49,12 +48,84 @@ func TestNewListCommandRun(t *testing.T) {
 
 	require.NoError(t, err)
 	output := out.String()
-	require.Contains(t, output, "2 total")
-	require.Contains(t, output, "ns1")
-	require.Contains(t, output, "ns2")
+	require.Contains(t, output, "3 total")
+	require.Contains(t, output, "FINGERPRINT")
+	require.Contains(t, output, "msp AND('Org1MSP.member', 'Org2MSP.member')")
+	require.Contains(t, output, "threshold ECDSA")
+	require.Contains(t, output, "SHA256:abcd")
+	require.Contains(t, output, "<undecodable: cannot unmarshal namespace policy>")
+	require.NotContains(t, output, "BEGIN PUBLIC KEY")
 	mockApp.AssertExpectations(t)
 }
 
+func TestNewListCommandRun_Formats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format    string
+		unmarshal func([]byte, any) error
+	}{
+		{format: "json", unmarshal: json.Unmarshal},
+		{format: "yaml", unmarshal: yaml.Unmarshal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("ListNamespaces", mock.Anything).Return(someNamespaces(), nil)
+
+			var out, errOut bytes.Buffer
+			printer := cliio.NewCLIPrinter(&out, &errOut, cliio.FormatTable)
+			cmd := newNsListCommand(&CLIContext{App: mockApp, Printer: printer})
+			require.NoError(t, cmd.Flags().Set("format", tt.format))
+
+			err := cmd.RunE(cmd, nil)
+			require.NoError(t, err)
+
+			var decoded []app.NamespaceQueryResult
+			require.NoError(t, tt.unmarshal(out.Bytes(), &decoded))
+			require.Equal(t, someNamespaces(), decoded)
+			mockApp.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewListCommandRun_InvalidFormat(t *testing.T) {
+	t.Parallel()
+
+	cmd := newNsListCommand(&CLIContext{App: &testApp{}})
+	require.NoError(t, cmd.Flags().Set("format", "xml"))
+
+	err := cmd.RunE(cmd, nil)
+	require.ErrorContains(t, err, "invalid format: xml")
+}
+
+func someNamespaces() []app.NamespaceQueryResult {
+	return []app.NamespaceQueryResult{
+		{
+			NsID:    "ns1",
+			Version: 1,
+			Policy: &transaction.PolicyDescription{
+				Type:       transaction.PolicyTypeMSP,
+				Expression: "AND('Org1MSP.member', 'Org2MSP.member')",
+			},
+		},
+		{
+			NsID:    "ns2",
+			Version: 2,
+			Policy: &transaction.PolicyDescription{
+				Type:        transaction.PolicyTypeThreshold,
+				Scheme:      "ECDSA",
+				PublicKey:   "-----BEGIN PUBLIC KEY-----\nabc\n-----END PUBLIC KEY-----\n",
+				Fingerprint: "SHA256:abcd",
+			},
+		},
+		{NsID: "ns3", Version: 0, PolicyError: "cannot unmarshal namespace policy"},
+	}
+}
+
 func TestNewListCommandRun_AppError(t *testing.T) {
 	t.Parallel()
 
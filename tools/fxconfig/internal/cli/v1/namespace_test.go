@@ -29,4 +29,7 @@ func TestNewNsRootCommand(t *testing.T) {
 	require.True(t, subCmds["create"])
 	require.True(t, subCmds["update"])
 	require.True(t, subCmds["list"])
+	require.True(t, subCmds["get"])
+	require.True(t, subCmds["history"])
+	require.True(t, subCmds["diff"])
 }
@@ -11,22 +11,23 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/hyperledger/fabric-x-common/api/committerpb"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
 
 // newNsUpdateCommand creates a command for updating existing namespaces.
-// It accepts a namespace name as argument and requires the --version flag to specify
-// the current version number, preventing concurrent modification conflicts.
+// It accepts a namespace name as argument and requires either the --version flag to specify
+// the current version number, preventing concurrent modification conflicts, or the
+// --auto-version flag to look up the current version via the query service.
 // The deployNamespace function is injected to enable testing with mock implementations.
 func newNsUpdateCommand(ctx *CLIContext) *cobra.Command {
 	var (
 		// flag variables
-		version   versionFlag
-		policy    policyFlag
-		output    outputFlag
-		namespace namespaceDeployFlags
+		version     versionFlag
+		autoVersion bool
+		policy      policyFlags
+		output      outputFlag
+		namespace   namespaceDeployFlags
 	)
 
 	cmd := &cobra.Command{
@@ -35,11 +36,19 @@ func newNsUpdateCommand(ctx *CLIContext) *cobra.Command {
 		Long: `Update an existing namespace's endorsement policy.
 
 The --version flag is required to prevent concurrent modification conflicts.
-Use 'fxconfig namespace list' to find the current version number.
+Use 'fxconfig namespace list' to find the current version number, or pass
+--auto-version to look it up via the query service instead.
 
 Version numbers increment with each successful update. If the version you
 specify doesn't match the current version, the update will fail.
 
+With --auto-version, the update fails early if the namespace does not exist.
+When submitting, the version is checked again right before submission, and
+the update fails with a clear error if the namespace was changed in between.
+
+The policy is declared with --policy or read from a policy file with
+--policy-file (see 'fxconfig namespace create --help').
+
 Examples:
   # Update namespace policy (check version first with 'list')
   fxconfig namespace update hello \
@@ -47,6 +56,12 @@ Examples:
     --version=0 \
     --endorse --submit --wait
 
+  # Update namespace policy at its current version
+  fxconfig namespace update hello \
+    --policy="OR('Org2MSP.member')" \
+    --auto-version \
+    --endorse --submit --wait
+
   # Change from single-org to multi-org policy
   fxconfig namespace update hello \
     --policy="AND('Org1MSP.member', 'Org2MSP.member')" \
@@ -60,16 +75,19 @@ Examples:
     --output=update_tx.json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			p := app.PolicyConfig{}
-			p.Set(string(policy))
+			p, err := policy.config(cmd, args[0])
+			if err != nil {
+				return err
+			}
 
 			input := app.DeployNamespaceInput{
-				NsID:    args[0],
-				Version: int(version),
-				Policy:  p,
-				Endorse: namespace.endorse,
-				Submit:  namespace.submit,
-				Wait:    namespace.wait,
+				NsID:        args[0],
+				Version:     int(version),
+				Policy:      p,
+				AutoVersion: autoVersion,
+				Endorse:     namespace.endorse,
+				Submit:      namespace.submit,
+				Wait:        namespace.wait,
 			}
 
 			res, status, err := ctx.App.DeployNamespace(cmd.Context(), &input)
@@ -78,10 +96,12 @@ Examples:
 			}
 
 			if res == nil {
-				ctx.Printer.Print(
-					fmt.Sprintf("Transaction status: %s", committerpb.Status_name[int32(status)]), //nolint:gosec
-				)
-				return nil
+				if !namespace.wait {
+					ctx.Printer.Print("Transaction status: SUBMITTED")
+					return nil
+				}
+				ctx.Printer.Print(fmt.Sprintf("Transaction status: %s", describeStatus(status)))
+				return statusError(status)
 			}
 
 			o, err := ctx.IOTransactionCodec.Encode(res.TxID, res.Tx)
@@ -95,6 +115,10 @@ Examples:
 
 	// adds flags related to namespaces
 	version.bind(cmd)
+	cmd.Flags().BoolVar(&autoVersion, "auto-version", false,
+		"Look up the current namespace version via the query service")
+	cmd.MarkFlagsMutuallyExclusive("version", "auto-version")
+	cmd.MarkFlagsOneRequired("version", "auto-version")
 	policy.bind(cmd)
 	output.bind(cmd)
 	namespace.bind(cmd)
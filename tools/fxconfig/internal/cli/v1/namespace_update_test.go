@@ -36,6 +36,80 @@ func TestNewUpdateCommand(t *testing.T) {
 
 	policy := cmd.Flag("policy")
 	require.NotNil(t, policy, "policy flag should exist")
+
+	autoVersion := cmd.Flag("auto-version")
+	require.NotNil(t, autoVersion, "auto-version flag should exist")
+}
+
+func TestNsUpdateCommand_VersionFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "neither version nor auto-version",
+			flags:   map[string]string{},
+			wantErr: "at least one of the flags in the group [version auto-version] is required",
+		},
+		{
+			name:    "both version and auto-version",
+			flags:   map[string]string{"version": "1", "auto-version": "true"},
+			wantErr: "none of the others can be",
+		},
+		{
+			name:  "version",
+			flags: map[string]string{"version": "1"},
+		},
+		{
+			name:  "auto-version",
+			flags: map[string]string{"auto-version": "true"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := newNsUpdateCommand(&CLIContext{App: &testApp{}})
+			// the policy is validated in a flag group, too
+			require.NoError(t, cmd.Flags().Set("policy", "OR('Org1MSP.member')"))
+			for name, value := range tt.flags {
+				require.NoError(t, cmd.Flags().Set(name, value))
+			}
+
+			err := cmd.ValidateFlagGroups()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNsUpdateCommandRun_AutoVersion(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("DeployNamespace", mock.Anything, mock.MatchedBy(func(in *app.DeployNamespaceInput) bool {
+		return in.AutoVersion && in.NsID == "my-namespace"
+	})).Return(nil, app.TxStatus{}, nil)
+
+	var printerOut bytes.Buffer
+	cmd := newNsUpdateCommand(&CLIContext{
+		App:     mockApp,
+		Printer: cliio.NewCLIPrinter(&printerOut, &printerOut, cliio.FormatTable),
+	})
+	require.NoError(t, cmd.Flags().Set("policy", "OR('Org1MSP.member')"))
+	require.NoError(t, cmd.Flags().Set("auto-version", "true"))
+
+	err := cmd.RunE(cmd, []string{"my-namespace"})
+
+	require.NoError(t, err)
+	mockApp.AssertExpectations(t)
 }
 
 func TestNsUpdateCommandRun_TxReturned(t *testing.T) {
@@ -46,7 +120,7 @@ func TestNsUpdateCommandRun_TxReturned(t *testing.T) {
 		TxID: "tx-456",
 		Tx:   &applicationpb.Tx{},
 	}
-	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(deployOut, app.UnknownStatus, nil)
+	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(deployOut, app.TxStatus{}, nil)
 
 	var outBuf bytes.Buffer
 	cmd := newNsUpdateCommand(&CLIContext{
@@ -69,7 +143,7 @@ func TestNsUpdateCommandRun_NoTx(t *testing.T) {
 	t.Parallel()
 
 	mockApp := &testApp{}
-	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(nil, app.UnknownStatus, nil)
+	mockApp.On("DeployNamespace", mock.Anything, mock.Anything).Return(nil, app.TxStatus{}, nil)
 
 	var printerOut bytes.Buffer
 	cmd := newNsUpdateCommand(&CLIContext{
@@ -82,6 +156,6 @@ func TestNsUpdateCommandRun_NoTx(t *testing.T) {
 	err := cmd.RunE(cmd, []string{"my-namespace"})
 
 	require.NoError(t, err)
-	require.Contains(t, printerOut.String(), "Transaction status: STATUS_UNSPECIFIED")
+	require.Contains(t, printerOut.String(), "Transaction status: SUBMITTED")
 	mockApp.AssertExpectations(t)
 }
@@ -11,9 +11,13 @@ package v1
 import (
 	"github.com/spf13/cobra"
 
+	fmsp "github.com/hyperledger/fabric-x-common/msp"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/provider"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
 )
 
 // NewRootCommand constructs and returns the root command for fxconfig.
@@ -21,7 +25,10 @@ import (
 // Configuration is loaded in PersistentPreRunE.
 func NewRootCommand(cliCtx *CLIContext, buildApp func(cfg *config.Config) (app.Application, error)) *cobra.Command {
 	// cli flags
-	var cfgFile string
+	var (
+		cfgFile  string
+		txFormat string
+	)
 	rootCmd := &cobra.Command{
 		Use:   "fxconfig",
 		Short: "CLI tool for managing Fabric-X namespaces and transactions",
@@ -30,13 +37,33 @@ func NewRootCommand(cliCtx *CLIContext, buildApp func(cfg *config.Config) (app.A
 Namespaces in Fabric-X define isolated execution environments with their own
 endorsement policies. This tool allows you to:
   • Create and update namespaces with custom endorsement policies
+  • Apply declarative namespace manifests
   • Query installed namespaces and their configurations
   • Endorse, merge, and submit transactions
   • Manage transaction lifecycle across multiple organizations
+  • Stream the transactions of committed blocks
+  • Fetch and decode committed blocks
+  • Update the channel config with signatures of multiple organizations
 	
 Configuration can be provided via:
   • Config file (--config flag or $HOME/.fxconfig/config.yaml, .fxconfig/config.yaml)
-  • Environment variables (FXCONFIG_*)`,
+  • Environment variables (FXCONFIG_*)
+
+Transaction files are written in the format selected by --tx-format:
+  • json     - transaction ID and transaction as JSON (default)
+  • yaml     - the json structure as YAML
+  • proto    - binary protobuf transaction, without the transaction ID; such files
+               can be inspected, but not endorsed, merged, verified, or submitted
+  • envelope - binary envelope signed by the local MSP identity for the orderer
+               channel, ready to be broadcast by any tool
+The format of transaction files read is detected automatically.
+
+Exit codes:
+  0 - Success (commands that wait: all transactions committed)
+  1 - The command failed
+  2 - A transaction was rejected (finalized with a status other than COMMITTED)
+  3 - A transaction was not finalized within the waiting timeout
+  4 - A transaction is not known to the committer`,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			var opts []config.Option
 			// Add config file option if specified
@@ -53,9 +80,17 @@ Configuration can be provided via:
 			// set our config and printer in our context
 			cliCtx.Config = cfg
 			cliCtx.Printer = cliio.NewCLIPrinter(cmd.OutOrStdout(), cmd.ErrOrStderr(), cliio.FormatTable)
+			cliCtx.ErrOut = cmd.ErrOrStderr()
 
-			// output coded
-			cliCtx.IOTransactionCodec = &cliio.JSONCodec{}
+			// transaction codec
+			format, err := cliio.ParseTxFormat(txFormat)
+			if err != nil {
+				return err
+			}
+			cliCtx.IOTransactionCodec, err = cliio.NewAutoCodec(format, newEnvelopeCodec(cfg))
+			if err != nil {
+				return err
+			}
 
 			// set application in context
 			cliCtx.App, err = buildApp(cfg)
@@ -70,14 +105,33 @@ Configuration can be provided via:
 	// config parameter
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "",
 		"Config file (default is $HOME/.fxconfig/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&txFormat, "tx-format", string(cliio.TxFormatJSON),
+		"Format of written transaction files (json|yaml|proto|envelope)")
 
 	// Register all subcommands
 	rootCmd.AddCommand(NewVersionCommand())
 	rootCmd.AddCommand(NewInfoCommand(cliCtx))
 	rootCmd.AddCommand(NewNsRootCommand(cliCtx))
 	rootCmd.AddCommand(NewTxRootCommand(cliCtx))
+	rootCmd.AddCommand(NewApplyCommand(cliCtx))
+	rootCmd.AddCommand(NewEventsCommand(cliCtx))
+	rootCmd.AddCommand(NewBlockRootCommand(cliCtx))
+	rootCmd.AddCommand(NewChannelRootCommand(cliCtx))
 
 	rootCmd.SilenceUsage = true
 
 	return rootCmd
 }
+
+// newEnvelopeCodec returns an envelope codec for the orderer channel that signs with the local
+// MSP identity. The identity is only loaded once an envelope is encoded.
+func newEnvelopeCodec(cfg *config.Config) *cliio.EnvelopeCodec {
+	signer := provider.New[fmsp.SigningIdentity, *config.MSPConfig](
+		func(cfg *config.MSPConfig) (fmsp.SigningIdentity, error) {
+			return msp.GetSignerIdentityFromMSP(*cfg)
+		},
+		&cfg.MSP,
+		validation.NewValidationContext(),
+	)
+	return &cliio.EnvelopeCodec{Channel: cfg.Orderer.Channel, Signer: signer.Get}
+}
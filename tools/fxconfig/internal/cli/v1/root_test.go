@@ -7,6 +7,9 @@ SPDX-License-Identifier: Apache-2.0
 package v1
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -14,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 )
 
@@ -29,8 +33,9 @@ func TestNewRootCommand(t *testing.T) {
 	require.Equal(t, "fxconfig", rootCmd.Use)
 	require.NotEmpty(t, rootCmd.Short)
 
-	// --config flag must be registered
+	// --config and --tx-format flags must be registered
 	require.NotNil(t, rootCmd.PersistentFlags().Lookup("config"))
+	require.NotNil(t, rootCmd.PersistentFlags().Lookup("tx-format"))
 
 	// all top-level subcommands must be present
 	subCmds := make(map[string]bool)
@@ -41,6 +46,10 @@ func TestNewRootCommand(t *testing.T) {
 	require.True(t, subCmds["info"])
 	require.True(t, subCmds["namespace"])
 	require.True(t, subCmds["tx"])
+	require.True(t, subCmds["apply"])
+	require.True(t, subCmds["events"])
+	require.True(t, subCmds["block"])
+	require.True(t, subCmds["channel"])
 }
 
 const minimalConfig = `
@@ -70,6 +79,54 @@ func TestPersistentPreRunE_ViaConfigFlag(t *testing.T) {
 	require.Equal(t, "TestMSP", cliCtx.Config.MSP.LocalMspID)
 }
 
+func TestPersistentPreRunE_ErrOut(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(minimalConfig), 0o600))
+
+	// the application builder writes diagnostics, e.g., policy lint warnings, to the command's error writer
+	cliCtx := &CLIContext{}
+	rootCmd := NewRootCommand(cliCtx, func(_ *config.Config) (app.Application, error) {
+		_, _ = fmt.Fprintln(cliCtx.ErrOut, "Warning: policy lint")
+		return &testApp{}, nil
+	})
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs([]string{"--config", configPath, "version"})
+
+	require.NoError(t, rootCmd.Execute())
+	require.Equal(t, "Warning: policy lint\n", stderr.String())
+	require.NotContains(t, stdout.String(), "Warning")
+}
+
+func TestPersistentPreRunE_TxFormat(t *testing.T) {
+	t.Parallel()
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(minimalConfig), 0o600))
+
+	cliCtx := &CLIContext{}
+	rootCmd := NewRootCommand(cliCtx, func(_ *config.Config) (app.Application, error) {
+		return &testApp{}, nil
+	})
+	rootCmd.SetArgs([]string{"--config", configPath, "--tx-format", "yaml", "version"})
+
+	require.NoError(t, rootCmd.Execute())
+	require.IsType(t, &cliio.AutoCodec{}, cliCtx.IOTransactionCodec)
+	require.IsType(t, &cliio.YAMLCodec{}, cliCtx.IOTransactionCodec.(*cliio.AutoCodec).Encoder)
+
+	rootCmd = NewRootCommand(&CLIContext{}, func(_ *config.Config) (app.Application, error) {
+		return &testApp{}, nil
+	})
+	rootCmd.SetArgs([]string{"--config", configPath, "--tx-format", "xml", "version"})
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+
+	require.ErrorContains(t, rootCmd.Execute(), "invalid transaction format: xml")
+}
+
 func TestPersistentPreRunE_ViaProjectConfig(t *testing.T) { //nolint:paralleltest
 	// Not parallel: changes working directory.
 
@@ -7,12 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package v1
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 )
 
 // NewTxRootCommand returns the namespace command group.
 // This command provides subcommands for transaction operations:
-// endorse, merge, and submit.
+// endorse, merge, verify, inspect, submit, status, envelope, and broadcast.
 func NewTxRootCommand(ctx *CLIContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx",
@@ -23,21 +27,52 @@ Transaction Lifecycle:
   1. Create - Generate transaction (e.g., namespace create/update)
   2. Endorse - Collect signatures from required organizations
   3. Merge - Combine endorsements from multiple organizations
-  4. Submit - Send to ordering service for finalization
+  4. Verify - Optionally check the endorsements against the namespace policies
+  5. Submit - Send to ordering service for finalization
 
 Multi-Organization Workflow:
   1. Org1 creates transaction: fxconfig namespace create ... --output tx.json
   2. Org1 endorses: fxconfig tx endorse tx.json --output tx_org1.json
   3. Org2 endorses: fxconfig tx endorse tx.json --output tx_org2.json
   4. Merge endorsements: fxconfig tx merge tx_org1.json tx_org2.json --output merged.json
-  5. Submit: fxconfig tx submit merged.json --wait`,
+  5. Verify: fxconfig tx verify merged.json
+  6. Submit: fxconfig tx submit merged.json --wait
+  7. Status: fxconfig tx status <txID> --wait (if the submission timed out)
+
+Offline Signing:
+  The submission envelope can be signed on a host without network access and
+  broadcast from another host that holds no signing key:
+  1. Sign offline: fxconfig tx envelope merged.json --output tx.envelope
+  2. Broadcast: fxconfig tx broadcast tx.envelope --wait`,
 	}
 
 	cmd.AddCommand(
 		newTxMergeCommand(ctx),
 		newTxEndorseCommand(ctx),
 		newTxSubmitCommand(ctx),
+		newTxStatusCommand(ctx),
+		newTxVerifyCommand(ctx),
+		newTxInspectCommand(ctx),
+		newTxEnvelopeCommand(ctx),
+		newTxBroadcastCommand(ctx),
 	)
 
 	return cmd
 }
+
+// errMissingTxID is returned for transaction files without a transaction ID.
+var errMissingTxID = errors.New("transaction has no txID: the proto format does not carry it, " +
+	"use the json, yaml, or envelope format (--tx-format)")
+
+// decodeTx decodes a transaction file and ensures that it carries a transaction ID,
+// as required to endorse, merge, verify, wrap, or submit the transaction.
+func decodeTx(ctx *CLIContext, data []byte) (string, *applicationpb.Tx, error) {
+	txID, tx, err := ctx.IOTransactionCodec.Decode(data)
+	if err != nil {
+		return "", nil, err
+	}
+	if txID == "" {
+		return "", nil, errMissingTxID
+	}
+	return txID, tx, nil
+}
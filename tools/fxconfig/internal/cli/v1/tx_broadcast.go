@@ -0,0 +1,101 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// newTxBroadcastCommand creates a command for submitting pre-signed envelopes.
+func newTxBroadcastCommand(ctx *CLIContext) *cobra.Command {
+	var wait waitFlag
+
+	cmd := &cobra.Command{
+		Use:   "broadcast [file|-]...",
+		Short: "Submit pre-signed envelopes to ordering service",
+		Long: `Submit envelopes signed offline to the Fabric-X ordering service.
+
+Each argument is a file with a binary envelope, as written by
+'fxconfig tx envelope' or 'fxconfig --tx-format envelope', or "-" to read a
+single envelope from stdin. The envelopes are sent as they are: no MSP is
+loaded, so the submitting host does not need a signing key.
+
+All envelopes are broadcast over a single orderer stream, and a
+per-transaction status table is printed. With --wait, all transactions are
+subscribed to in a single notification request.
+
+Status Codes:
+  0 - All envelopes submitted (with --wait: all transactions committed)
+  1 - At least one envelope failed (see the status table for details)
+
+Examples:
+  # Broadcast a signed envelope
+  fxconfig tx broadcast tx.envelope
+
+  # Broadcast several envelopes and wait for finalization
+  fxconfig tx broadcast tx1.envelope tx2.envelope --wait`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inputs := make([]cliio.Input, len(args))
+			for i, arg := range args {
+				data, err := cliio.ResolveInput(cmd, arg)
+				if err != nil {
+					return err
+				}
+				inputs[i] = cliio.Input{Source: arg, Data: data}
+			}
+
+			envs, err := decodeEnvelopes(inputs)
+			if err != nil {
+				return err
+			}
+
+			results, err := ctx.App.BroadcastEnvelopes(cmd.Context(), envs, bool(wait))
+			if err != nil {
+				return err
+			}
+
+			return reportSubmissions(ctx, inputs, results, bool(wait))
+		},
+	}
+	wait.bind(cmd)
+
+	return cmd
+}
+
+// decodeEnvelopes decodes all inputs as envelopes and ensures that every txID is unique.
+func decodeEnvelopes(inputs []cliio.Input) ([]adapters.SignedEnvelope, error) {
+	envs := make([]adapters.SignedEnvelope, 0, len(inputs))
+	sources := make(map[string]string, len(inputs))
+
+	for _, in := range inputs {
+		txID, _, err := transaction.UnwrapEnvelope(in.Data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %w", in.Source, err)
+		}
+		env, err := protoutil.UnmarshalEnvelope(in.Data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %w", in.Source, err)
+		}
+
+		if prev, ok := sources[txID]; ok {
+			return nil, fmt.Errorf("duplicate txID %s in %s and %s", txID, prev, in.Source)
+		}
+		sources[txID] = in.Source
+
+		envs = append(envs, adapters.SignedEnvelope{TxID: txID, Envelope: env})
+	}
+
+	return envs, nil
+}
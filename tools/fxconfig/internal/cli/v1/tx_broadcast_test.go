@@ -0,0 +1,126 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// writeEnvelopeFile writes an unsigned envelope wrapping an empty transaction with the given ID.
+func writeEnvelopeFile(t *testing.T, txID string) string {
+	t.Helper()
+
+	chdr := protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, "mychannel", 0)
+	chdr.TxId = txID
+	payload := &cb.Payload{
+		Header: &cb.Header{
+			ChannelHeader:   protoutil.MarshalOrPanic(chdr),
+			SignatureHeader: protoutil.MarshalOrPanic(&cb.SignatureHeader{}),
+		},
+		Data: protoutil.MarshalOrPanic(&applicationpb.Tx{}),
+	}
+	env := &cb.Envelope{Payload: protoutil.MarshalOrPanic(payload), Signature: []byte("sig")}
+
+	path := filepath.Join(t.TempDir(), txID+".envelope")
+	require.NoError(t, os.WriteFile(path, protoutil.MarshalOrPanic(env), 0o600))
+
+	return path
+}
+
+func newTestBroadcastContext(mockApp *testApp, out *bytes.Buffer) *CLIContext {
+	return &CLIContext{App: mockApp, Printer: cliio.NewCLIPrinter(out, out, cliio.FormatTable)}
+}
+
+func TestNewTxBroadcastCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := newTxBroadcastCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "broadcast [file|-]...", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+	require.NotNil(t, cmd.Flags().Lookup("wait"))
+}
+
+func TestTxBroadcastCommand(t *testing.T) {
+	t.Parallel()
+
+	first, second := writeEnvelopeFile(t, "tx-1"), writeEnvelopeFile(t, "tx-2")
+
+	mockApp := &testApp{}
+	mockApp.On("BroadcastEnvelopes", mock.Anything, mock.MatchedBy(func(envs []adapters.SignedEnvelope) bool {
+		return len(envs) == 2 && envs[0].TxID == "tx-1" && envs[1].TxID == "tx-2" &&
+			bytes.Equal(envs[0].Envelope.GetSignature(), []byte("sig"))
+	}), true).Return([]app.TxSubmissionResult{
+		{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+		{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT)},
+	}, nil)
+
+	var out bytes.Buffer
+	cmd := newTxBroadcastCommand(newTestBroadcastContext(mockApp, &out))
+	cmd.SetArgs([]string{first, second, "--wait"})
+
+	err := cmd.Execute()
+	require.EqualError(t, err, "1 of 2 transactions did not commit")
+	require.Equal(t, ExitTxRejected, ExitCode(err))
+	require.Contains(t, out.String(), "COMMITTED")
+	require.Contains(t, out.String(), "ABORTED_MVCC_CONFLICT")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxBroadcastCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	envFile := writeEnvelopeFile(t, "tx-1")
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	tests := []struct {
+		name    string
+		args    []string
+		appErr  error
+		wantErr string
+	}{
+		{name: "not an envelope", args: []string{txFile}, wantErr: "cannot decode " + txFile},
+		{name: "duplicate txID", args: []string{envFile, envFile}, wantErr: "duplicate txID tx-1"},
+		{
+			name:    "app error",
+			args:    []string{envFile},
+			appErr:  errors.New("orderer unavailable"),
+			wantErr: "orderer unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("BroadcastEnvelopes", mock.Anything, mock.Anything, false).Return(nil, tt.appErr)
+
+			var out bytes.Buffer
+			cmd := newTxBroadcastCommand(newTestBroadcastContext(mockApp, &out))
+			cmd.SetArgs(tt.args)
+
+			require.ErrorContains(t, cmd.Execute(), tt.wantErr)
+		})
+	}
+}
@@ -7,14 +7,23 @@ SPDX-License-Identifier: Apache-2.0
 package v1
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
 // newTxEndorseCommand creates a command for endorsing transactions.
 func newTxEndorseCommand(ctx *CLIContext) *cobra.Command {
-	var output outputFlag
+	var (
+		output       outputFlag
+		thresholdKey string
+		namespaces   []string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "endorse [file]",
@@ -31,6 +40,14 @@ in JSON format (as generated by namespace create/update commands).
 If the input transaction is already endorsed, the new endorsement will be
 appended to the existing endorsements.
 
+Namespaces governed by a threshold policy are endorsed with --threshold-key,
+the private key (PEM) matching the public key of the policy, instead of the
+MSP identity. Prefix the key path with the scheme of the policy, eddsa: or
+bls:, for policies that are not ECDSA. The committer only verifies the first endorsement of such a
+namespace, so the threshold endorsement is placed first. Use --namespace to
+select the threshold namespaces of a transaction that also writes to
+namespaces with MSP policies.
+
 Examples:
   # Endorse transaction and save to new file
   fxconfig tx endorse tx.json --output tx_org1.json
@@ -39,7 +56,13 @@ Examples:
   fxconfig tx endorse tx.json > tx_org1.json
 
   # Endorse with custom config
-  fxconfig tx endorse tx.json --config /path/to/org1-config.yaml --output tx_org1.json`,
+  fxconfig tx endorse tx.json --config /path/to/org1-config.yaml --output tx_org1.json
+
+  # Endorse a namespace with a threshold policy
+  fxconfig tx endorse tx.json --threshold-key payments_key.pem --namespace payments
+
+  # Endorse a namespace with an EdDSA threshold policy
+  fxconfig tx endorse tx.json --threshold-key eddsa:tokens_key.pem --namespace tokens`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			input, err := cliio.ResolveInput(cmd, args[0])
@@ -47,14 +70,35 @@ Examples:
 				return err
 			}
 
-			txID, tx, err := ctx.IOTransactionCodec.Decode(input)
+			txID, tx, err := decodeTx(ctx, input)
 			if err != nil {
 				return err
 			}
 
-			endorsedTx, err := ctx.App.EndorseTransaction(cmd.Context(), txID, tx)
-			if err != nil {
-				return err
+			if thresholdKey == "" && len(namespaces) > 0 {
+				return errors.New("--namespace requires --threshold-key")
+			}
+
+			var endorsedTx *applicationpb.Tx
+			if thresholdKey != "" {
+				signer, err := transaction.LoadThresholdSigner(transaction.SplitSchemePath(thresholdKey))
+				if err != nil {
+					return err
+				}
+				endorsedTx, err = ctx.App.EndorseTransactionWithKey(cmd.Context(), &app.ThresholdEndorseInput{
+					TxID:       txID,
+					Tx:         tx,
+					Signer:     signer,
+					Namespaces: namespaces,
+				})
+				if err != nil {
+					return err
+				}
+			} else {
+				endorsedTx, err = ctx.App.EndorseTransaction(cmd.Context(), txID, tx)
+				if err != nil {
+					return err
+				}
 			}
 
 			o, err := ctx.IOTransactionCodec.Encode(txID, endorsedTx)
@@ -66,6 +110,10 @@ Examples:
 		},
 	}
 	output.bind(cmd)
+	cmd.Flags().StringVar(&thresholdKey, "threshold-key", "",
+		"Private key ([scheme:]path to PEM) of a threshold policy to endorse with instead of the MSP identity")
+	cmd.Flags().StringArrayVar(&namespaces, "namespace", nil,
+		"Namespace to endorse with the threshold key (repeatable; default all namespaces)")
 
 	return cmd
 }
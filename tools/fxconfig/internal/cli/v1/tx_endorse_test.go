@@ -8,16 +8,25 @@ package v1
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
 
@@ -31,6 +40,8 @@ func TestNewTxEndorseCommand(t *testing.T) {
 	require.NotEmpty(t, cmd.Short)
 	require.NotNil(t, cmd.RunE)
 	require.NotNil(t, cmd.Flags().Lookup("output"))
+	require.NotNil(t, cmd.Flags().Lookup("threshold-key"))
+	require.NotNil(t, cmd.Flags().Lookup("namespace"))
 }
 
 func TestTxEndorseCommand_Success(t *testing.T) {
@@ -104,3 +115,123 @@ func writeTxFile(t *testing.T, txID string, tx *applicationpb.Tx) string {
 
 	return path
 }
+
+func TestTxEndorseCommand_ProtoFormat(t *testing.T) {
+	t.Parallel()
+
+	data, err := (&cliio.ProtoCodec{}).Encode("test-tx-id", &applicationpb.Tx{
+		Namespaces: []*applicationpb.TxNamespace{{NsId: "payments"}},
+	})
+	require.NoError(t, err)
+	txFile := filepath.Join(t.TempDir(), "tx.pb")
+	require.NoError(t, os.WriteFile(txFile, data, 0o600))
+
+	// EndorseTransaction is not expected to be called: the endorsement would sign an empty txID
+	mockApp := &testApp{}
+	for _, newCmd := range []func(*CLIContext) *cobra.Command{
+		newTxEndorseCommand, newTxVerifyCommand, newTxEnvelopeCommand, newTxSubmitCommand,
+	} {
+		cmd := newCmd(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.AutoCodec{}})
+		cmd.SetArgs([]string{txFile})
+		require.ErrorIs(t, cmd.Execute(), errMissingTxID, cmd.Name())
+	}
+
+	cmd := newTxMergeCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.AutoCodec{}})
+	cmd.SetArgs([]string{txFile, txFile})
+	require.ErrorIs(t, cmd.Execute(), errMissingTxID)
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxEndorseCommand_ThresholdKey(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "test-tx-id", &applicationpb.Tx{})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	edKeyFile := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(edKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER}), 0o600))
+
+	tests := []struct {
+		name   string
+		key    string
+		scheme string
+	}{
+		{name: "ECDSA", key: keyFile, scheme: "ECDSA"},
+		{name: "EdDSA", key: "eddsa:" + edKeyFile, scheme: "EDDSA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("EndorseTransactionWithKey", mock.Anything, mock.MatchedBy(func(in *app.ThresholdEndorseInput) bool {
+				return in.TxID == "test-tx-id" && in.Signer.Scheme() == tt.scheme &&
+					slices.Equal(in.Namespaces, []string{"payments", "assets"})
+			})).Return(&applicationpb.Tx{}, nil)
+
+			var outBuf bytes.Buffer
+			cmd := newTxEndorseCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.JSONCodec{}})
+			cmd.SetOut(&outBuf)
+			cmd.SetArgs([]string{txFile, "--threshold-key", tt.key, "--namespace", "payments", "--namespace", "assets"})
+
+			require.NoError(t, cmd.Execute())
+			require.NotEmpty(t, outBuf.String())
+			mockApp.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTxEndorseCommand_ThresholdKeyErrors(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "test-tx-id", &applicationpb.Tx{})
+	notAKey := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(notAKey, []byte("not a key"), 0o600))
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{
+			name:        "namespace without key",
+			args:        []string{txFile, "--namespace", "payments"},
+			expectError: "--namespace requires --threshold-key",
+		},
+		{
+			name:        "invalid key",
+			args:        []string{txFile, "--threshold-key", notAKey},
+			expectError: "no PEM data found",
+		},
+		{
+			name:        "unknown scheme",
+			args:        []string{txFile, "--threshold-key", "rsa:" + notAKey},
+			expectError: `unsupported scheme "rsa"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the app is not expected to be called
+			mockApp := &testApp{}
+			cmd := newTxEndorseCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.JSONCodec{}})
+			cmd.SetArgs(tt.args)
+
+			require.ErrorContains(t, cmd.Execute(), tt.expectError)
+			mockApp.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newTxEnvelopeCommand creates a command for signing the submission envelope of a transaction offline.
+func newTxEnvelopeCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		output  outputFlag
+		channel string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "envelope [file|-]",
+		Short: "Sign the submission envelope of a transaction offline",
+		Long: `Wrap an endorsed transaction in an envelope signed by the local MSP identity.
+
+The envelope is built and signed exactly as by 'fxconfig tx submit', but no
+service is contacted, so this command can run on a host without network
+access that holds the signing key. The signed envelope is written as binary
+protobuf and can be submitted from another host with 'fxconfig tx broadcast',
+or by any other tool.
+
+The channel defaults to the orderer channel of the configuration.
+
+Examples:
+  # Sign the envelope of a merged transaction
+  fxconfig tx envelope merged_tx.json --output tx.envelope
+
+  # Sign for a specific channel
+  fxconfig tx envelope merged_tx.json --channel mychannel --output tx.envelope
+
+  # Broadcast the envelope from a connected host
+  fxconfig tx broadcast tx.envelope --wait`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, err := cliio.ResolveInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			txID, tx, err := decodeTx(ctx, input)
+			if err != nil {
+				return err
+			}
+
+			if channel == "" {
+				channel = ctx.Config.Orderer.Channel
+			}
+
+			env, err := ctx.App.SignEnvelope(cmd.Context(), channel, txID, tx)
+			if err != nil {
+				return err
+			}
+
+			o, err := proto.Marshal(env)
+			if err != nil {
+				return err
+			}
+
+			return cliio.WriteOutput(cmd, string(output), o)
+		},
+	}
+	output.bind(cmd)
+	cmd.Flags().StringVar(&channel, "channel", "", "Channel name (defaults to the orderer channel of the configuration)")
+
+	return cmd
+}
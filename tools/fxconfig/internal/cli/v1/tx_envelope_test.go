@@ -0,0 +1,96 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+func newTestEnvelopeContext(mockApp *testApp) *CLIContext {
+	return &CLIContext{
+		App:                mockApp,
+		Config:             &config.Config{Orderer: config.OrdererConfig{Channel: "mychannel"}},
+		IOTransactionCodec: &cliio.AutoCodec{},
+	}
+}
+
+func TestNewTxEnvelopeCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := newTxEnvelopeCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "envelope [file|-]", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+	require.NotNil(t, cmd.Flags().Lookup("output"))
+	require.NotNil(t, cmd.Flags().Lookup("channel"))
+}
+
+func TestTxEnvelopeCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantChannel string
+	}{
+		{name: "configured channel", wantChannel: "mychannel"},
+		{name: "channel flag", args: []string{"--channel", "otherchannel"}, wantChannel: "otherchannel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+			output := filepath.Join(t.TempDir(), "tx.envelope")
+			env := &cb.Envelope{Payload: []byte("payload"), Signature: []byte("sig")}
+
+			mockApp := &testApp{}
+			mockApp.On("SignEnvelope", mock.Anything, tt.wantChannel, "tx-1", mock.Anything).Return(env, nil)
+
+			cmd := newTxEnvelopeCommand(newTestEnvelopeContext(mockApp))
+			cmd.SetArgs(append([]string{txFile, "--output", output}, tt.args...))
+
+			require.NoError(t, cmd.Execute())
+			mockApp.AssertExpectations(t)
+
+			data, err := os.ReadFile(output)
+			require.NoError(t, err)
+			written, err := protoutil.UnmarshalEnvelope(data)
+			require.NoError(t, err)
+			require.Equal(t, []byte("sig"), written.GetSignature())
+		})
+	}
+}
+
+func TestTxEnvelopeCommand_AppError(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("SignEnvelope", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("msp unavailable"))
+
+	cmd := newTxEnvelopeCommand(newTestEnvelopeContext(mockApp))
+	cmd.SetArgs([]string{txFile})
+
+	require.ErrorContains(t, cmd.Execute(), "msp unavailable")
+}
@@ -0,0 +1,180 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// maxValueWidth is the number of characters of a value shown in the inspect table.
+const maxValueWidth = 64
+
+// newTxInspectCommand creates a command for decoding a transaction file into a readable report.
+func newTxInspectCommand(ctx *CLIContext) *cobra.Command {
+	var format formatFlag
+
+	cmd := &cobra.Command{
+		Use:   "inspect [file|-]",
+		Short: "Decode and print a transaction",
+		Long: `Decode a transaction file into a readable report.
+
+Displays for each namespace:
+  • Read, read-write, and blind-write keys with their read versions
+  • Written values; values of the meta-namespace are decoded as namespace policies
+  • Endorsements with the signer's MSP ID, certificate subject, and expiry,
+    and whether the signature verifies with the signer's certificate
+
+Keys and values are shown as text if printable, and as hex prefixed with "0x"
+otherwise. In table format, long values are truncated; use --format json or
+yaml for the full values.
+
+Examples:
+  # Inspect a transaction
+  fxconfig tx inspect tx.json
+
+  # Inspect a transaction from stdin as yaml
+  cat tx.json | fxconfig tx inspect - --format yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
+			data, err := cliio.ResolveInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			txID, tx, err := ctx.IOTransactionCodec.Decode(data)
+			if err != nil {
+				return err
+			}
+
+			report, err := ctx.App.InspectTransaction(cmd.Context(), txID, tx)
+			if err != nil {
+				return err
+			}
+
+			if f != cliio.FormatTable {
+				out, err := cliio.Marshal(f, report)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(out))
+				return nil
+			}
+
+			ctx.Printer.Print(renderTxReport(report))
+			return nil
+		},
+	}
+	format.bind(cmd)
+
+	return cmd
+}
+
+// renderTxReport renders the report of a transaction.
+func renderTxReport(r *transaction.TxReport) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Transaction %s\n", r.TxID)
+	for _, ns := range r.Namespaces {
+		fmt.Fprintf(&b, "\nNamespace %s (version %d)\n", ns.NsID, ns.Version)
+		renderKeys(&b, "reads", ns.Reads)
+		renderKeys(&b, "read-writes", ns.ReadWrites)
+		renderKeys(&b, "blind-writes", ns.BlindWrites)
+
+		b.WriteString("  endorsements:\n")
+		if len(ns.Endorsements) == 0 {
+			b.WriteString("    none\n")
+		}
+		for i, e := range ns.Endorsements {
+			fmt.Fprintf(&b, "    [%d] %s\n", i, endorsementSummary(e))
+		}
+	}
+
+	return b.String()
+}
+
+func renderKeys(b *strings.Builder, title string, keys []transaction.KeyReport) {
+	if len(keys) == 0 {
+		return
+	}
+
+	rows := make([][]string, len(keys))
+	for i, k := range keys {
+		version := "-"
+		if k.Version != nil {
+			version = strconv.FormatUint(*k.Version, 10)
+		}
+
+		value := truncate(k.Value, maxValueWidth)
+		switch {
+		case k.Policy != nil:
+			value = k.Policy.String()
+		case k.PolicyError != "":
+			value = fmt.Sprintf("<undecodable policy: %s> %s", k.PolicyError, value)
+		}
+
+		rows[i] = []string{k.Key, version, value}
+	}
+
+	fmt.Fprintf(b, "  %s:\n", title)
+	for line := range strings.Lines(cliio.RenderTable([]string{"KEY", "VERSION", "VALUE"}, rows)) {
+		b.WriteString("    " + line)
+	}
+}
+
+// endorsementSummary renders the signer of an endorsement and whether its signature verifies.
+func endorsementSummary(e transaction.EndorsementCheck) string {
+	var b strings.Builder
+
+	b.WriteString(endorserName(e))
+	if e.Expires != nil {
+		fmt.Fprintf(&b, ", expires %s", e.Expires.UTC().Format(time.RFC3339))
+	}
+
+	switch {
+	case e.Valid:
+		b.WriteString(": signature valid")
+	case e.Subject != "":
+		fmt.Fprintf(&b, ": signature invalid (%s)", e.Error)
+	default:
+		fmt.Fprintf(&b, ": signature not verified (%s)", e.Error)
+	}
+
+	return b.String()
+}
+
+// endorserName renders the MSP ID and certificate subject of an endorsement.
+func endorserName(e transaction.EndorsementCheck) string {
+	switch {
+	case e.MSPID == "":
+		return "<no identity>"
+	case e.Subject == "":
+		return e.MSPID
+	default:
+		return e.MSPID + " " + e.Subject
+	}
+}
+
+// truncate shortens s to at most n characters.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return fmt.Sprintf("%s... (%d characters)", string(runes[:n]), len(runes))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+func someTxReport() *transaction.TxReport {
+	version := uint64(2)
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &transaction.TxReport{
+		TxID: "tx-1",
+		Namespaces: []transaction.NamespaceReport{
+			{
+				NsID: "_meta",
+				ReadWrites: []transaction.KeyReport{{
+					Key:     "payments",
+					Version: &version,
+					Policy:  &transaction.PolicyDescription{Type: "msp", Expression: "OR('Org1MSP.member')"},
+				}},
+				Endorsements: []transaction.EndorsementCheck{
+					{MSPID: "Org1MSP", Subject: "CN=admin", Expires: &expires, Valid: true},
+					{MSPID: "Org2MSP", Subject: "CN=peer", Error: "signature mismatch"},
+					{Error: "endorsement has no identity"},
+				},
+			},
+			{
+				NsID:        "data",
+				Version:     1,
+				BlindWrites: []transaction.KeyReport{{Key: "0x00ff", Value: string(bytes.Repeat([]byte("a"), 70))}},
+			},
+		},
+	}
+}
+
+func TestNewTxInspectCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := newTxInspectCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "inspect [file|-]", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+	require.NotNil(t, cmd.Flags().Lookup("format"))
+}
+
+func TestTxInspectCommand_Table(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("InspectTransaction", mock.Anything, "tx-1", mock.Anything).Return(someTxReport(), nil)
+
+	var out bytes.Buffer
+	cmd := newTxInspectCommand(&CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&out, &out, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	})
+	cmd.SetArgs([]string{txFile})
+
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+
+	expected := `Transaction tx-1
+
+Namespace _meta (version 0)
+  read-writes:
+    KEY       VERSION  VALUE
+    payments  2        msp OR('Org1MSP.member')
+  endorsements:
+    [0] Org1MSP CN=admin, expires 2030-01-01T00:00:00Z: signature valid
+    [1] Org2MSP CN=peer: signature invalid (signature mismatch)
+    [2] <no identity>: signature not verified (endorsement has no identity)
+
+Namespace data (version 1)
+  blind-writes:
+    KEY     VERSION  VALUE
+    0x00ff  -        aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa... (70 characters)
+  endorsements:
+    none
+`
+	require.Equal(t, expected, out.String())
+}
+
+func TestTxInspectCommand_JSON(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("InspectTransaction", mock.Anything, "tx-1", mock.Anything).Return(someTxReport(), nil)
+
+	var out bytes.Buffer
+	cmd := newTxInspectCommand(&CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&out, &out, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	})
+	cmd.SetArgs([]string{txFile, "--format", "json"})
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), `"expires": "2030-01-01T00:00:00Z"`)
+	require.Contains(t, out.String(), `"expression": "OR('Org1MSP.member')"`)
+}
+
+func TestTxInspectCommand_AppError(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("InspectTransaction", mock.Anything, "tx-1", mock.Anything).Return(nil, errors.New("nil transaction"))
+
+	cmd := newTxInspectCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.JSONCodec{}})
+	cmd.SetArgs([]string{txFile})
+
+	require.ErrorContains(t, cmd.Execute(), "nil transaction")
+}
@@ -83,7 +83,7 @@ func resolveInputs(ctx *CLIContext, cmd *cobra.Command, args []string) (string,
 			return "", nil, err
 		}
 
-		id, tx, err := ctx.IOTransactionCodec.Decode(input)
+		id, tx, err := decodeTx(ctx, input)
 		if err != nil {
 			return "", nil, err
 		}
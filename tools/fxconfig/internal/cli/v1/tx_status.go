@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+)
+
+// newTxStatusCommand creates a command for querying the status of submitted transactions.
+func newTxStatusCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		wait    waitFlag
+		timeout time.Duration
+		format  formatFlag
+	)
+
+	cmd := &cobra.Command{
+		Use:   "status <txID>...",
+		Short: "Show the status of submitted transactions",
+		Long: `Query the final status of already submitted transactions by their txID.
+
+The status is read from the query service of the committer. A transaction that
+is not committed (yet) is reported as NOT_FOUND. With --wait, such transactions
+are awaited with the notification service until they are finalized or the
+waiting timeout expires, and are then reported as TIMED_OUT. Nothing is
+resubmitted. The waiting timeout is notifications.waitingTimeout unless --timeout
+is set, which may also exceed it.
+
+Use this command to find out whether a transaction committed after
+'fxconfig tx submit --wait' timed out.
+
+Exit Codes:
+  0 - All transactions successfully committed
+  1 - The command failed, e.g., the query service is unavailable
+  2 - A transaction was rejected (finalized with a status other than COMMITTED)
+  3 - A transaction was not finalized within the waiting timeout
+  4 - A transaction is not known to the committer (without --wait)
+If transactions end differently, the lowest non-zero code applies.
+
+Examples:
+  # Show the status of a transaction
+  fxconfig tx status 3f2a...
+
+  # Wait up to 2 minutes for two transactions to be finalized
+  fxconfig tx status 3f2a... 9b1c... --wait --timeout 2m
+
+  # Show the status as json
+  fxconfig tx status 3f2a... --format json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed("timeout") {
+				if timeout <= 0 {
+					return fmt.Errorf("invalid timeout %s: must be positive", timeout)
+				}
+				wait = true
+			}
+
+			results, err := ctx.App.TransactionStatus(cmd.Context(), &app.TxStatusInput{
+				TxIDs:   args,
+				Wait:    bool(wait),
+				Timeout: timeout,
+			})
+			if err != nil {
+				return err
+			}
+
+			if f != cliio.FormatTable {
+				views := make([]txStatusView, len(results))
+				for i, r := range results {
+					views[i] = newTxStatusView(r)
+				}
+				data, err := cliio.Marshal(f, views)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(data))
+			} else {
+				ctx.Printer.Print(renderTxStatuses(results))
+			}
+
+			var failed int
+			for _, r := range results {
+				if r.Err != nil || !r.Status.Committed() {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return &exitError{
+					code: resultsExitCode(results),
+					err:  fmt.Errorf("%d of %d transactions are not committed", failed, len(results)),
+				}
+			}
+			return nil
+		},
+	}
+	wait.bind(cmd)
+	cmd.Flags().DurationVar(&timeout, "timeout", 0,
+		"Maximum time to wait for the transactions to be finalized instead of the waiting timeout (implies --wait)")
+	format.bind(cmd)
+
+	return cmd
+}
+
+// txStatusView is the json and yaml representation of the status of a transaction.
+type txStatusView struct {
+	TxID string `json:"txID" yaml:"txID"`
+	// State is one of final, timed-out, not-found, or unknown.
+	State string `json:"state" yaml:"state"`
+	// Status and Code are the committer status of a finalized transaction.
+	Status   string  `json:"status" yaml:"status"`
+	Code     int32   `json:"code" yaml:"code"`
+	BlockNum *uint64 `json:"block,omitempty" yaml:"block,omitempty"`
+	TxNum    *uint32 `json:"txNum,omitempty" yaml:"txNum,omitempty"`
+	ExitCode int     `json:"exitCode" yaml:"exitCode"`
+	Error    string  `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newTxStatusView(r app.TxSubmissionResult) txStatusView {
+	v := txStatusView{
+		TxID:     r.TxID,
+		State:    r.Status.State.String(),
+		Status:   r.Status.Status.String(),
+		Code:     int32(r.Status.Status),
+		ExitCode: statusExitCode(r.Status),
+	}
+	if r.Status.State == adapters.TxStateFinal {
+		v.BlockNum, v.TxNum = &r.Status.BlockNum, &r.Status.TxNum
+	}
+	if r.Err != nil {
+		v.Error = r.Err.Error()
+		v.ExitCode = ExitError
+	}
+	return v
+}
+
+// renderTxStatuses renders the status of transactions as a table.
+func renderTxStatuses(results []app.TxSubmissionResult) string {
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		var errMsg string
+		if r.Err != nil {
+			errMsg = r.Err.Error()
+		}
+		block, txNum := positionColumns(r.Status)
+		rows[i] = []string{r.TxID, statusLabel(r.Status), block, txNum, errMsg}
+	}
+
+	return cliio.RenderTable([]string{"TXID", "STATUS", "BLOCK", "TXNUM", "ERROR"}, rows)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+func TestTxStatusCommand(t *testing.T) {
+	t.Parallel()
+
+	committed := app.TxSubmissionResult{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)}
+	rejected := app.TxSubmissionResult{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT)}
+	timedOut := app.TxSubmissionResult{TxID: "tx-3", Status: app.TxStatus{TxID: "tx-3", State: adapters.TxStateTimedOut}}
+	notFound := app.TxSubmissionResult{TxID: "tx-4", Status: app.TxStatus{TxID: "tx-4", State: adapters.TxStateNotFound}}
+
+	tests := []struct {
+		name         string
+		args         []string
+		results      []app.TxSubmissionResult
+		appErr       error
+		expectWait   bool
+		expectOutput []string
+		expectError  string
+		expectCode   int
+	}{
+		{
+			name:         "committed",
+			args:         []string{"tx-1"},
+			results:      []app.TxSubmissionResult{committed},
+			expectOutput: []string{"TXID", "BLOCK", "tx-1", "COMMITTED", "5"},
+		},
+		{
+			name:         "not found",
+			args:         []string{"tx-1", "tx-4"},
+			results:      []app.TxSubmissionResult{committed, notFound},
+			expectOutput: []string{"NOT_FOUND"},
+			expectError:  "1 of 2 transactions are not committed",
+			expectCode:   ExitTxNotFound,
+		},
+		{
+			name:         "timed out",
+			args:         []string{"tx-3", "--wait"},
+			results:      []app.TxSubmissionResult{timedOut},
+			expectWait:   true,
+			expectOutput: []string{"TIMED_OUT"},
+			expectError:  "1 of 1 transactions are not committed",
+			expectCode:   ExitTxTimedOut,
+		},
+		{
+			name:         "rejected",
+			args:         []string{"tx-1", "tx-2", "tx-3", "--wait"},
+			results:      []app.TxSubmissionResult{committed, rejected, timedOut},
+			expectWait:   true,
+			expectOutput: []string{"ABORTED_MVCC_CONFLICT", "TIMED_OUT"},
+			expectError:  "2 of 3 transactions are not committed",
+			expectCode:   ExitTxRejected,
+		},
+		{
+			name:        "invalid timeout",
+			args:        []string{"tx-1", "--timeout", "0s"},
+			expectError: "invalid timeout 0s: must be positive",
+			expectCode:  ExitError,
+		},
+		{
+			name:        "app error",
+			args:        []string{"tx-1"},
+			appErr:      errors.New("query service unavailable"),
+			expectError: "query service unavailable",
+			expectCode:  ExitError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockApp := &testApp{}
+			mockApp.On("TransactionStatus", mock.Anything, mock.MatchedBy(func(in *app.TxStatusInput) bool {
+				return in.Wait == tt.expectWait
+			})).Return(tt.results, tt.appErr).Maybe()
+
+			var outBuf bytes.Buffer
+			ctx := &CLIContext{
+				Config:  &config.Config{},
+				App:     mockApp,
+				Printer: cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+			}
+
+			cmd := newTxStatusCommand(ctx)
+			cmd.SetOut(&outBuf)
+			cmd.SetErr(&outBuf)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectCode, ExitCode(err))
+			for _, o := range tt.expectOutput {
+				require.Contains(t, outBuf.String(), o)
+			}
+		})
+	}
+}
+
+func TestTxStatusCommand_Timeout(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("TransactionStatus", mock.Anything, &app.TxStatusInput{
+		TxIDs:   []string{"tx-1"},
+		Wait:    true,
+		Timeout: 2 * time.Minute,
+	}).Return([]app.TxSubmissionResult{{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)}}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		Config:  &config.Config{},
+		App:     mockApp,
+		Printer: cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+	}
+
+	cmd := newTxStatusCommand(ctx)
+	cmd.SetArgs([]string{"tx-1", "--timeout", "2m"})
+	require.NoError(t, cmd.Execute())
+
+	// the timeout is passed to the application, leaving the shared configuration untouched
+	require.Zero(t, ctx.Config.Notifications.WaitingTimeout)
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxStatusCommand_JSON(t *testing.T) {
+	t.Parallel()
+
+	mockApp := &testApp{}
+	mockApp.On("TransactionStatus", mock.Anything, mock.Anything).
+		Return([]app.TxSubmissionResult{
+			{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+			{TxID: "tx-2", Status: app.TxStatus{TxID: "tx-2", State: adapters.TxStateNotFound}},
+		}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		App:     mockApp,
+		Printer: cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+	}
+
+	cmd := newTxStatusCommand(ctx)
+	cmd.SetArgs([]string{"tx-1", "tx-2", "--format", "json"})
+	require.Equal(t, ExitTxNotFound, ExitCode(cmd.Execute()))
+
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal(outBuf.Bytes(), &decoded))
+	require.Equal(t, []map[string]any{
+		{
+			"txID": "tx-1", "state": "final", "status": "COMMITTED", "code": float64(1),
+			"block": float64(5), "txNum": float64(1), "exitCode": float64(ExitOK),
+		},
+		{
+			"txID": "tx-2", "state": "not-found", "status": "STATUS_UNSPECIFIED", "code": float64(0),
+			"exitCode": float64(ExitTxNotFound),
+		},
+	}, decoded)
+}
@@ -7,30 +7,57 @@ SPDX-License-Identifier: Apache-2.0
 package v1
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
-	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
 
 // newTxSubmitCommand creates a command for submitting transactions.
 func newTxSubmitCommand(ctx *CLIContext) *cobra.Command {
-	var wait waitFlag
+	var (
+		wait     waitFlag
+		verify   bool
+		policies string
+	)
 
 	cmd := &cobra.Command{
-		Use:   "submit [file]",
-		Short: "Submit transaction to ordering service",
-		Long: `Submit an endorsed transaction to the Fabric-X ordering service.
+		Use:   "submit [file|dir|-]...",
+		Short: "Submit transactions to ordering service",
+		Long: `Submit endorsed transactions to the Fabric-X ordering service.
 
 The transaction must have sufficient endorsements to satisfy its endorsement
 policy. Use 'fxconfig tx merge' to combine endorsements from multiple
 organizations before submission.
 
-Status Codes (with --wait):
-  0 - Transaction successfully committed
-  1 - Transaction failed (see error message for details)
+Multiple transactions can be submitted at once. Each argument is one of:
+  • A transaction file
+  • A JSONL file (.jsonl) with one transaction per line
+  • A directory; its files are submitted in lexical order, skipping hidden files
+    and subdirectories. Each file is decoded like a transaction file, hence a
+    directory must only contain transactions of the --tx-format (or of any
+    auto-detected format), and its .jsonl files are read as JSONL batches
+  • "-" to read from stdin, either a JSONL stream or a single transaction
+
+With --verify, the endorsements of every transaction are first checked against
+the committed namespace policies (see 'fxconfig tx verify'), and nothing is
+submitted if a policy is not satisfied. Namespace transactions created by
+'fxconfig namespace' write to the meta-namespace, whose policy can only be
+verified with a policy file passed with --policies.
+
+A batch is broadcast over a single orderer stream. With --wait, all
+transactions are subscribed to in a single notification request and a
+per-transaction status table is printed.
+
+Exit Codes (with --wait):
+  0 - All transactions successfully committed
+  1 - The command failed or a transaction could not be submitted
+  2 - A transaction was rejected (finalized with a status other than COMMITTED)
+  3 - A transaction was not finalized within the waiting timeout
 
 Examples:
   # Submit transaction (returns immediately)
@@ -42,6 +69,18 @@ Examples:
   # Submit with custom config
   fxconfig tx submit merged_tx.json --config /path/to/config.yaml --wait
 
+  # Check endorsements before submission
+  fxconfig tx submit merged_tx.json --verify --wait
+
+  # Check the endorsements of a namespace transaction against a local policy file
+  fxconfig tx submit ns_tx.json --verify --policies policies.yaml --wait
+
+  # Submit all transactions in a directory and wait for all of them
+  fxconfig tx submit ./txs/ --wait
+
+  # Submit a JSONL stream from stdin
+  cat batch.jsonl | fxconfig tx submit - --wait
+
   # Submit and capture status
   if fxconfig tx submit merged_tx.json --wait; then
     echo "Transaction committed successfully"
@@ -50,37 +89,177 @@ Examples:
   fi`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			input, err := cliio.ResolveInput(cmd, args[0])
+			if policies != "" && !verify {
+				return errors.New("--policies requires --verify")
+			}
+
+			inputs, err := cliio.ResolveInputs(cmd, args)
 			if err != nil {
 				return err
 			}
 
-			txID, tx, err := ctx.IOTransactionCodec.Decode(input)
+			txs, err := decodeBatch(ctx, inputs)
 			if err != nil {
 				return err
 			}
 
+			if verify {
+				var manifest *app.NamespaceManifest
+				if policies != "" {
+					manifest, err = readManifest(policies)
+					if err != nil {
+						return err
+					}
+				}
+				if err := verifyBatch(cmd, ctx, txs, manifest); err != nil {
+					return err
+				}
+			}
+
+			if len(txs) > 1 {
+				return submitBatch(cmd, ctx, inputs, txs, bool(wait))
+			}
+
+			txID, tx := txs[0].TxID, txs[0].Tx
 			if wait {
 				status, err := ctx.App.SubmitTransactionWithWait(cmd.Context(), txID, tx)
 				if err != nil {
 					return err
 				}
-				ctx.Printer.Print(
-					fmt.Sprintf("Transaction status: %s", committerpb.Status_name[int32(status)]), //nolint:gosec
-				)
-				if status != int(committerpb.Status_COMMITTED) {
-					return fmt.Errorf(
-						"transaction failed with status: %s",
-						committerpb.Status_name[int32(status)], //nolint:gosec
-					)
-				}
-				return nil
+				ctx.Printer.Print(fmt.Sprintf("Transaction status: %s", describeStatus(status)))
+				return statusError(status)
 			}
 
 			return ctx.App.SubmitTransaction(cmd.Context(), txID, tx)
 		},
 	}
 	wait.bind(cmd)
+	cmd.Flags().BoolVar(&verify, "verify", false,
+		"Check endorsements against the namespace policies before submission")
+	cmd.Flags().StringVar(&policies, "policies", "",
+		"Policy file with the namespace policies to check against with --verify (manifest format)")
 
 	return cmd
 }
+
+// verifyBatch checks the endorsements of all transactions against the namespace policies, i.e.,
+// the local policies if not nil, and otherwise the committed ones.
+// The report of every transaction that does not satisfy its policies is printed.
+func verifyBatch(
+	cmd *cobra.Command,
+	ctx *CLIContext,
+	txs []adapters.Transaction,
+	policies *app.NamespaceManifest,
+) error {
+	var errs []error
+	for _, tx := range txs {
+		res, err := ctx.App.VerifyTransaction(cmd.Context(), &app.VerifyTransactionInput{
+			TxID:     tx.TxID,
+			Tx:       tx.Tx,
+			Policies: policies,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot verify transaction %s: %w", tx.TxID, err)
+		}
+		if err := verificationError(res); err != nil {
+			ctx.Printer.Print(renderVerification(res))
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("nothing submitted: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// decodeBatch decodes all inputs and ensures that every txID is unique within the batch.
+func decodeBatch(ctx *CLIContext, inputs []cliio.Input) ([]adapters.Transaction, error) {
+	txs := make([]adapters.Transaction, 0, len(inputs))
+	sources := make(map[string]string, len(inputs))
+
+	for _, in := range inputs {
+		txID, tx, err := decodeTx(ctx, in.Data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %w", in.Source, err)
+		}
+
+		if prev, ok := sources[txID]; ok {
+			return nil, fmt.Errorf("duplicate txID %s in %s and %s", txID, prev, in.Source)
+		}
+		sources[txID] = in.Source
+
+		txs = append(txs, adapters.Transaction{TxID: txID, Tx: tx})
+	}
+
+	return txs, nil
+}
+
+// submitBatch submits all transactions at once and prints a per-transaction status table.
+func submitBatch(
+	cmd *cobra.Command,
+	ctx *CLIContext,
+	inputs []cliio.Input,
+	txs []adapters.Transaction,
+	wait bool,
+) error {
+	results, err := ctx.App.SubmitTransactions(cmd.Context(), txs, wait)
+	if err != nil {
+		return err
+	}
+
+	return reportSubmissions(ctx, inputs, results, wait)
+}
+
+// reportSubmissions prints a per-transaction status table of a batch submission.
+// Returns an error if any transaction was not submitted or, with wait, did not commit.
+func reportSubmissions(ctx *CLIContext, inputs []cliio.Input, results []app.TxSubmissionResult, wait bool) error {
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		rows[i] = append([]string{inputs[i].Source, r.TxID}, submissionColumns(r, wait)...)
+	}
+
+	ctx.Printer.Print(cliio.RenderTable([]string{"SOURCE", "TXID", "STATUS", "BLOCK", "TXNUM", "ERROR"}, rows))
+
+	return submissionsError(results, wait, "transactions")
+}
+
+// submissionColumns returns the status, block, transaction number, and error columns of a
+// submission result.
+func submissionColumns(r app.TxSubmissionResult, wait bool) []string {
+	var errMsg string
+	if r.Err != nil {
+		errMsg = r.Err.Error()
+	}
+
+	switch {
+	case r.Err != nil:
+		return []string{"FAILED", "", "", errMsg}
+	case !wait:
+		return []string{"SUBMITTED", "", "", errMsg}
+	default:
+		block, txNum := positionColumns(r.Status)
+		return []string{statusLabel(r.Status), block, txNum, errMsg}
+	}
+}
+
+// submissionsError returns an error with the exit code of a batch if any transaction was not
+// submitted or, with wait, did not commit; nil otherwise. What names the submitted items.
+func submissionsError(results []app.TxSubmissionResult, wait bool, what string) error {
+	var failed int
+	for _, r := range results {
+		if r.Err != nil || (wait && !r.Status.Committed()) {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	if wait {
+		return &exitError{
+			code: resultsExitCode(results),
+			err:  fmt.Errorf("%d of %d %s did not commit", failed, len(results), what),
+		}
+	}
+	return fmt.Errorf("%d of %d %s could not be submitted", failed, len(results), what)
+}
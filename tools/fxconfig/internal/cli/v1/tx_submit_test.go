@@ -8,7 +8,10 @@ package v1
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -17,6 +20,8 @@ import (
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/api/committerpb"
 
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
 )
 
@@ -26,7 +31,7 @@ func TestNewTxSubmitCommand(t *testing.T) {
 	cmd := newTxSubmitCommand(&CLIContext{App: &testApp{}})
 
 	require.NotNil(t, cmd)
-	require.Equal(t, "submit [file]", cmd.Use)
+	require.Equal(t, "submit [file|dir|-]...", cmd.Use)
 	require.NotEmpty(t, cmd.Short)
 	require.NotNil(t, cmd.RunE)
 	require.NotNil(t, cmd.Flags().Lookup("wait"))
@@ -60,7 +65,7 @@ func TestTxSubmitCommand_SubmitWithWait(t *testing.T) {
 
 	mockApp := &testApp{}
 	mockApp.On("SubmitTransactionWithWait", mock.Anything, "tx-123", mock.AnythingOfType("*applicationpb.Tx")).
-		Return(int(committerpb.Status_COMMITTED), nil)
+		Return(finalStatus("tx-123", committerpb.Status_COMMITTED), nil)
 
 	var outBuf bytes.Buffer
 	ctx := &CLIContext{
@@ -85,7 +90,7 @@ func TestTxSubmitCommand_SubmitWithWaitFailed(t *testing.T) {
 
 	mockApp := &testApp{}
 	mockApp.On("SubmitTransactionWithWait", mock.Anything, "tx-123", mock.AnythingOfType("*applicationpb.Tx")).
-		Return(int(committerpb.Status_ABORTED_SIGNATURE_INVALID), nil)
+		Return(finalStatus("tx-123", committerpb.Status_ABORTED_SIGNATURE_INVALID), nil)
 
 	var outBuf bytes.Buffer
 	ctx := &CLIContext{
@@ -101,6 +106,7 @@ func TestTxSubmitCommand_SubmitWithWaitFailed(t *testing.T) {
 	err := cmd.Execute()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "transaction failed with status: ABORTED_SIGNATURE_INVALID")
+	require.Equal(t, ExitTxRejected, ExitCode(err))
 	require.Contains(t, outBuf.String(), "Transaction status: ABORTED_SIGNATURE_INVALID")
 	mockApp.AssertExpectations(t)
 }
@@ -137,3 +143,166 @@ func TestTxSubmitCommand_MissingArg(t *testing.T) {
 	err := cmd.Execute()
 	require.Error(t, err)
 }
+
+// writeTxDir writes one transaction file per txID into a new directory.
+func writeTxDir(t *testing.T, txIDs ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, txID := range txIDs {
+		data, err := (&cliio.JSONCodec{}).Encode(txID, &applicationpb.Tx{})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, txID+".json"), data, 0o600))
+	}
+
+	return dir
+}
+
+func batchTxIDs(txIDs ...string) any {
+	return mock.MatchedBy(func(txs []adapters.Transaction) bool {
+		if len(txs) != len(txIDs) {
+			return false
+		}
+		for i, tx := range txs {
+			if tx.TxID != txIDs[i] {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestTxSubmitCommand_BatchWithWait(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTxDir(t, "tx-1", "tx-2")
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitTransactions", mock.Anything, batchTxIDs("tx-1", "tx-2"), true).
+		Return([]app.TxSubmissionResult{
+			{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+			{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_COMMITTED)},
+		}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	}
+
+	cmd := newTxSubmitCommand(ctx)
+	cmd.SetArgs([]string{dir, "--wait"})
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, outBuf.String(), "TXID")
+	require.Contains(t, outBuf.String(), "tx-2")
+	require.Contains(t, outBuf.String(), "COMMITTED")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_BatchYAMLDir(t *testing.T) {
+	t.Parallel()
+
+	// directories are not limited to json files
+	dir := t.TempDir()
+	for _, txID := range []string{"tx-1", "tx-2"} {
+		data, err := (&cliio.YAMLCodec{}).Encode(txID, &applicationpb.Tx{})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, txID+".yaml"), data, 0o600))
+	}
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitTransactions", mock.Anything, batchTxIDs("tx-1", "tx-2"), false).
+		Return([]app.TxSubmissionResult{{TxID: "tx-1"}, {TxID: "tx-2"}}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+		IOTransactionCodec: &cliio.YAMLCodec{},
+	}
+
+	cmd := newTxSubmitCommand(ctx)
+	cmd.SetArgs([]string{dir})
+
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_BatchWithWaitFailed(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTxDir(t, "tx-1", "tx-2", "tx-3")
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitTransactions", mock.Anything, batchTxIDs("tx-1", "tx-2", "tx-3"), true).
+		Return([]app.TxSubmissionResult{
+			{TxID: "tx-1", Status: finalStatus("tx-1", committerpb.Status_COMMITTED)},
+			{TxID: "tx-2", Status: finalStatus("tx-2", committerpb.Status_ABORTED_MVCC_CONFLICT)},
+			{TxID: "tx-3", Err: errors.New("broadcast failed")},
+		}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	}
+
+	cmd := newTxSubmitCommand(ctx)
+	cmd.SetArgs([]string{dir, "--wait"})
+
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "2 of 3 transactions did not commit")
+	require.Equal(t, ExitError, ExitCode(err))
+	require.Contains(t, outBuf.String(), "ABORTED_MVCC_CONFLICT")
+	require.Contains(t, outBuf.String(), "broadcast failed")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_BatchJSONL(t *testing.T) {
+	t.Parallel()
+
+	var lines []byte
+	for _, txID := range []string{"tx-1", "tx-2"} {
+		data, err := (&cliio.JSONCodec{}).Encode(txID, &applicationpb.Tx{})
+		require.NoError(t, err)
+		var compact bytes.Buffer
+		require.NoError(t, json.Compact(&compact, data))
+		lines = append(append(lines, compact.Bytes()...), '\n')
+	}
+
+	mockApp := &testApp{}
+	mockApp.On("SubmitTransactions", mock.Anything, batchTxIDs("tx-1", "tx-2"), false).
+		Return([]app.TxSubmissionResult{{TxID: "tx-1"}, {TxID: "tx-2"}}, nil)
+
+	var outBuf bytes.Buffer
+	ctx := &CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&outBuf, &outBuf, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	}
+
+	cmd := newTxSubmitCommand(ctx)
+	cmd.SetIn(bytes.NewReader(lines))
+	cmd.SetArgs([]string{"-"})
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, outBuf.String(), "stdin:2")
+	require.Contains(t, outBuf.String(), "SUBMITTED")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_BatchDuplicateTxID(t *testing.T) {
+	t.Parallel()
+
+	first := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+	second := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	cmd := newTxSubmitCommand(&CLIContext{App: &testApp{}, IOTransactionCodec: &cliio.JSONCodec{}})
+	cmd.SetArgs([]string{first, second})
+
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "duplicate txID tx-1")
+}
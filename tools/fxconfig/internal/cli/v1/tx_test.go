@@ -29,4 +29,5 @@ func TestNewTxRootCommand(t *testing.T) {
 	require.True(t, subCmds["endorse"])
 	require.True(t, subCmds["merge"])
 	require.True(t, subCmds["submit"])
+	require.True(t, subCmds["status"])
 }
@@ -0,0 +1,179 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// newTxVerifyCommand creates a command for checking the endorsements of a transaction
+// against the namespace policies before submission.
+func newTxVerifyCommand(ctx *CLIContext) *cobra.Command {
+	var (
+		policies string
+		format   formatFlag
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify [file|-]",
+		Short: "Check endorsements against the namespace policies",
+		Long: `Check whether the endorsements of a transaction satisfy its endorsement policies.
+
+Every endorsement is verified over the namespace it endorses, and the
+endorsements are evaluated against the policy of the namespace, as the
+committer does. The transaction is not submitted.
+
+The namespace policies are resolved via the query service. For offline
+verification, pass a policy file with --policies; it uses the manifest format
+of 'fxconfig apply'. Namespace transactions created by 'fxconfig namespace'
+write to the meta-namespace, whose policy can only be verified with a policy
+file.
+
+MSP endorsements are verified with the certificate embedded in the
+endorsement. Certificate chains are not validated against the channel MSPs,
+and roles other than member are matched by the node OU of the certificate.
+
+Status Codes:
+  0 - All namespace policies are satisfied
+  1 - At least one namespace policy is not satisfied
+
+Examples:
+  # Verify a merged transaction against the committed policies
+  fxconfig tx verify merged_tx.json
+
+  # Verify offline against local policies
+  fxconfig tx verify merged_tx.json --policies policies.yaml
+
+  # Verify as json
+  fxconfig tx verify merged_tx.json --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := cliio.ParseFormat(string(format), cliio.QueryFormats)
+			if err != nil {
+				return err
+			}
+
+			data, err := cliio.ResolveInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			txID, tx, err := decodeTx(ctx, data)
+			if err != nil {
+				return err
+			}
+
+			input := app.VerifyTransactionInput{TxID: txID, Tx: tx}
+			if policies != "" {
+				input.Policies, err = readManifest(policies)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := ctx.App.VerifyTransaction(cmd.Context(), &input)
+			if err != nil {
+				return err
+			}
+
+			if f != cliio.FormatTable {
+				out, err := cliio.Marshal(f, res)
+				if err != nil {
+					return err
+				}
+				ctx.Printer.Print(string(out))
+			} else {
+				ctx.Printer.Print(renderVerification(res))
+			}
+
+			return verificationError(res)
+		},
+	}
+
+	cmd.Flags().StringVar(&policies, "policies", "",
+		"Policy file with the namespace policies for offline verification (manifest format)")
+	format.bind(cmd)
+
+	return cmd
+}
+
+// verificationError returns an error if a namespace policy of the transaction is not satisfied.
+func verificationError(res *app.TxVerification) error {
+	var failed int
+	for _, ns := range res.Namespaces {
+		if !ns.Satisfied {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("transaction %s: endorsement policy not satisfied for %d of %d namespaces",
+		res.TxID, failed, len(res.Namespaces))
+}
+
+// renderVerification renders the verification report of a transaction.
+func renderVerification(res *app.TxVerification) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Transaction %s\n", res.TxID)
+	for _, ns := range res.Namespaces {
+		b.WriteString("\n")
+		renderNamespaceVerification(&b, ns)
+	}
+
+	return b.String()
+}
+
+func renderNamespaceVerification(b *strings.Builder, ns transaction.NamespaceVerification) {
+	status := "SATISFIED"
+	if !ns.Satisfied {
+		status = "NOT SATISFIED"
+	}
+	if ns.Error != "" {
+		status += " (" + ns.Error + ")"
+	}
+	fmt.Fprintf(b, "Namespace %s: %s\n", ns.NsID, status)
+
+	if ns.Policy == nil {
+		return
+	}
+	fmt.Fprintf(b, "  policy: %s\n", ns.Policy)
+
+	b.WriteString("  endorsements:\n")
+	if len(ns.Endorsements) == 0 {
+		b.WriteString("    none\n")
+	}
+	for i, e := range ns.Endorsements {
+		result := "valid"
+		if !e.Valid {
+			result = "invalid"
+		}
+		if e.Error != "" {
+			result += " (" + e.Error + ")"
+		}
+		fmt.Fprintf(b, "    [%d] %s: %s\n", i, endorserName(e), result)
+	}
+
+	if len(ns.Principals) == 0 {
+		return
+	}
+	b.WriteString("  principals:\n")
+	for _, p := range ns.Principals {
+		if p.Satisfied {
+			fmt.Fprintf(b, "    + %s satisfied\n", p.Principal)
+		} else {
+			fmt.Fprintf(b, "    - %s missing\n", p.Principal)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	fmsp "github.com/hyperledger/fabric-x-common/msp"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/app"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/cli/v1/cliio"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/provider"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
+)
+
+func someVerification(satisfied bool) *app.TxVerification {
+	return &app.TxVerification{
+		TxID: "tx-1",
+		Namespaces: []transaction.NamespaceVerification{{
+			NsID: "payments",
+			Policy: &transaction.PolicyDescription{
+				Type:       transaction.PolicyTypeMSP,
+				Expression: "AND('A.member', 'B.member')",
+			},
+			Satisfied: satisfied,
+			Endorsements: []transaction.EndorsementCheck{
+				{MSPID: "A", Subject: "CN=user@A", Valid: true},
+				{MSPID: "B", Error: "signature mismatch"},
+			},
+			Principals: []transaction.PrincipalCheck{
+				{Principal: "'A.member'", Satisfied: true},
+				{Principal: "'B.member'", Satisfied: satisfied},
+			},
+		}},
+	}
+}
+
+func newTestTxVerifyCommand(mockApp *testApp, out *bytes.Buffer) *cobra.Command {
+	return newTxVerifyCommand(&CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(out, out, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	})
+}
+
+func TestNewTxVerifyCommand(t *testing.T) {
+	t.Parallel()
+
+	cmd := newTxVerifyCommand(&CLIContext{App: &testApp{}})
+
+	require.NotNil(t, cmd)
+	require.Equal(t, "verify [file|-]", cmd.Use)
+	require.NotEmpty(t, cmd.Short)
+	require.NotNil(t, cmd.Flags().Lookup("policies"))
+	require.NotNil(t, cmd.Flags().Lookup("format"))
+}
+
+func TestTxVerifyCommand_NotSatisfied(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("VerifyTransaction", mock.Anything, mock.MatchedBy(func(in *app.VerifyTransactionInput) bool {
+		return in.TxID == "tx-1" && in.Policies == nil
+	})).Return(someVerification(false), nil)
+
+	var out bytes.Buffer
+	cmd := newTestTxVerifyCommand(mockApp, &out)
+	cmd.SetArgs([]string{txFile})
+
+	err := cmd.Execute()
+	require.EqualError(t, err, "transaction tx-1: endorsement policy not satisfied for 1 of 1 namespaces")
+	require.Contains(t, out.String(), "Namespace payments: NOT SATISFIED\n")
+	require.Contains(t, out.String(), "  policy: msp AND('A.member', 'B.member')\n")
+	require.Contains(t, out.String(), "    [0] A CN=user@A: valid\n")
+	require.Contains(t, out.String(), "    [1] B: invalid (signature mismatch)\n")
+	require.Contains(t, out.String(), "    + 'A.member' satisfied\n")
+	require.Contains(t, out.String(), "    - 'B.member' missing\n")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxVerifyCommand_LocalPolicies(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+	policies := writeManifest(t, someManifestYAML)
+
+	mockApp := &testApp{}
+	mockApp.On("VerifyTransaction", mock.Anything, mock.MatchedBy(func(in *app.VerifyTransactionInput) bool {
+		return in.Policies != nil && len(in.Policies.Namespaces) > 0
+	})).Return(someVerification(true), nil)
+
+	var out bytes.Buffer
+	cmd := newTestTxVerifyCommand(mockApp, &out)
+	cmd.SetArgs([]string{txFile, "--policies", policies, "--format", "json"})
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), `"satisfied": true`)
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxVerifyCommand_AppError(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("VerifyTransaction", mock.Anything, mock.Anything).Return(nil, errors.New("query failed"))
+
+	var out bytes.Buffer
+	cmd := newTestTxVerifyCommand(mockApp, &out)
+	cmd.SetArgs([]string{txFile})
+
+	require.ErrorContains(t, cmd.Execute(), "query failed")
+}
+
+func TestTxSubmitCommand_VerifyBeforeSubmit(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	// SubmitTransaction is not expected to be called
+	mockApp := &testApp{}
+	mockApp.On("VerifyTransaction", mock.Anything, mock.Anything).Return(someVerification(false), nil)
+
+	var out bytes.Buffer
+	cmd := newTxSubmitCommand(&CLIContext{
+		App:                mockApp,
+		Printer:            cliio.NewCLIPrinter(&out, &out, cliio.FormatTable),
+		IOTransactionCodec: &cliio.JSONCodec{},
+	})
+	cmd.SetArgs([]string{txFile, "--verify"})
+
+	err := cmd.Execute()
+	require.ErrorContains(t, err, "nothing submitted")
+	require.Contains(t, out.String(), "NOT SATISFIED")
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_VerifiedSubmit(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	mockApp := &testApp{}
+	mockApp.On("VerifyTransaction", mock.Anything, mock.Anything).Return(someVerification(true), nil)
+	mockApp.On("SubmitTransaction", mock.Anything, "tx-1", mock.Anything).Return(nil)
+
+	cmd := newTxSubmitCommand(&CLIContext{App: mockApp, IOTransactionCodec: &cliio.JSONCodec{}})
+	cmd.SetArgs([]string{txFile, "--verify"})
+
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+}
+
+// verifyingApp creates and verifies namespace transactions with an AdminApp signing with the MSP
+// testdata identity, and mocks all other calls.
+type verifyingApp struct {
+	*testApp
+	admin *app.AdminApp
+}
+
+func newVerifyingApp(t *testing.T) *verifyingApp {
+	t.Helper()
+
+	mspDir, err := filepath.Abs("../../msp/testdata/msp")
+	require.NoError(t, err)
+
+	vctx := validation.NewValidationContext()
+	return &verifyingApp{
+		testApp: &testApp{},
+		admin: &app.AdminApp{
+			Validators: vctx,
+			MspProvider: provider.New[fmsp.SigningIdentity, *config.MSPConfig](
+				func(cfg *config.MSPConfig) (fmsp.SigningIdentity, error) {
+					return msp.GetSignerIdentityFromMSP(*cfg)
+				},
+				&config.MSPConfig{LocalMspID: "Org1MSP", ConfigPath: mspDir},
+				vctx,
+			),
+		},
+	}
+}
+
+func (a *verifyingApp) DeployNamespace(
+	ctx context.Context,
+	input *app.DeployNamespaceInput,
+) (*app.DeployNamespaceOutput, app.TxStatus, error) {
+	return a.admin.DeployNamespace(ctx, input)
+}
+
+func (a *verifyingApp) VerifyTransaction(
+	ctx context.Context,
+	input *app.VerifyTransactionInput,
+) (*app.TxVerification, error) {
+	return a.admin.VerifyTransaction(ctx, input)
+}
+
+func TestTxSubmitCommand_VerifyNamespaceTx(t *testing.T) {
+	t.Parallel()
+
+	mockApp := newVerifyingApp(t)
+	newCtx := func(out *bytes.Buffer) *CLIContext {
+		return &CLIContext{
+			App:                mockApp,
+			Printer:            cliio.NewCLIPrinter(out, out, cliio.FormatTable),
+			IOTransactionCodec: &cliio.JSONCodec{},
+		}
+	}
+
+	// create and endorse a namespace transaction as Org1MSP
+	var out bytes.Buffer
+	txFile := filepath.Join(t.TempDir(), "ns_tx.json")
+	cmd := newNsCreateCommand(newCtx(&out))
+	cmd.SetArgs([]string{"payments", "--policy", "OR('Org1MSP.member')", "--endorse", "--output", txFile})
+	require.NoError(t, cmd.Execute())
+
+	// the meta-namespace policy cannot be resolved via the query service
+	out.Reset()
+	cmd = newTxSubmitCommand(newCtx(&out))
+	cmd.SetArgs([]string{txFile, "--verify", "--policies", writeManifest(t, `namespaces:
+  - name: _meta
+    policy: "AND('Org1MSP.member', 'Org2MSP.member')"
+`)})
+	require.ErrorContains(t, cmd.Execute(), "nothing submitted")
+	require.Contains(t, out.String(), "'Org2MSP.member' missing")
+
+	// with a satisfied policy file, the transaction is submitted
+	mockApp.On("SubmitTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	cmd = newTxSubmitCommand(newCtx(&out))
+	cmd.SetArgs([]string{txFile, "--verify", "--policies", writeManifest(t, `namespaces:
+  - name: _meta
+    policy: "OR('Org1MSP.member')"
+`)})
+	require.NoError(t, cmd.Execute())
+	mockApp.AssertExpectations(t)
+}
+
+func TestTxSubmitCommand_PoliciesRequireVerify(t *testing.T) {
+	t.Parallel()
+
+	txFile := writeTxFile(t, "tx-1", &applicationpb.Tx{})
+
+	cmd := newTxSubmitCommand(&CLIContext{App: &testApp{}, IOTransactionCodec: &cliio.JSONCodec{}})
+	cmd.SetArgs([]string{txFile, "--policies", writeManifest(t, someManifestYAML)})
+	require.EqualError(t, cmd.Execute(), "--policies requires --verify")
+}
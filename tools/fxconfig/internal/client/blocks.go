@@ -0,0 +1,162 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+// BlockQueryClient provides a gRPC client for reading committed blocks from the Fabric-X committer.
+// The block query and deliver services are served by the committer sidecar, which also serves
+// notifications, hence the client connects to the notifications endpoint.
+type BlockQueryClient struct {
+	cfg     config.NotificationsConfig
+	client  committerpb.BlockQueryServiceClient
+	deliver peer.DeliverClient
+	closeF  func()
+}
+
+// NewBlockQueryClient creates a new block query client with the provided configuration.
+// It establishes a gRPC connection with optional TLS and returns an error if connection fails.
+func NewBlockQueryClient(cfg config.NotificationsConfig) (*BlockQueryClient, error) {
+	conn, err := newClientConn(&cfg.EndpointServiceConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get grpc client: %w", err)
+	}
+
+	return &BlockQueryClient{
+		cfg:     cfg,
+		client:  committerpb.NewBlockQueryServiceClient(conn),
+		deliver: peer.NewDeliverClient(conn),
+		closeF: func() {
+			_ = conn.Close()
+		},
+	}, nil
+}
+
+// GetBlockchainInfo retrieves the current height of the committed ledger.
+// The request is bounded by the configured connection timeout.
+func (bc *BlockQueryClient) GetBlockchainInfo(ctx context.Context) (*cb.BlockchainInfo, error) {
+	if bc.client == nil {
+		return nil, errors.New("require client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, bc.cfg.ConnectionTimeout)
+	defer cancel()
+
+	res, err := bc.client.GetBlockchainInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("getBlockchainInfo error: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetBlockByNumber retrieves a committed block, including the transaction statuses in its metadata.
+// The request is bounded by the configured connection timeout.
+func (bc *BlockQueryClient) GetBlockByNumber(ctx context.Context, number uint64) (*cb.Block, error) {
+	if bc.client == nil {
+		return nil, errors.New("require client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, bc.cfg.ConnectionTimeout)
+	defer cancel()
+
+	res, err := bc.client.GetBlockByNumber(ctx, &committerpb.BlockNumber{Number: number})
+	if err != nil {
+		return nil, fmt.Errorf("getBlockByNumber error: %w", err)
+	}
+
+	return res, nil
+}
+
+// DeliverBlocks streams committed blocks, including the transaction statuses in their metadata, from
+// the deliver service of the committer sidecar, starting at block from. If to is nil, new blocks are
+// streamed as they are committed until ctx is done; otherwise the stream ends after block to, waiting
+// for it to be committed if necessary. Each block is passed to deliver; an error of deliver ends the stream.
+func (bc *BlockQueryClient) DeliverBlocks(
+	ctx context.Context,
+	from uint64,
+	to *uint64,
+	deliver func(*cb.Block) error,
+) error {
+	if bc.deliver == nil {
+		return errors.New("require client")
+	}
+
+	stop := uint64(math.MaxUint64)
+	if to != nil {
+		stop = *to
+	}
+
+	// the sidecar serves a single channel and does not authenticate seek requests,
+	// hence the request neither names a channel nor is signed
+	env, err := protoutil.CreateSignedEnvelope(cb.HeaderType_DELIVER_SEEK_INFO, "", nil, &ab.SeekInfo{
+		Start:    seekPosition(from),
+		Stop:     seekPosition(stop),
+		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
+	}, 0, 0)
+	if err != nil {
+		return fmt.Errorf("cannot create seek request: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := bc.deliver.Deliver(ctx)
+	if err != nil {
+		return fmt.Errorf("deliver error: %w", err)
+	}
+	if err := stream.Send(env); err != nil {
+		return fmt.Errorf("deliver error: %w", err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("deliver error: %w", err)
+		}
+
+		switch t := res.GetType().(type) {
+		case *peer.DeliverResponse_Block:
+			if err := deliver(t.Block); err != nil {
+				return err
+			}
+		case *peer.DeliverResponse_Status:
+			if t.Status != cb.Status_SUCCESS {
+				return fmt.Errorf("deliver failed with status: %s", t.Status)
+			}
+			return nil
+		default:
+			return fmt.Errorf("unexpected deliver response: %T", t)
+		}
+	}
+}
+
+func seekPosition(number uint64) *ab.SeekPosition {
+	return &ab.SeekPosition{Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: number}}}
+}
+
+// Close terminates the gRPC connection to the block query service.
+func (bc *BlockQueryClient) Close() error {
+	if bc.closeF != nil {
+		bc.closeF()
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+// mockBlockQueryServiceClient implements committerpb.BlockQueryServiceClient for testing.
+type mockBlockQueryServiceClient struct {
+	info   *cb.BlockchainInfo
+	blocks map[uint64]*cb.Block
+	err    error
+}
+
+func (m *mockBlockQueryServiceClient) GetBlockchainInfo(
+	_ context.Context,
+	_ *emptypb.Empty,
+	_ ...grpc.CallOption,
+) (*cb.BlockchainInfo, error) {
+	return m.info, m.err
+}
+
+func (m *mockBlockQueryServiceClient) GetBlockByNumber(
+	_ context.Context,
+	in *committerpb.BlockNumber,
+	_ ...grpc.CallOption,
+) (*cb.Block, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	block, ok := m.blocks[in.GetNumber()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return block, nil
+}
+
+func (*mockBlockQueryServiceClient) GetBlockByTxID(
+	_ context.Context,
+	_ *committerpb.TxID,
+	_ ...grpc.CallOption,
+) (*cb.Block, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (*mockBlockQueryServiceClient) GetTxByID(
+	_ context.Context,
+	_ *committerpb.TxID,
+	_ ...grpc.CallOption,
+) (*cb.Envelope, error) {
+	return nil, errors.New("not implemented")
+}
+
+// mockDeliverStream implements grpc.BidiStreamingClient[cb.Envelope, peer.DeliverResponse].
+// It records the seek request and returns the responses in order.
+type mockDeliverStream struct {
+	seekInfo  *ab.SeekInfo
+	responses []*peer.DeliverResponse
+	recvErr   error
+}
+
+func (m *mockDeliverStream) Send(env *cb.Envelope) error {
+	payload, err := protoutil.UnmarshalPayload(env.GetPayload())
+	if err != nil {
+		return err
+	}
+	m.seekInfo = &ab.SeekInfo{}
+	return proto.Unmarshal(payload.GetData(), m.seekInfo)
+}
+
+func (m *mockDeliverStream) Recv() (*peer.DeliverResponse, error) {
+	if len(m.responses) == 0 {
+		return nil, m.recvErr
+	}
+	res := m.responses[0]
+	m.responses = m.responses[1:]
+	return res, nil
+}
+
+func (*mockDeliverStream) Header() (metadata.MD, error) { return nil, nil }
+func (*mockDeliverStream) Trailer() metadata.MD         { return nil }
+func (*mockDeliverStream) CloseSend() error             { return nil }
+func (*mockDeliverStream) Context() context.Context     { return context.Background() }
+func (*mockDeliverStream) SendMsg(_ any) error          { return nil }
+func (*mockDeliverStream) RecvMsg(_ any) error          { return nil }
+
+// mockDeliverClient implements peer.DeliverClient.
+type mockDeliverClient struct {
+	stream *mockDeliverStream
+}
+
+func (m *mockDeliverClient) Deliver(
+	_ context.Context,
+	_ ...grpc.CallOption,
+) (grpc.BidiStreamingClient[cb.Envelope, peer.DeliverResponse], error) {
+	return m.stream, nil
+}
+
+func (*mockDeliverClient) DeliverFiltered(
+	_ context.Context,
+	_ ...grpc.CallOption,
+) (grpc.BidiStreamingClient[cb.Envelope, peer.DeliverResponse], error) {
+	return nil, errors.New("not implemented")
+}
+
+func (*mockDeliverClient) DeliverWithPrivateData(
+	_ context.Context,
+	_ ...grpc.CallOption,
+) (grpc.BidiStreamingClient[cb.Envelope, peer.DeliverResponse], error) {
+	return nil, errors.New("not implemented")
+}
+
+func someDeliverResponses(numbers ...uint64) []*peer.DeliverResponse {
+	responses := make([]*peer.DeliverResponse, 0, len(numbers)+1)
+	for _, n := range numbers {
+		responses = append(responses, &peer.DeliverResponse{
+			Type: &peer.DeliverResponse_Block{Block: &cb.Block{Header: &cb.BlockHeader{Number: n}}},
+		})
+	}
+	return responses
+}
+
+func newTestBlockQueryClient(mock committerpb.BlockQueryServiceClient) *BlockQueryClient {
+	return &BlockQueryClient{
+		cfg: config.NotificationsConfig{
+			EndpointServiceConfig: config.EndpointServiceConfig{
+				ConnectionTimeout: time.Second,
+			},
+		},
+		client: mock,
+	}
+}
+
+func TestBlockQueryClient_NilClient(t *testing.T) {
+	t.Parallel()
+
+	bc := &BlockQueryClient{}
+	_, err := bc.GetBlockchainInfo(t.Context())
+	require.Error(t, err)
+	_, err = bc.GetBlockByNumber(t.Context(), 0)
+	require.Error(t, err)
+	err = bc.DeliverBlocks(t.Context(), 0, nil, func(*cb.Block) error { return nil })
+	require.Error(t, err)
+}
+
+func TestBlockQueryClient_GetBlockchainInfo(t *testing.T) {
+	t.Parallel()
+
+	expected := &cb.BlockchainInfo{Height: 5}
+	bc := newTestBlockQueryClient(&mockBlockQueryServiceClient{info: expected})
+
+	info, err := bc.GetBlockchainInfo(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, expected, info)
+}
+
+func TestBlockQueryClient_GetBlockByNumber(t *testing.T) {
+	t.Parallel()
+
+	expected := &cb.Block{Header: &cb.BlockHeader{Number: 2}}
+	bc := newTestBlockQueryClient(&mockBlockQueryServiceClient{blocks: map[uint64]*cb.Block{2: expected}})
+
+	block, err := bc.GetBlockByNumber(t.Context(), 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, block)
+
+	_, err = bc.GetBlockByNumber(t.Context(), 3)
+	require.ErrorContains(t, err, "getBlockByNumber error")
+}
+
+func TestBlockQueryClient_Error(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestBlockQueryClient(&mockBlockQueryServiceClient{err: errors.New("rpc error")})
+	_, err := bc.GetBlockchainInfo(t.Context())
+	require.ErrorContains(t, err, "rpc error")
+}
+
+func TestBlockQueryClient_DeliverBlocks(t *testing.T) {
+	t.Parallel()
+
+	to := uint64(3)
+	statusSuccess := &peer.DeliverResponse{Type: &peer.DeliverResponse_Status{Status: cb.Status_SUCCESS}}
+	statusNotFound := &peer.DeliverResponse{Type: &peer.DeliverResponse_Status{Status: cb.Status_NOT_FOUND}}
+
+	tests := []struct {
+		name         string
+		to           *uint64
+		responses    []*peer.DeliverResponse
+		recvErr      error
+		deliverErr   error
+		expectStop   uint64
+		expectBlocks []uint64
+		expectError  string
+	}{
+		{
+			name:         "range",
+			to:           &to,
+			responses:    append(someDeliverResponses(2, 3), statusSuccess),
+			expectStop:   3,
+			expectBlocks: []uint64{2, 3},
+		},
+		{
+			name:         "follow until the stream breaks",
+			responses:    someDeliverResponses(2),
+			recvErr:      errors.New("connection closed"),
+			expectStop:   math.MaxUint64,
+			expectBlocks: []uint64{2},
+			expectError:  "deliver error: connection closed",
+		},
+		{
+			name:        "failure status",
+			to:          &to,
+			responses:   []*peer.DeliverResponse{statusNotFound},
+			expectStop:  3,
+			expectError: "deliver failed with status: NOT_FOUND",
+		},
+		{
+			name:         "deliver error",
+			to:           &to,
+			responses:    append(someDeliverResponses(2, 3), statusSuccess),
+			deliverErr:   errors.New("disk full"),
+			expectStop:   3,
+			expectBlocks: []uint64{2},
+			expectError:  "disk full",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &mockDeliverStream{responses: tt.responses, recvErr: tt.recvErr}
+			bc := &BlockQueryClient{deliver: &mockDeliverClient{stream: stream}}
+
+			var blocks []uint64
+			err := bc.DeliverBlocks(t.Context(), 2, tt.to, func(block *cb.Block) error {
+				blocks = append(blocks, block.GetHeader().GetNumber())
+				return tt.deliverErr
+			})
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectBlocks, blocks)
+
+			require.Equal(t, uint64(2), stream.seekInfo.GetStart().GetSpecified().GetNumber())
+			require.Equal(t, tt.expectStop, stream.seekInfo.GetStop().GetSpecified().GetNumber())
+			require.Equal(t, ab.SeekInfo_BLOCK_UNTIL_READY, stream.seekInfo.GetBehavior())
+		})
+	}
+}
+
+func TestBlockQueryClient_Close(t *testing.T) {
+	t.Parallel()
+
+	closed := false
+	bc := &BlockQueryClient{closeF: func() { closed = true }}
+	require.NoError(t, bc.Close())
+	require.True(t, closed)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"google.golang.org/grpc"
+)
+
+// maxInFlight bounds the number of envelopes sent on a broadcast session
+// that have not been acknowledged yet.
+const maxInFlight = 1024
+
+// errSessionClosed is returned for envelopes submitted to, or pending on, a closed session.
+var errSessionClosed = errors.New("broadcast session closed")
+
+// BroadcastStatusError reports an envelope that was rejected by the ordering service.
+// It carries the status and the additional information returned by the orderer.
+type BroadcastStatusError struct {
+	Status cb.Status
+	Info   string
+}
+
+func (e *BroadcastStatusError) Error() string {
+	if e.Info == "" {
+		return fmt.Sprintf("orderer returned status %s", e.Status)
+	}
+	return fmt.Sprintf("orderer returned status %s: %s", e.Status, e.Info)
+}
+
+// BroadcastResult is the acknowledgement of a single envelope sent on a broadcast session.
+type BroadcastResult struct {
+	Status cb.Status
+	Info   string
+	// Err is set if the envelope could not be delivered or acknowledged.
+	Err error
+}
+
+// AsError returns the delivery error, or a BroadcastStatusError if the orderer did not accept the envelope.
+func (r BroadcastResult) AsError() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if r.Status != cb.Status_SUCCESS {
+		return &BroadcastStatusError{Status: r.Status, Info: r.Info}
+	}
+	return nil
+}
+
+// BroadcastSession is a long-lived broadcast stream to the ordering service.
+// It is safe for concurrent use. Envelopes are pipelined, i.e., several envelopes
+// can be in flight at the same time, and acknowledgements are matched to sends in order.
+// Once the stream fails, all pending and future envelopes report the stream error.
+type BroadcastSession struct {
+	stream grpc.BidiStreamingClient[cb.Envelope, ab.BroadcastResponse]
+	cancel context.CancelFunc
+
+	// sendMu serializes sends and enqueues acknowledgements in send order.
+	sendMu  sync.Mutex
+	pending chan chan BroadcastResult
+	closed  bool
+
+	// err holds the error that terminated the session.
+	// Atomically stored; checked by Send() before sending envelopes.
+	err atomic.Pointer[error]
+
+	// done is closed once the receiver goroutine terminates.
+	done chan struct{}
+}
+
+// NewBroadcastSession opens a broadcast stream and starts receiving acknowledgements.
+// The stream lives until Close is called or the stream fails.
+func NewBroadcastSession(client ab.AtomicBroadcastClient) (*BroadcastSession, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := client.Broadcast(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	s := &BroadcastSession{
+		stream:  stream,
+		cancel:  cancel,
+		pending: make(chan chan BroadcastResult, maxInFlight),
+		done:    make(chan struct{}),
+	}
+	go s.receive()
+
+	return s, nil
+}
+
+// Send transmits the envelope and returns a channel that receives its acknowledgement.
+// Send blocks if the maximum number of unacknowledged envelopes is reached.
+func (s *BroadcastSession) Send(env *cb.Envelope) (<-chan BroadcastResult, error) {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if s.closed {
+		return nil, errSessionClosed
+	}
+	if err := s.err.Load(); err != nil {
+		return nil, *err
+	}
+
+	if err := s.stream.Send(env); err != nil {
+		return nil, s.fail(err)
+	}
+
+	ack := make(chan BroadcastResult, 1)
+	s.pending <- ack
+
+	return ack, nil
+}
+
+// Broadcast sends the envelope and waits for its acknowledgement.
+func (s *BroadcastSession) Broadcast(ctx context.Context, env *cb.Envelope) (BroadcastResult, error) {
+	ack, err := s.Send(env)
+	if err != nil {
+		return BroadcastResult{}, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return BroadcastResult{}, ctx.Err()
+	case res := <-ack:
+		return res, res.AsError()
+	}
+}
+
+// Err returns the error that terminated the session, if any.
+func (s *BroadcastSession) Err() error {
+	if err := s.err.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+// Close terminates the stream. Pending envelopes that were not yet acknowledged report an error.
+func (s *BroadcastSession) Close() error {
+	// Cancel the stream first, so that a Send blocked on a full pending queue is released
+	// by the receiver draining the queue with the stream error.
+	_ = s.fail(errSessionClosed)
+	s.cancel()
+
+	s.sendMu.Lock()
+	if s.closed {
+		s.sendMu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.pending)
+	s.sendMu.Unlock()
+
+	<-s.done
+	return nil
+}
+
+// receive reads one acknowledgement per pending envelope, in send order.
+func (s *BroadcastSession) receive() {
+	defer close(s.done)
+
+	var streamErr error
+	for ack := range s.pending {
+		if streamErr != nil {
+			ack <- BroadcastResult{Err: streamErr}
+			continue
+		}
+
+		resp, err := s.stream.Recv()
+		if err != nil {
+			streamErr = s.fail(err)
+			ack <- BroadcastResult{Err: streamErr}
+			continue
+		}
+
+		ack <- BroadcastResult{Status: resp.GetStatus(), Info: resp.GetInfo()}
+	}
+}
+
+// fail records the first stream failure so that subsequent sends are rejected.
+// Returns the recorded error.
+func (s *BroadcastSession) fail(err error) error {
+	if !errors.Is(err, errSessionClosed) {
+		err = fmt.Errorf("broadcast stream failed: %w", err)
+	}
+	s.err.CompareAndSwap(nil, &err)
+	return *s.err.Load()
+}
@@ -0,0 +1,323 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+)
+
+// fakeOrderer is an in-process AtomicBroadcastServer.
+// It acknowledges every envelope with its payload as info; payloads prefixed
+// with "reject" are acknowledged with BAD_REQUEST.
+type fakeOrderer struct {
+	ab.UnimplementedAtomicBroadcastServer
+}
+
+func (*fakeOrderer) Broadcast(stream grpc.BidiStreamingServer[cb.Envelope, ab.BroadcastResponse]) error {
+	for {
+		env, err := stream.Recv()
+		if err != nil {
+			return nil //nolint:nilerr // client closed the stream
+		}
+
+		info := strings.ToValidUTF8(string(env.GetPayload()), "?")
+		resp := &ab.BroadcastResponse{Status: cb.Status_SUCCESS, Info: info}
+		if strings.HasPrefix(info, "reject") {
+			resp.Status = cb.Status_BAD_REQUEST
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// startFakeOrderer serves a fakeOrderer over an in-memory listener and returns a client for it.
+func startFakeOrderer(tb testing.TB) ab.AtomicBroadcastClient {
+	tb.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	ab.RegisterAtomicBroadcastServer(srv, &fakeOrderer{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(tb, err)
+
+	tb.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+
+	return ab.NewAtomicBroadcastClient(conn)
+}
+
+func newTestBroadcastSession(t *testing.T, client ab.AtomicBroadcastClient) *BroadcastSession {
+	t.Helper()
+
+	s, err := NewBroadcastSession(client)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = s.Close()
+	})
+
+	return s
+}
+
+func TestBroadcastSession_OpenError(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewBroadcastSession(&mockAtomicBroadcastClient{broadcastErr: errors.New("unavailable")})
+	require.ErrorContains(t, err, "unavailable")
+}
+
+func TestBroadcastSession_PipelinesInOrder(t *testing.T) {
+	t.Parallel()
+
+	s := newTestBroadcastSession(t, startFakeOrderer(t))
+
+	const n = 100
+	acks := make([]<-chan BroadcastResult, n)
+	for i := range acks {
+		ack, err := s.Send(&cb.Envelope{Payload: fmt.Appendf(nil, "env-%d", i)})
+		require.NoError(t, err)
+		acks[i] = ack
+	}
+
+	for i, ack := range acks {
+		res := <-ack
+		require.NoError(t, res.AsError())
+		require.Equal(t, cb.Status_SUCCESS, res.Status)
+		require.Equal(t, fmt.Sprintf("env-%d", i), res.Info)
+	}
+}
+
+func TestBroadcastSession_ConcurrentSends(t *testing.T) {
+	t.Parallel()
+
+	s := newTestBroadcastSession(t, startFakeOrderer(t))
+
+	results := make([]BroadcastResult, 50)
+	errs := make([]error, len(results))
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Go(func() {
+			env := &cb.Envelope{Payload: fmt.Appendf(nil, "env-%d", i)}
+			results[i], errs[i] = s.Broadcast(t.Context(), env)
+		})
+	}
+	wg.Wait()
+
+	for i, res := range results {
+		require.NoError(t, errs[i])
+		require.Equal(t, fmt.Sprintf("env-%d", i), res.Info)
+	}
+}
+
+func TestBroadcastSession_SurfacesInfo(t *testing.T) {
+	t.Parallel()
+
+	s := newTestBroadcastSession(t, startFakeOrderer(t))
+
+	res, err := s.Broadcast(t.Context(), &cb.Envelope{Payload: []byte("reject: bad signature")})
+	require.Equal(t, cb.Status_BAD_REQUEST, res.Status)
+	require.Equal(t, "reject: bad signature", res.Info)
+
+	var statusErr *BroadcastStatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, cb.Status_BAD_REQUEST, statusErr.Status)
+	require.EqualError(t, err, "orderer returned status BAD_REQUEST: reject: bad signature")
+
+	// a rejected envelope does not terminate the session
+	res, err = s.Broadcast(t.Context(), &cb.Envelope{Payload: []byte("ok")})
+	require.NoError(t, err)
+	require.Equal(t, "ok", res.Info)
+	require.NoError(t, s.Err())
+}
+
+func TestBroadcastSession_RecvErrorFailsPendingAndLaterSends(t *testing.T) {
+	t.Parallel()
+
+	stream := &batchBroadcastStream{responses: []*ab.BroadcastResponse{{Status: cb.Status_SUCCESS}}}
+	s := newTestBroadcastSession(t, &mockAtomicBroadcastClient{stream: stream})
+
+	acks := make([]<-chan BroadcastResult, 3)
+	for i := range acks {
+		ack, err := s.Send(&cb.Envelope{})
+		require.NoError(t, err)
+		acks[i] = ack
+	}
+
+	require.NoError(t, (<-acks[0]).AsError())
+	require.ErrorContains(t, (<-acks[1]).AsError(), "stream closed")
+	require.ErrorContains(t, (<-acks[2]).AsError(), "stream closed")
+
+	require.ErrorContains(t, s.Err(), "broadcast stream failed")
+	_, err := s.Send(&cb.Envelope{})
+	require.ErrorContains(t, err, "stream closed")
+}
+
+func TestBroadcastSession_SendError(t *testing.T) {
+	t.Parallel()
+
+	s := newTestBroadcastSession(t, &mockAtomicBroadcastClient{
+		stream: &mockBroadcastStream{sendErr: errors.New("send failed")},
+	})
+
+	_, err := s.Send(&cb.Envelope{})
+	require.ErrorContains(t, err, "send failed")
+	require.ErrorContains(t, s.Err(), "send failed")
+}
+
+func TestBroadcastSession_Close(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewBroadcastSession(startFakeOrderer(t))
+	require.NoError(t, err)
+
+	_, err = s.Broadcast(t.Context(), &cb.Envelope{Payload: []byte("ok")})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Close())
+	require.NoError(t, s.Close())
+
+	_, err = s.Send(&cb.Envelope{})
+	require.ErrorIs(t, err, errSessionClosed)
+}
+
+func TestOrdererClient_ReusesSession(t *testing.T) {
+	t.Parallel()
+
+	client := &countingBroadcastClient{AtomicBroadcastClient: startFakeOrderer(t)}
+	oc := newTestOrdererClient(client)
+	t.Cleanup(func() {
+		_ = oc.Close()
+	})
+
+	for i := range 3 {
+		err := oc.Broadcast(t.Context(), &testSigningIdentity{}, fmt.Sprintf("tx-%d", i), someBroadcastTx())
+		require.NoError(t, err)
+	}
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, client.openedCount())
+}
+
+func TestOrdererClient_ReopensFailedSession(t *testing.T) {
+	t.Parallel()
+
+	client := &countingBroadcastClient{AtomicBroadcastClient: &mockAtomicBroadcastClient{
+		stream: &mockBroadcastStream{recvErr: errors.New("recv failed")},
+	}}
+	oc := newTestOrdererClient(client)
+
+	err := oc.Broadcast(t.Context(), &testSigningIdentity{}, "tx-1", someBroadcastTx())
+	require.ErrorContains(t, err, "recv failed")
+	err = oc.Broadcast(t.Context(), &testSigningIdentity{}, "tx-2", someBroadcastTx())
+	require.ErrorContains(t, err, "recv failed")
+
+	require.Equal(t, 2, client.openedCount())
+	require.NoError(t, oc.Close())
+}
+
+// countingBroadcastClient counts the number of opened broadcast streams.
+type countingBroadcastClient struct {
+	ab.AtomicBroadcastClient
+
+	mu     sync.Mutex
+	opened int
+}
+
+func (c *countingBroadcastClient) Broadcast(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (grpc.BidiStreamingClient[cb.Envelope, ab.BroadcastResponse], error) {
+	c.mu.Lock()
+	c.opened++
+	c.mu.Unlock()
+	return c.AtomicBroadcastClient.Broadcast(ctx, opts...)
+}
+
+func (c *countingBroadcastClient) openedCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opened
+}
+
+// BenchmarkBroadcast_StreamPerEnvelope measures the previous behavior of opening
+// a new broadcast stream for every envelope.
+func BenchmarkBroadcast_StreamPerEnvelope(b *testing.B) {
+	client := startFakeOrderer(b)
+	env := &cb.Envelope{Payload: []byte("payload")}
+
+	for b.Loop() {
+		stream, err := client.Broadcast(b.Context())
+		require.NoError(b, err)
+		require.NoError(b, stream.Send(env))
+		_, err = stream.Recv()
+		require.NoError(b, err)
+		require.NoError(b, stream.CloseSend())
+	}
+}
+
+// BenchmarkBroadcast_SessionSequential measures sending one envelope at a time over a session.
+func BenchmarkBroadcast_SessionSequential(b *testing.B) {
+	s, err := NewBroadcastSession(startFakeOrderer(b))
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_ = s.Close()
+	})
+	env := &cb.Envelope{Payload: []byte("payload")}
+
+	for b.Loop() {
+		_, err := s.Broadcast(b.Context(), env)
+		require.NoError(b, err)
+	}
+}
+
+// BenchmarkBroadcast_SessionPipelined measures sending envelopes concurrently over a session.
+func BenchmarkBroadcast_SessionPipelined(b *testing.B) {
+	s, err := NewBroadcastSession(startFakeOrderer(b))
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_ = s.Close()
+	})
+	env := &cb.Envelope{Payload: []byte("payload")}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, err := s.Broadcast(context.Background(), env)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
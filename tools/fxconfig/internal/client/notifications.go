@@ -10,19 +10,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 )
 
 // NotificationClient provides a gRPC client for receiving transaction status notifications.
 // It manages bidirectional streaming with the committer notification service and multiplexes
-// notifications to multiple subscribers per transaction ID.
+// notifications to multiple subscribers per transaction ID. A failed stream is re-opened with
+// backoff, and all pending subscriptions are resent, until the reconnection budget is used up.
 type NotificationClient struct {
 	cfg    config.NotificationsConfig
 	closeF func()
@@ -31,11 +36,16 @@ type NotificationClient struct {
 	requestQueue  chan *committerpb.NotificationRequest
 	responseQueue chan *committerpb.NotificationResponse
 
-	subscribers   map[string][]chan int
+	subscribers map[string][]chan adapters.TxStatus
+	// timeouts holds the waiting timeout requested for a txID if it differs from the configured one.
+	// It is guarded by subscribersMu and used to resubscribe pending txIDs after a stream failure.
+	timeouts      map[string]time.Duration
 	subscribersMu sync.RWMutex
 
-	// streamErr holds the error that caused the stream to terminate.
-	// Atomically stored; checked by Subscribe() before sending requests.
+	// streamErr holds the error that caused the stream to terminate permanently,
+	// i.e., after all reconnection attempts failed or the client was closed.
+	// Atomically stored; checked by Subscribe() before sending requests and
+	// reported by WaitForEvent() to subscribers whose channel was closed.
 	streamErr atomic.Pointer[error]
 }
 
@@ -58,7 +68,8 @@ func NewNotificationClient(cfg config.NotificationsConfig) (*NotificationClient,
 		},
 		requestQueue:  make(chan *committerpb.NotificationRequest),
 		responseQueue: make(chan *committerpb.NotificationResponse),
-		subscribers:   make(map[string][]chan int),
+		subscribers:   make(map[string][]chan adapters.TxStatus),
+		timeouts:      make(map[string]time.Duration),
 	}
 
 	go func() {
@@ -80,7 +91,24 @@ func (n *NotificationClient) Close() error {
 
 // Subscribe registers interest in a transaction's status and returns a channel for notifications.
 // Multiple subscribers to the same txID share a single upstream subscription.
-func (n *NotificationClient) Subscribe(ctx context.Context, txID string) (chan int, error) {
+func (n *NotificationClient) Subscribe(ctx context.Context, txID string) (chan adapters.TxStatus, error) {
+	subscriptions, err := n.SubscribeBatch(ctx, []string{txID}, 0)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions[0], nil
+}
+
+// SubscribeBatch registers interest in the status of several transactions at once.
+// All txIDs without an active upstream subscription are requested in a single TxIDsBatch,
+// asking the notifier to wait up to timeout, or the configured waiting timeout if zero.
+// TxIDs that are already subscribed keep the waiting timeout of their first subscription.
+// Returns one notification channel per txID, in input order.
+func (n *NotificationClient) SubscribeBatch(
+	ctx context.Context,
+	txIDs []string,
+	timeout time.Duration,
+) ([]chan adapters.TxStatus, error) {
 	// Apply timeout to prevent blocking on requestQueue send.
 	ctx, cancel := context.WithTimeout(ctx, n.cfg.WaitingTimeout)
 	defer cancel()
@@ -90,58 +118,41 @@ func (n *NotificationClient) Subscribe(ctx context.Context, txID string) (chan i
 		return nil, *err
 	}
 
-	receiverCh := make(chan int, 1)
-	isFirst := func() bool {
+	receivers := make([]chan adapters.TxStatus, len(txIDs))
+	firstTxIDs := func() []string {
 		n.subscribersMu.Lock()
 		defer n.subscribersMu.Unlock()
 
-		subscribers := n.subscribers[txID]
-		n.subscribers[txID] = append(subscribers, receiverCh)
+		var first []string
+		for i, txID := range txIDs {
+			receivers[i] = make(chan adapters.TxStatus, 1)
 
-		return len(subscribers) == 0
+			subscribers := n.subscribers[txID]
+			n.subscribers[txID] = append(subscribers, receivers[i])
+
+			if len(subscribers) == 0 {
+				first = append(first, txID)
+				if timeout > 0 {
+					n.timeouts[txID] = timeout
+				}
+			}
+		}
+		return first
 	}()
 
-	if !isFirst {
-		// we already have an active subscription for this txID
-		return receiverCh, nil
+	if len(firstTxIDs) == 0 {
+		// we already have an active subscription for all txIDs
+		return receivers, nil
 	}
 
 	rollback := func() {
-		// rollback can race logically with dispatcher cleanup in listen(),
-		// where completed txIDs are also deleted from n.subscribers. The shared
-		// subscribersMu lock plus the missing-key guard below makes this idempotent
-		// and safe regardless of which path removes the entry first.
-		n.subscribersMu.Lock()
-		defer n.subscribersMu.Unlock()
-
-		subscribers, ok := n.subscribers[txID]
-		if !ok {
-			return
-		}
-
-		for i, ch := range subscribers {
-			if ch != receiverCh {
-				continue
-			}
-
-			subscribers = append(subscribers[:i], subscribers[i+1:]...)
-			if len(subscribers) == 0 {
-				delete(n.subscribers, txID)
-				return
-			}
-
-			n.subscribers[txID] = subscribers
-			return
+		for i, txID := range txIDs {
+			n.unsubscribe(txID, receivers[i])
 		}
 	}
 
 	// setup request
-	req := &committerpb.NotificationRequest{
-		TxStatusRequest: &committerpb.TxIDsBatch{
-			TxIds: []string{txID},
-		},
-		Timeout: durationpb.New(n.cfg.WaitingTimeout),
-	}
+	req := n.newRequest(firstTxIDs, timeout)
 
 	// check if our ctx is still open
 	select {
@@ -159,42 +170,192 @@ func (n *NotificationClient) Subscribe(ctx context.Context, txID string) (chan i
 	case n.requestQueue <- req:
 	}
 
-	return receiverCh, nil
+	return receivers, nil
+}
+
+// unsubscribe removes the receiver channel from the subscribers of txID.
+func (n *NotificationClient) unsubscribe(txID string, receiverCh chan adapters.TxStatus) {
+	// unsubscribe can race logically with dispatcher cleanup in listen(),
+	// where completed txIDs are also deleted from n.subscribers. The shared
+	// subscribersMu lock plus the missing-key guard below makes this idempotent
+	// and safe regardless of which path removes the entry first.
+	n.subscribersMu.Lock()
+	defer n.subscribersMu.Unlock()
+
+	subscribers, ok := n.subscribers[txID]
+	if !ok {
+		return
+	}
+
+	for i, ch := range subscribers {
+		if ch != receiverCh {
+			continue
+		}
+
+		subscribers = append(subscribers[:i], subscribers[i+1:]...)
+		if len(subscribers) == 0 {
+			delete(n.subscribers, txID)
+			delete(n.timeouts, txID)
+			return
+		}
+
+		n.subscribers[txID] = subscribers
+		return
+	}
 }
 
 // WaitForEvent blocks until a status notification arrives or the timeout expires.
-// Returns the transaction status code or an error if the context is canceled.
-func (n *NotificationClient) WaitForEvent(ctx context.Context, subscription chan int) (int, error) {
-	ctx, cancel := context.WithTimeout(ctx, n.cfg.WaitingTimeout)
+// The timeout should match the one of the subscription; zero uses the configured waiting timeout.
+// Returns the transaction status, a timed-out status if the waiting timeout expires,
+// the stream error if the notification stream terminated permanently,
+// or an error if the context is canceled.
+func (n *NotificationClient) WaitForEvent(
+	ctx context.Context,
+	subscription chan adapters.TxStatus,
+	timeout time.Duration,
+) (adapters.TxStatus, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, n.waitingTimeout(timeout))
 	defer cancel()
-	return wait(ctx, subscription)
+
+	status, err := wait(waitCtx, subscription)
+	switch {
+	case errors.Is(err, errSubscriptionClosed):
+		if streamErr := n.streamErr.Load(); streamErr != nil {
+			return adapters.TxStatus{}, fmt.Errorf("notification stream terminated: %w", *streamErr)
+		}
+		return adapters.TxStatus{}, err
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		// the waiting timeout expired before the notifier reported the timeout
+		return adapters.TxStatus{State: adapters.TxStateTimedOut}, nil
+	default:
+		return status, err
+	}
 }
 
-func wait(ctx context.Context, subscription chan int) (int, error) {
+// errSubscriptionClosed is returned by wait if the subscription was closed without a status.
+var errSubscriptionClosed = errors.New("subscription closed")
+
+func wait(ctx context.Context, subscription chan adapters.TxStatus) (adapters.TxStatus, error) {
 	select {
 	case <-ctx.Done():
-		return 0, ctx.Err()
+		return adapters.TxStatus{}, ctx.Err()
 	default:
 	}
 
 	// try to push to request queue
 	select {
 	case <-ctx.Done():
-		return 0, ctx.Err()
-	case status := <-subscription:
+		return adapters.TxStatus{}, ctx.Err()
+	case status, ok := <-subscription:
+		if !ok {
+			return adapters.TxStatus{}, errSubscriptionClosed
+		}
 		return status, nil
 	}
 }
 
-// listen runs the bidirectional gRPC stream, managing request/response queues
-// and dispatching notifications to subscribers. Blocks until context is canceled.
+const (
+	// maxReconnectBackoff caps the exponential backoff between reconnection attempts.
+	maxReconnectBackoff = 30 * time.Second
+	// minHealthyStreamUptime is the time after which a stream that has not delivered
+	// any response is considered healthy.
+	minHealthyStreamUptime = 10 * time.Second
+)
+
+// listen runs the notification stream and re-establishes it whenever it fails.
+// After a stream failure, it waits with exponential backoff and re-opens the stream,
+// resubscribing all pending transaction IDs. Once the configured reconnection budget
+// is exhausted, the error is latched in streamErr, all pending subscriptions are closed,
+// and the error is returned.
+// Blocks until context is canceled or the reconnection budget is used up.
+func (n *NotificationClient) listen(ctx context.Context) error {
+	var attempt int
+	for {
+		healthy, err := n.runStream(ctx)
+		if ctx.Err() != nil {
+			n.terminate(ctx.Err())
+			return ctx.Err()
+		}
+
+		// only a stream that has delivered a response, or stayed up for a while,
+		// resets the retry budget; a stream that fails right after opening does not.
+		if healthy {
+			attempt = 0
+		}
+
+		if attempt >= n.cfg.ReconnectAttempts {
+			if attempt > 0 {
+				err = fmt.Errorf("notification stream failed after %d reconnect attempts: %w", attempt, err)
+			}
+			n.terminate(err)
+			return err
+		}
+		attempt++
+
+		backoff := reconnectBackoff(n.cfg.ReconnectBackoff, attempt)
+		logger.Warnf("Notification stream failed, reconnecting in %s (attempt %d/%d): %s",
+			backoff, attempt, n.cfg.ReconnectAttempts, err)
+
+		if err := n.backoff(ctx, backoff); err != nil {
+			n.terminate(err)
+			return err
+		}
+	}
+}
+
+// backoff waits for the given delay before reconnecting. Meanwhile, the request queue is drained,
+// so that subscribing does not block while the stream is down: the subscribers of a request are
+// registered before it is queued, hence they are resubscribed when the stream is re-opened.
+func (n *NotificationClient) backoff(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-n.requestQueue:
+		case <-timer.C:
+			return nil
+		}
+	}
+}
+
+// reconnectBackoff returns the delay before the given reconnection attempt.
+// The initial delay is doubled for every attempt and capped at maxReconnectBackoff.
+func reconnectBackoff(initial time.Duration, attempt int) time.Duration {
+	d := initial
+	for i := 1; i < attempt && d < maxReconnectBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxReconnectBackoff)
+}
+
+// runStream runs a single bidirectional gRPC stream, managing request/response queues
+// and dispatching notifications to subscribers. Returns whether the stream was healthy,
+// i.e., delivered a response or stayed up for minHealthyStreamUptime, and the error that
+// terminated it.
 //
 //nolint:gocognit
-func (n *NotificationClient) listen(ctx context.Context) error {
+func (n *NotificationClient) runStream(ctx context.Context) (bool, error) {
 	notifyStream, err := n.notifyClient.OpenNotificationStream(ctx)
 	if err != nil {
-		n.streamErr.Store(&err)
-		return err
+		return false, err
+	}
+	opened := time.Now()
+	var received atomic.Bool
+	healthy := func() bool {
+		return received.Load() || time.Since(opened) >= minHealthyStreamUptime
+	}
+
+	// resubscribe all transactions that are still awaiting a status,
+	// e.g., after the previous stream has failed.
+	for _, req := range n.pendingRequests() {
+		logger.Infof("Resubscribing %d pending transaction(s) on notification stream",
+			len(req.GetTxStatusRequest().GetTxIds()))
+		if err := notifyStream.Send(req); err != nil {
+			return false, err
+		}
 	}
 
 	// Use the base context for errgroup
@@ -210,6 +371,7 @@ func (n *NotificationClient) listen(ctx context.Context) error {
 				}
 				return rerr
 			}
+			received.Store(true)
 			select {
 			case <-gCtx.Done():
 				return gCtx.Err()
@@ -236,11 +398,6 @@ func (n *NotificationClient) listen(ctx context.Context) error {
 
 	// spawn notification dispatcher
 	g.Go(func() error {
-		type notificationCall struct {
-			receiverQueue chan int
-			status        int
-		}
-
 		var resp *committerpb.NotificationResponse
 		for {
 			select {
@@ -249,67 +406,125 @@ func (n *NotificationClient) listen(ctx context.Context) error {
 			case resp = <-n.responseQueue:
 			}
 
-			res := parseResponse(resp)
+			n.dispatch(parseResponse(resp))
+		}
+	})
+
+	err = g.Wait()
+	return healthy(), err
+}
 
-			// Collect subscribers under lock, then release before spawning goroutines.
-			// This minimizes lock hold time — only map lookups and deletes happen
-			// under the lock. Goroutine scheduling happens entirely outside.
-			var notifications []notificationCall
+// dispatch delivers the parsed statuses to all subscribers of the corresponding txIDs.
+func (n *NotificationClient) dispatch(res map[string]adapters.TxStatus) {
+	type notificationCall struct {
+		receiverQueue chan adapters.TxStatus
+		status        adapters.TxStatus
+	}
 
-			n.subscribersMu.Lock()
-			for txID, v := range res {
-				receivers, ok := n.subscribers[txID]
-				if !ok {
-					continue
-				}
-				delete(n.subscribers, txID)
-				for _, q := range receivers {
-					notifications = append(notifications, notificationCall{receiverQueue: q, status: v})
-				}
-			}
-			n.subscribersMu.Unlock()
+	// Collect subscribers under lock, then release before delivering.
+	// This minimizes lock hold time — only map lookups and deletes happen
+	// under the lock. Delivery happens entirely outside.
+	var notifications []notificationCall
 
-			for _, c := range notifications {
-				select {
-				case c.receiverQueue <- c.status:
-				default:
-					// message dropped
-				}
-			}
+	n.subscribersMu.Lock()
+	for txID, v := range res {
+		receivers, ok := n.subscribers[txID]
+		if !ok {
+			continue
 		}
-	})
+		delete(n.subscribers, txID)
+		delete(n.timeouts, txID)
+		for _, q := range receivers {
+			notifications = append(notifications, notificationCall{receiverQueue: q, status: v})
+		}
+	}
+	n.subscribersMu.Unlock()
 
-	err = g.Wait()
+	for _, c := range notifications {
+		select {
+		case c.receiverQueue <- c.status:
+		default:
+			// message dropped
+		}
+	}
+}
+
+// pendingRequests returns the requests resubscribing the txIDs that still have active subscribers,
+// one per requested waiting timeout.
+func (n *NotificationClient) pendingRequests() []*committerpb.NotificationRequest {
+	n.subscribersMu.RLock()
+	defer n.subscribersMu.RUnlock()
 
-	// Capture the error from the group before cleanup.
-	if err != nil && !errors.Is(err, context.Canceled) {
-		n.streamErr.Store(&err)
+	byTimeout := make(map[time.Duration][]string)
+	for txID := range n.subscribers {
+		timeout := n.timeouts[txID]
+		byTimeout[timeout] = append(byTimeout[timeout], txID)
 	}
 
-	// Cleanup subscribers map when listen() exits
+	reqs := make([]*committerpb.NotificationRequest, 0, len(byTimeout))
+	for _, timeout := range slices.Sorted(maps.Keys(byTimeout)) {
+		txIDs := byTimeout[timeout]
+		slices.Sort(txIDs)
+		reqs = append(reqs, n.newRequest(txIDs, timeout))
+	}
+
+	return reqs
+}
+
+// terminate latches err in streamErr once the listener terminates, and closes and drops
+// all subscriptions, so that pending WaitForEvent calls return the error immediately.
+func (n *NotificationClient) terminate(err error) {
+	n.streamErr.Store(&err)
+
 	n.subscribersMu.Lock()
+	defer n.subscribersMu.Unlock()
+	for _, receivers := range n.subscribers {
+		for _, ch := range receivers {
+			close(ch)
+		}
+	}
 	clear(n.subscribers)
-	n.subscribersMu.Unlock()
+	clear(n.timeouts)
+}
+
+// newRequest creates a notification request for the given txIDs and waiting timeout.
+func (n *NotificationClient) newRequest(txIDs []string, timeout time.Duration) *committerpb.NotificationRequest {
+	return &committerpb.NotificationRequest{
+		TxStatusRequest: &committerpb.TxIDsBatch{
+			TxIds: txIDs,
+		},
+		Timeout: durationpb.New(n.waitingTimeout(timeout)),
+	}
+}
 
-	return err
+// waitingTimeout returns timeout, or the configured waiting timeout if it is zero.
+func (n *NotificationClient) waitingTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return n.cfg.WaitingTimeout
 }
 
-// parseResponse extracts transaction statuses from a notification response,
-// mapping transaction IDs to their status codes (timeouts and status events).
-func parseResponse(resp *committerpb.NotificationResponse) map[string]int {
-	res := make(map[string]int)
+// parseResponse extracts transaction statuses from a notification response, mapping transaction
+// IDs to their final status and ledger position, or to a timed-out status.
+func parseResponse(resp *committerpb.NotificationResponse) map[string]adapters.TxStatus {
+	res := make(map[string]adapters.TxStatus)
 
 	// first parse all timeouts
 	for _, txID := range resp.GetTimeoutTxIds() {
-		res[txID] = int(committerpb.Status_STATUS_UNSPECIFIED)
+		res[txID] = adapters.TxStatus{TxID: txID, State: adapters.TxStateTimedOut}
 	}
 
 	// next we parse the status events
 	for _, r := range resp.GetTxStatusEvents() {
-		txID := r.GetRef().GetTxId()
-		status := r.GetStatus()
-
-		res[txID] = int(status)
+		ref := r.GetRef()
+		res[ref.GetTxId()] = adapters.TxStatus{
+			TxID:     ref.GetTxId(),
+			State:    adapters.TxStateFinal,
+			Status:   r.GetStatus(),
+			BlockNum: ref.GetBlockNum(),
+			TxNum:    ref.GetTxNum(),
+		}
 	}
 
 	return res
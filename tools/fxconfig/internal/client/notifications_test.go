@@ -9,12 +9,16 @@ package client
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 )
 
@@ -25,7 +29,8 @@ func newTestNotificationClient(waitingTimeout time.Duration) *NotificationClient
 		},
 		requestQueue:  make(chan *committerpb.NotificationRequest),
 		responseQueue: make(chan *committerpb.NotificationResponse),
-		subscribers:   make(map[string][]chan int),
+		subscribers:   make(map[string][]chan adapters.TxStatus),
+		timeouts:      make(map[string]time.Duration),
 	}
 }
 
@@ -46,8 +51,8 @@ func TestParseResponse_TimeoutTxIDs(t *testing.T) {
 	}
 	result := parseResponse(resp)
 	require.Len(t, result, 2)
-	require.Equal(t, int(committerpb.Status_STATUS_UNSPECIFIED), result["tx1"])
-	require.Equal(t, int(committerpb.Status_STATUS_UNSPECIFIED), result["tx2"])
+	require.Equal(t, adapters.TxStatus{TxID: "tx1", State: adapters.TxStateTimedOut}, result["tx1"])
+	require.Equal(t, adapters.TxStatus{TxID: "tx2", State: adapters.TxStateTimedOut}, result["tx2"])
 }
 
 func TestParseResponse_TxStatusEvents(t *testing.T) {
@@ -56,14 +61,20 @@ func TestParseResponse_TxStatusEvents(t *testing.T) {
 	resp := &committerpb.NotificationResponse{
 		TxStatusEvents: []*committerpb.TxStatus{
 			{
-				Ref:    &committerpb.TxRef{TxId: "tx1"},
-				Status: committerpb.Status_COMMITTED,
+				Ref:    &committerpb.TxRef{TxId: "tx1", BlockNum: 12, TxNum: 3},
+				Status: committerpb.Status_ABORTED_MVCC_CONFLICT,
 			},
 		},
 	}
 	result := parseResponse(resp)
 	require.Len(t, result, 1)
-	require.Equal(t, int(committerpb.Status_COMMITTED), result["tx1"])
+	require.Equal(t, adapters.TxStatus{
+		TxID:     "tx1",
+		State:    adapters.TxStateFinal,
+		Status:   committerpb.Status_ABORTED_MVCC_CONFLICT,
+		BlockNum: 12,
+		TxNum:    3,
+	}, result["tx1"])
 }
 
 func TestParseResponse_StatusOverridesTimeout(t *testing.T) {
@@ -80,7 +91,7 @@ func TestParseResponse_StatusOverridesTimeout(t *testing.T) {
 	}
 	result := parseResponse(resp)
 	require.Len(t, result, 1)
-	require.Equal(t, int(committerpb.Status_COMMITTED), result["tx1"])
+	require.True(t, result["tx1"].Committed())
 }
 
 // wait tests
@@ -91,19 +102,29 @@ func TestWait_ContextAlreadyCanceled(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	_, err := wait(ctx, make(chan int, 1))
+	_, err := wait(ctx, make(chan adapters.TxStatus, 1))
 	require.ErrorIs(t, err, context.Canceled)
 }
 
 func TestWait_StatusReceived(t *testing.T) {
 	t.Parallel()
 
-	ch := make(chan int, 1)
-	ch <- 42
+	ch := make(chan adapters.TxStatus, 1)
+	ch <- adapters.TxStatus{TxID: "tx1", State: adapters.TxStateFinal, Status: committerpb.Status_COMMITTED}
 
 	status, err := wait(t.Context(), ch)
 	require.NoError(t, err)
-	require.Equal(t, 42, status)
+	require.True(t, status.Committed())
+}
+
+func TestWait_SubscriptionClosed(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan adapters.TxStatus, 1)
+	close(ch)
+
+	_, err := wait(t.Context(), ch)
+	require.ErrorIs(t, err, errSubscriptionClosed)
 }
 
 // WaitForEvent tests
@@ -112,12 +133,12 @@ func TestWaitForEvent_Success(t *testing.T) {
 	t.Parallel()
 
 	nc := newTestNotificationClient(time.Second)
-	ch := make(chan int, 1)
-	ch <- 7
+	ch := make(chan adapters.TxStatus, 1)
+	ch <- adapters.TxStatus{TxID: "tx1", State: adapters.TxStateTimedOut}
 
-	status, err := nc.WaitForEvent(t.Context(), ch)
+	status, err := nc.WaitForEvent(t.Context(), ch, 0)
 	require.NoError(t, err)
-	require.Equal(t, 7, status)
+	require.Equal(t, adapters.TxStateTimedOut, status.State)
 }
 
 func TestWaitForEvent_Timeout(t *testing.T) {
@@ -125,8 +146,9 @@ func TestWaitForEvent_Timeout(t *testing.T) {
 
 	nc := newTestNotificationClient(time.Millisecond)
 
-	_, err := nc.WaitForEvent(t.Context(), make(chan int))
-	require.Error(t, err)
+	status, err := nc.WaitForEvent(t.Context(), make(chan adapters.TxStatus), 0)
+	require.NoError(t, err)
+	require.Equal(t, adapters.TxStateTimedOut, status.State)
 }
 
 // Subscribe tests
@@ -137,7 +159,7 @@ func TestNotificationClient_Subscribe_DuplicateSubscription(t *testing.T) {
 	nc := newTestNotificationClient(time.Second)
 	// Pre-populate a subscriber so the second subscribe is a duplicate
 	// and won't send to the requestQueue (which would block without a listener).
-	nc.subscribers["tx1"] = []chan int{make(chan int, 1)}
+	nc.subscribers["tx1"] = []chan adapters.TxStatus{make(chan adapters.TxStatus, 1)}
 
 	ch, err := nc.Subscribe(t.Context(), "tx1")
 	require.NoError(t, err)
@@ -182,7 +204,7 @@ func TestNotificationClient_Subscribe_CancelKeepsExistingSubscribers(t *testing.
 	require.ErrorIs(t, <-firstErrCh, context.Canceled)
 
 	nc.subscribersMu.RLock()
-	subscribers := append([]chan int(nil), nc.subscribers[txID]...)
+	subscribers := append([]chan adapters.TxStatus(nil), nc.subscribers[txID]...)
 	nc.subscribersMu.RUnlock()
 	require.Len(t, subscribers, 1)
 	require.Equal(t, secondCh, subscribers[0])
@@ -262,6 +284,39 @@ func TestNotificationClient_Subscribe_StreamError(t *testing.T) {
 	require.Empty(t, nc.subscribers, "subscribers map should not be modified when streamErr is set")
 }
 
+func TestNotificationClient_SubscribeBatch_SingleRequest(t *testing.T) {
+	t.Parallel()
+
+	nc := newTestNotificationClient(time.Second)
+	// tx2 already has an active subscription and must not be requested again
+	nc.subscribers["tx2"] = []chan adapters.TxStatus{make(chan adapters.TxStatus, 1)}
+
+	reqCh := make(chan *committerpb.NotificationRequest, 1)
+	go func() { reqCh <- <-nc.requestQueue }()
+
+	chs, err := nc.SubscribeBatch(t.Context(), []string{"tx1", "tx2", "tx3"}, 0)
+	require.NoError(t, err)
+	require.Len(t, chs, 3)
+
+	req := <-reqCh
+	require.Equal(t, []string{"tx1", "tx3"}, req.GetTxStatusRequest().GetTxIds())
+	require.Len(t, nc.subscribers["tx2"], 2)
+}
+
+func TestNotificationClient_SubscribeBatch_RollbackOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	nc := newTestNotificationClient(time.Millisecond)
+	existing := make(chan adapters.TxStatus, 1)
+	nc.subscribers["tx2"] = []chan adapters.TxStatus{existing}
+
+	_, err := nc.SubscribeBatch(t.Context(), []string{"tx1", "tx2"}, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NotContains(t, nc.subscribers, "tx1")
+	require.Equal(t, []chan adapters.TxStatus{existing}, nc.subscribers["tx2"])
+}
+
 func TestNotificationClient_Subscribe_Timeout(t *testing.T) {
 	t.Parallel()
 
@@ -320,8 +375,8 @@ func TestNotificationClient_ConcurrentSubscribers_SameTxID_BothReceive(t *testin
 
 	nc.subscribersMu.Lock()
 	type call struct {
-		ch     chan int
-		status int
+		ch     chan adapters.TxStatus
+		status adapters.TxStatus
 	}
 	var calls []call
 	for txID, v := range resp {
@@ -348,14 +403,14 @@ func TestNotificationClient_ConcurrentSubscribers_SameTxID_BothReceive(t *testin
 	// Both subscribers must receive the status
 	select {
 	case s := <-ch1:
-		require.Equal(t, int(committerpb.Status_COMMITTED), s)
+		require.True(t, s.Committed())
 	case <-time.After(time.Second):
 		t.Fatal("ch1: timed out — first subscriber was starved")
 	}
 
 	select {
 	case s := <-ch2:
-		require.Equal(t, int(committerpb.Status_COMMITTED), s)
+		require.True(t, s.Committed())
 	case <-time.After(time.Second):
 		t.Fatal("ch2: timed out — notification silently dropped for duplicate subscriber")
 	}
@@ -379,10 +434,10 @@ func TestNotificationClient_SubscribeAfterDispatch_DoesNotHang(t *testing.T) {
 
 	// Deliver to the first subscriber
 	for _, r := range receivers {
-		r <- int(committerpb.Status_COMMITTED)
+		r <- adapters.TxStatus{TxID: "tx-resubscribe", State: adapters.TxStateFinal, Status: committerpb.Status_COMMITTED}
 	}
 	s := <-ch1
-	require.Equal(t, int(committerpb.Status_COMMITTED), s)
+	require.True(t, s.Committed())
 
 	// A new subscriber arrives for the same txID after dispatch.
 	// This takes the fresh-subscription path and pushes to requestQueue.
@@ -402,9 +457,9 @@ func TestNotificationClient_ListenExit_ClearsSubscribers(t *testing.T) {
 	nc := newTestNotificationClient(2 * time.Second)
 
 	// Pre-populate subscribers as if Subscribe() was called
-	ch := make(chan int, 1)
+	ch := make(chan adapters.TxStatus, 1)
 	nc.subscribersMu.Lock()
-	nc.subscribers["tx-orphan"] = []chan int{ch}
+	nc.subscribers["tx-orphan"] = []chan adapters.TxStatus{ch}
 	nc.subscribersMu.Unlock()
 
 	// Simulate listen() exiting — it calls clear(n.subscribers)
@@ -421,7 +476,7 @@ func TestNotificationClient_ListenExit_ClearsSubscribers(t *testing.T) {
 	// no dispatcher is running and listen() has cleaned up
 	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
 	defer cancel()
-	_, err := nc.WaitForEvent(ctx, ch)
+	_, err := nc.WaitForEvent(ctx, ch, 0)
 	require.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
@@ -431,7 +486,7 @@ func TestNotificationClient_ConcurrentSubscribeAndDispatch(t *testing.T) {
 	nc := newTestNotificationClient(2 * time.Second)
 
 	const subscriberCount = 10
-	channels := make([]chan int, subscriberCount)
+	channels := make([]chan adapters.TxStatus, subscriberCount)
 
 	// First subscriber triggers the upstream request
 	go func() { <-nc.requestQueue }()
@@ -452,7 +507,7 @@ func TestNotificationClient_ConcurrentSubscribeAndDispatch(t *testing.T) {
 	nc.subscribersMu.RUnlock()
 
 	// Simulate dispatcher delivery
-	status := int(committerpb.Status_COMMITTED)
+	status := adapters.TxStatus{TxID: "tx-race", State: adapters.TxStateFinal, Status: committerpb.Status_COMMITTED}
 	nc.subscribersMu.Lock()
 	receivers := nc.subscribers["tx-race"]
 	delete(nc.subscribers, "tx-race")
@@ -481,3 +536,350 @@ func TestNotificationClient_ConcurrentSubscribeAndDispatch(t *testing.T) {
 	nc.subscribersMu.RUnlock()
 	require.False(t, exists, "subscriber entry should be deleted after dispatch")
 }
+
+// Reconnection tests
+
+// fakeNotificationStream implements grpc.BidiStreamingClient[NotificationRequest, NotificationResponse].
+// Requests sent by the client are forwarded to sent; Recv blocks until a response is pushed to
+// responses or the stream is broken via fail.
+type fakeNotificationStream struct {
+	ctx       context.Context //nolint:containedctx
+	sent      chan *committerpb.NotificationRequest
+	responses chan *committerpb.NotificationResponse
+	failed    chan error
+}
+
+func newFakeNotificationStream(ctx context.Context) *fakeNotificationStream {
+	return &fakeNotificationStream{
+		ctx:       ctx,
+		sent:      make(chan *committerpb.NotificationRequest, 16),
+		responses: make(chan *committerpb.NotificationResponse, 16),
+		failed:    make(chan error, 1),
+	}
+}
+
+func (s *fakeNotificationStream) fail(err error) { s.failed <- err }
+
+func (s *fakeNotificationStream) Send(req *committerpb.NotificationRequest) error {
+	s.sent <- req
+	return nil
+}
+
+func (s *fakeNotificationStream) Recv() (*committerpb.NotificationResponse, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case err := <-s.failed:
+		return nil, err
+	case resp := <-s.responses:
+		return resp, nil
+	}
+}
+
+func (*fakeNotificationStream) Header() (metadata.MD, error) { return nil, nil }
+func (*fakeNotificationStream) Trailer() metadata.MD         { return nil }
+func (*fakeNotificationStream) CloseSend() error             { return nil }
+func (s *fakeNotificationStream) Context() context.Context   { return s.ctx }
+func (*fakeNotificationStream) SendMsg(_ any) error          { return nil }
+func (*fakeNotificationStream) RecvMsg(_ any) error          { return nil }
+
+// fakeNotifierClient implements committerpb.NotifierClient. Every successfully opened
+// stream is published on streams; openErrs are returned (in order) before opening a stream.
+type fakeNotifierClient struct {
+	mu       sync.Mutex
+	openErrs []error
+	opened   int
+	streams  chan *fakeNotificationStream
+}
+
+func (f *fakeNotifierClient) OpenNotificationStream(
+	ctx context.Context,
+	_ ...grpc.CallOption,
+) (grpc.BidiStreamingClient[committerpb.NotificationRequest, committerpb.NotificationResponse], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.opened++
+	if len(f.openErrs) > 0 {
+		err := f.openErrs[0]
+		f.openErrs = f.openErrs[1:]
+		return nil, err
+	}
+
+	s := newFakeNotificationStream(ctx)
+	f.streams <- s
+	return s, nil
+}
+
+func newReconnectingNotificationClient(
+	notifier *fakeNotifierClient,
+	attempts int,
+) *NotificationClient {
+	nc := newTestNotificationClient(2 * time.Second)
+	nc.notifyClient = notifier
+	nc.cfg.ReconnectAttempts = attempts
+	nc.cfg.ReconnectBackoff = time.Millisecond
+	return nc
+}
+
+func nextStream(t *testing.T, notifier *fakeNotifierClient) *fakeNotificationStream {
+	t.Helper()
+	select {
+	case s := <-notifier.streams:
+		return s
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for notification stream")
+		return nil
+	}
+}
+
+func nextRequest(t *testing.T, s *fakeNotificationStream) *committerpb.NotificationRequest {
+	t.Helper()
+	select {
+	case req := <-s.sent:
+		return req
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for notification request")
+		return nil
+	}
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, time.Second, reconnectBackoff(time.Second, 1))
+	require.Equal(t, 2*time.Second, reconnectBackoff(time.Second, 2))
+	require.Equal(t, 8*time.Second, reconnectBackoff(time.Second, 4))
+	require.Equal(t, maxReconnectBackoff, reconnectBackoff(time.Second, 100))
+}
+
+func TestNotificationClient_Listen_ResubscribesAfterStreamFailure(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 4)}
+	nc := newReconnectingNotificationClient(notifier, 3)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	listenErr := make(chan error, 1)
+	go func() { listenErr <- nc.listen(ctx) }()
+
+	first := nextStream(t, notifier)
+
+	ch, err := nc.Subscribe(t.Context(), "tx1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx1"}, nextRequest(t, first).GetTxStatusRequest().GetTxIds())
+
+	// break the stream; the client must re-open it and resubscribe the pending txID
+	first.fail(errors.New("committer restarted"))
+
+	second := nextStream(t, notifier)
+	require.Equal(t, []string{"tx1"}, nextRequest(t, second).GetTxStatusRequest().GetTxIds())
+
+	second.responses <- &committerpb.NotificationResponse{
+		TxStatusEvents: []*committerpb.TxStatus{
+			committerpb.NewTxStatus(committerpb.Status_COMMITTED, "tx1", 1, 0),
+		},
+	}
+
+	status, err := nc.WaitForEvent(t.Context(), ch, 0)
+	require.NoError(t, err)
+	require.Equal(t, adapters.TxStatus{
+		TxID: "tx1", State: adapters.TxStateFinal, Status: committerpb.Status_COMMITTED, BlockNum: 1,
+	}, status)
+	require.Nil(t, nc.streamErr.Load())
+
+	cancel()
+	require.ErrorIs(t, <-listenErr, context.Canceled)
+}
+
+func TestNotificationClient_SubscribeBatch_TimeoutExceedsWaitingTimeout(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 4)}
+	nc := newReconnectingNotificationClient(notifier, 3)
+	nc.cfg.WaitingTimeout = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = nc.listen(ctx) }()
+
+	first := nextStream(t, notifier)
+
+	chs, err := nc.SubscribeBatch(t.Context(), []string{"tx1"}, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, nextRequest(t, first).GetTimeout().AsDuration())
+
+	// the requested timeout is kept when resubscribing after a stream failure
+	first.fail(errors.New("committer restarted"))
+	second := nextStream(t, notifier)
+	require.Equal(t, time.Minute, nextRequest(t, second).GetTimeout().AsDuration())
+
+	type result struct {
+		status adapters.TxStatus
+		err    error
+	}
+	waited := make(chan result, 1)
+	go func() {
+		status, err := nc.WaitForEvent(t.Context(), chs[0], time.Minute)
+		waited <- result{status, err}
+	}()
+
+	// the status arrives after the configured waiting timeout, but within the requested one
+	time.Sleep(5 * nc.cfg.WaitingTimeout)
+	second.responses <- &committerpb.NotificationResponse{
+		TxStatusEvents: []*committerpb.TxStatus{
+			committerpb.NewTxStatus(committerpb.Status_COMMITTED, "tx1", 1, 0),
+		},
+	}
+
+	res := <-waited
+	require.NoError(t, res.err)
+	require.True(t, res.status.Committed())
+}
+
+func TestNotificationClient_Listen_SubscribeDuringBackoff(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 4)}
+	nc := newReconnectingNotificationClient(notifier, 3)
+	nc.cfg.WaitingTimeout = 100 * time.Millisecond
+	nc.cfg.ReconnectBackoff = time.Second
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	go func() { _ = nc.listen(ctx) }()
+
+	first := nextStream(t, notifier)
+	first.fail(errors.New("committer restarted"))
+	// give the listener time to enter the backoff
+	time.Sleep(50 * time.Millisecond)
+
+	// subscribing must not wait for the stream to be re-opened, which takes longer than the
+	// waiting timeout, and the subscription must survive until then
+	start := time.Now()
+	ch, err := nc.Subscribe(t.Context(), "tx1")
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), nc.cfg.WaitingTimeout)
+
+	second := nextStream(t, notifier)
+	require.Equal(t, []string{"tx1"}, nextRequest(t, second).GetTxStatusRequest().GetTxIds())
+
+	second.responses <- &committerpb.NotificationResponse{
+		TxStatusEvents: []*committerpb.TxStatus{
+			committerpb.NewTxStatus(committerpb.Status_COMMITTED, "tx1", 1, 0),
+		},
+	}
+	status, err := nc.WaitForEvent(t.Context(), ch, time.Second)
+	require.NoError(t, err)
+	require.True(t, status.Committed())
+}
+
+func TestNotificationClient_Listen_RetryBudgetExhausted(t *testing.T) {
+	t.Parallel()
+
+	openErr := errors.New("connection refused")
+	notifier := &fakeNotifierClient{
+		streams:  make(chan *fakeNotificationStream, 1),
+		openErrs: []error{openErr, openErr, openErr},
+	}
+	nc := newReconnectingNotificationClient(notifier, 2)
+	pending := make(chan adapters.TxStatus, 1)
+	nc.subscribers["tx-pending"] = []chan adapters.TxStatus{pending}
+
+	err := nc.listen(t.Context())
+	require.ErrorIs(t, err, openErr)
+	require.ErrorContains(t, err, "after 2 reconnect attempts")
+	require.Equal(t, 3, notifier.opened)
+	require.Empty(t, nc.subscribers)
+
+	// pending waiters get the stream error instead of waiting for the timeout
+	_, err = nc.WaitForEvent(t.Context(), pending, 0)
+	require.ErrorIs(t, err, openErr)
+	require.ErrorContains(t, err, "notification stream terminated")
+
+	// the error is latched and reported to later subscribers
+	_, err = nc.Subscribe(t.Context(), "tx1")
+	require.ErrorIs(t, err, openErr)
+}
+
+func TestNotificationClient_Listen_ReconnectionDisabled(t *testing.T) {
+	t.Parallel()
+
+	openErr := errors.New("connection refused")
+	notifier := &fakeNotifierClient{
+		streams:  make(chan *fakeNotificationStream, 1),
+		openErrs: []error{openErr},
+	}
+	nc := newReconnectingNotificationClient(notifier, 0)
+
+	err := nc.listen(t.Context())
+	require.ErrorIs(t, err, openErr)
+	require.Equal(t, 1, notifier.opened)
+}
+
+func TestNotificationClient_Listen_HealthyStreamResetsBudget(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 4)}
+	nc := newReconnectingNotificationClient(notifier, 1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	listenErr := make(chan error, 1)
+	go func() { listenErr <- nc.listen(ctx) }()
+
+	// each stream delivers a response before it fails, so the budget of one is never exhausted
+	for range 3 {
+		s := nextStream(t, notifier)
+		s.responses <- &committerpb.NotificationResponse{}
+		require.Eventually(t, func() bool { return len(s.responses) == 0 }, 2*time.Second, time.Millisecond)
+		s.fail(errors.New("stream reset"))
+	}
+	nextStream(t, notifier)
+	cancel()
+
+	require.ErrorIs(t, <-listenErr, context.Canceled)
+	require.ErrorIs(t, *nc.streamErr.Load(), context.Canceled)
+}
+
+func TestNotificationClient_Listen_FailingStreamExhaustsBudget(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 4)}
+	nc := newReconnectingNotificationClient(notifier, 2)
+
+	listenErr := make(chan error, 1)
+	go func() { listenErr <- nc.listen(t.Context()) }()
+
+	// the server accepts every stream but fails it immediately
+	streamErr := errors.New("stream reset")
+	for range 3 {
+		nextStream(t, notifier).fail(streamErr)
+	}
+
+	err := <-listenErr
+	require.ErrorIs(t, err, streamErr)
+	require.ErrorContains(t, err, "after 2 reconnect attempts")
+}
+
+func TestNotificationClient_Listen_CloseReleasesWaiters(t *testing.T) {
+	t.Parallel()
+
+	notifier := &fakeNotifierClient{streams: make(chan *fakeNotificationStream, 1)}
+	nc := newReconnectingNotificationClient(notifier, 1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	listenErr := make(chan error, 1)
+	go func() { listenErr <- nc.listen(ctx) }()
+
+	s := nextStream(t, notifier)
+	ch, err := nc.Subscribe(t.Context(), "tx1")
+	require.NoError(t, err)
+	nextRequest(t, s)
+
+	cancel()
+	require.ErrorIs(t, <-listenErr, context.Canceled)
+
+	_, err = nc.WaitForEvent(t.Context(), ch, 0)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -10,51 +10,127 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
 	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/msp"
-	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
 )
 
+// errNoResponse is reported for endpoints that did not acknowledge an envelope before
+// the outcome of its submission was decided.
+var errNoResponse = errors.New("no response before the submission was decided")
+
 // OrdererClient provides a gRPC client for submitting transactions to the Fabric-X ordering service.
 // It handles connection management, TLS configuration, and transaction envelope creation.
+// The ordering service can be reached through several endpoints (e.g., the routers of an Arma
+// deployment). Envelopes are either sent to one endpoint with failover to the next, or sent to
+// all endpoints requiring f+1 of them to accept, so that f crashed or byzantine endpoints are tolerated.
 type OrdererClient struct {
-	cfg    config.OrdererConfig
-	client ab.AtomicBroadcastClient
-	closeF func()
+	cfg       config.OrdererConfig
+	endpoints []*ordererEndpoint
+
+	// preferred is the index of the endpoint tried first by the failover strategy,
+	// i.e., the endpoint that accepted the last envelope.
+	preferred atomic.Int32
+}
+
+// ordererEndpoint is a connection to a single orderer endpoint.
+// All envelopes are sent over a single long-lived broadcast session that is opened on first
+// use and re-opened on the next call after it failed.
+type ordererEndpoint struct {
+	address string
+	client  ab.AtomicBroadcastClient
+	closeF  func()
+
+	sessionMu sync.Mutex
+	session   *BroadcastSession
+}
+
+// EndpointResult is the outcome of broadcasting an envelope to a single orderer endpoint.
+type EndpointResult struct {
+	Endpoint string
+	BroadcastResult
+}
+
+// SubmissionError reports an envelope that was not accepted by the required number of orderer endpoints.
+// It carries the result of every endpoint the envelope was sent to.
+type SubmissionError struct {
+	Required int
+	Results  []EndpointResult
+}
+
+func (e *SubmissionError) Error() string {
+	var accepted int
+	msgs := make([]string, 0, len(e.Results))
+	for _, r := range e.Results {
+		if err := r.AsError(); err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", r.Endpoint, err))
+			continue
+		}
+		accepted++
+	}
+
+	if len(e.Results) == 1 && accepted == 0 {
+		return "orderer " + msgs[0]
+	}
+
+	return fmt.Sprintf("envelope accepted by %d of %d required orderer endpoints: %s",
+		accepted, e.Required, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of all endpoints that did not accept the envelope.
+func (e *SubmissionError) Unwrap() []error {
+	var errs []error
+	for _, r := range e.Results {
+		if err := r.AsError(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
 }
 
 // NewOrdererClient creates a new orderer client with the provided configuration and signing identity.
-// It establishes a gRPC connection with optional TLS and returns an error if connection fails.
+// It establishes a gRPC connection with optional TLS to every endpoint and returns an error if connection fails.
 func NewOrdererClient(cfg config.OrdererConfig) (*OrdererClient, error) {
-	conn, err := newClientConn(&cfg.EndpointServiceConfig)
-	if err != nil {
-		return nil, fmt.Errorf("cannot get grpc client: %w", err)
+	oc := &OrdererClient{cfg: cfg}
+
+	for _, epCfg := range cfg.EndpointConfigs() {
+		conn, err := newClientConn(&epCfg)
+		if err != nil {
+			_ = oc.Close()
+			return nil, fmt.Errorf("cannot get grpc client: %w", err)
+		}
+
+		oc.endpoints = append(oc.endpoints, &ordererEndpoint{
+			address: epCfg.Address,
+			client:  ab.NewAtomicBroadcastClient(conn),
+			closeF: func() {
+				_ = conn.Close()
+			},
+		})
 	}
 
-	return &OrdererClient{
-		cfg:    cfg,
-		client: ab.NewAtomicBroadcastClient(conn),
-		closeF: func() {
-			_ = conn.Close()
-		},
-	}, nil
+	return oc, nil
 }
 
-// Close terminates the gRPC connection to the ordering service.
+// Close terminates the broadcast sessions and the gRPC connections to the ordering service.
 func (oc *OrdererClient) Close() error {
-	if oc.closeF != nil {
-		oc.closeF()
+	for _, ep := range oc.endpoints {
+		ep.close()
 	}
 	return nil
 }
 
-// Broadcast sends the signed envelope to the ordering service.
-// It establishes a gRPC connection, sends the envelope, and waits for acknowledgment.
+// Broadcast sends the signed envelope to the ordering service and waits for acknowledgment.
+// Endpoints that did not accept the envelope are logged if the submission succeeded nevertheless.
 func (oc *OrdererClient) Broadcast(
 	ctx context.Context,
 	signer msp.SigningIdentity,
@@ -66,76 +142,314 @@ func (oc *OrdererClient) Broadcast(
 		return err
 	}
 
-	return oc.send(ctx, env)
+	results, err := oc.BroadcastEnvelope(ctx, env)
+	if err != nil {
+		return err
+	}
+	warnFailedEndpoints(txID, results)
+
+	return nil
+}
+
+// BroadcastBatch signs all transactions and pipelines them to the ordering service.
+// All envelopes are sent before the acknowledgements are collected. Returns one error per
+// transaction (nil on success), in input order.
+func (oc *OrdererClient) BroadcastBatch(
+	ctx context.Context,
+	signer msp.SigningIdentity,
+	txs []adapters.Transaction,
+) []error {
+	errs := make([]error, len(txs))
+
+	envs := make([]*cb.Envelope, 0, len(txs))
+	indexes := make([]int, 0, len(txs))
+	for i, t := range txs {
+		env, err := oc.createSignedEnvelope(signer, t.TxID, t.Tx)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		envs = append(envs, env)
+		indexes = append(indexes, i)
+	}
+
+	results, sendErrs := oc.BroadcastEnvelopes(ctx, envs)
+	for j, i := range indexes {
+		errs[i] = sendErrs[j]
+		if errs[i] == nil {
+			warnFailedEndpoints(txs[i].TxID, results[j])
+		}
+	}
+
+	return errs
 }
 
-// send transmits the envelope to the orderer and waits for acknowledgment.
-// Returns an error if the orderer rejects the transaction or communication fails.
-func (oc *OrdererClient) send(ctx context.Context, env *cb.Envelope) error {
-	if oc.client == nil {
-		return errors.New("require client")
+// BroadcastEnvelopeBatch pipelines pre-signed envelopes to the ordering service.
+// Returns one error per envelope (nil on success), in input order.
+func (oc *OrdererClient) BroadcastEnvelopeBatch(ctx context.Context, envs []adapters.SignedEnvelope) []error {
+	raw := make([]*cb.Envelope, len(envs))
+	for i, e := range envs {
+		raw[i] = e.Envelope
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	results, errs := oc.BroadcastEnvelopes(ctx, raw)
+	for i, err := range errs {
+		if err == nil {
+			warnFailedEndpoints(envs[i].TxID, results[i])
+		}
+	}
 
-	abc, err := oc.client.Broadcast(ctx)
-	if err != nil {
-		return err
+	return errs
+}
+
+// BroadcastEnvelope sends a signed envelope according to the configured submission strategy.
+// Returns the result of every endpoint the envelope was sent to and, if the envelope was not
+// accepted by the required number of endpoints, a SubmissionError.
+func (oc *OrdererClient) BroadcastEnvelope(ctx context.Context, env *cb.Envelope) ([]EndpointResult, error) {
+	results, errs := oc.BroadcastEnvelopes(ctx, []*cb.Envelope{env})
+	return results[0], errs[0]
+}
+
+// BroadcastEnvelopes pipelines the signed envelopes according to the configured submission strategy.
+// Returns the per-endpoint results and the error of every envelope, in input order.
+func (oc *OrdererClient) BroadcastEnvelopes(
+	ctx context.Context,
+	envs []*cb.Envelope,
+) ([][]EndpointResult, []error) {
+	if len(oc.endpoints) == 0 {
+		errs := make([]error, len(envs))
+		for i := range errs {
+			errs[i] = errors.New("require orderer endpoint")
+		}
+		return make([][]EndpointResult, len(envs)), errs
 	}
 
-	err = abc.Send(env)
-	if err != nil {
-		return err
+	if oc.cfg.Submission == config.SubmissionAll {
+		return oc.broadcastAll(ctx, envs)
 	}
+	return oc.broadcastFailover(ctx, envs)
+}
 
-	status, err := abc.Recv()
-	if err != nil {
-		return err
+// broadcastFailover sends the envelopes to the preferred endpoint. Envelopes that are not
+// accepted, including rejections that might come from a byzantine endpoint, are resent to
+// the next endpoint until all endpoints were tried.
+func (oc *OrdererClient) broadcastFailover(
+	ctx context.Context,
+	envs []*cb.Envelope,
+) ([][]EndpointResult, []error) {
+	results := make([][]EndpointResult, len(envs))
+
+	pending := make([]int, len(envs))
+	for i := range pending {
+		pending[i] = i
 	}
 
-	if status.GetStatus() != cb.Status_SUCCESS {
-		return fmt.Errorf("got error %#v", status.GetStatus())
+	start := int(oc.preferred.Load())
+	for k := 0; k < len(oc.endpoints) && len(pending) > 0 && ctx.Err() == nil; k++ {
+		idx := (start + k) % len(oc.endpoints)
+		ep := oc.endpoints[idx]
+
+		batch := make([]*cb.Envelope, len(pending))
+		for j, i := range pending {
+			batch[j] = envs[i]
+		}
+		acks := ep.send(batch)
+
+		var failed []int
+		for j, i := range pending {
+			res := ep.await(ctx, acks[j])
+			results[i] = append(results[i], res)
+			if res.AsError() != nil {
+				failed = append(failed, i)
+			}
+		}
+
+		if len(failed) < len(pending) {
+			oc.preferred.Store(int32(idx)) //nolint:gosec // number of endpoints is small
+		}
+		pending = failed
 	}
 
-	return nil
+	errs := make([]error, len(envs))
+	for _, i := range pending {
+		errs[i] = &SubmissionError{Required: 1, Results: results[i]}
+	}
+
+	return results, errs
 }
 
-// createSignedEnvelope wraps the transaction in a signed envelope for submission to the orderer.
-// The envelope contains the channel header, signature header, and transaction payload.
-func (oc *OrdererClient) createSignedEnvelope(
-	signer msp.SigningIdentity,
-	txID string,
-	tx *applicationpb.Tx,
-) (*cb.Envelope, error) {
-	if signer == nil {
-		return nil, errors.New("require Signer")
+// broadcastAll sends the envelopes to all endpoints concurrently and requires each envelope
+// to be accepted by f+1 endpoints.
+func (oc *OrdererClient) broadcastAll(ctx context.Context, envs []*cb.Envelope) ([][]EndpointResult, []error) {
+	acks := make([][]<-chan BroadcastResult, len(oc.endpoints))
+
+	var wg sync.WaitGroup
+	for j, ep := range oc.endpoints {
+		wg.Go(func() {
+			acks[j] = ep.send(envs)
+		})
 	}
+	wg.Wait()
 
-	signatureHdr := protoutil.NewSignatureHeaderOrPanic(signer)
+	required := oc.requiredAcks()
+	results := make([][]EndpointResult, len(envs))
+	errs := make([]error, len(envs))
+	for i := range envs {
+		envAcks := make([]<-chan BroadcastResult, len(oc.endpoints))
+		for j := range oc.endpoints {
+			envAcks[j] = acks[j][i]
+		}
+		results[i], errs[i] = oc.awaitQuorum(ctx, required, envAcks)
+	}
 
-	// prepare transaction submission
-	// create signed envelope
-	channelHdr := protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, oc.cfg.Channel, 0)
-	channelHdr.TxId = txID
+	return results, errs
+}
 
-	payloadHdr := protoutil.MakePayloadHeader(channelHdr, signatureHdr)
-	txBytes := protoutil.MarshalOrPanic(tx)
+// awaitQuorum waits until the required number of endpoints accepted the envelope, or until
+// this can no longer happen. Endpoints that did not respond until then report errNoResponse,
+// so a single unresponsive endpoint does not block the submission.
+func (oc *OrdererClient) awaitQuorum(
+	ctx context.Context,
+	required int,
+	acks []<-chan BroadcastResult,
+) ([]EndpointResult, error) {
+	type endpointAck struct {
+		idx int
+		res BroadcastResult
+	}
+
+	received := make(chan endpointAck, len(acks))
+	results := make([]EndpointResult, len(acks))
+	for j, ack := range acks {
+		results[j] = EndpointResult{Endpoint: oc.endpoints[j].address, BroadcastResult: BroadcastResult{Err: errNoResponse}}
+		go func() {
+			received <- endpointAck{idx: j, res: <-ack}
+		}()
+	}
 
-	payloadBytes := protoutil.MarshalOrPanic(
-		&cb.Payload{
-			Header: payloadHdr,
-			Data:   txBytes,
-		},
-	)
+	var accepted, rejected int
+	for accepted < required && len(acks)-rejected >= required {
+		select {
+		case <-ctx.Done():
+			return results, ctx.Err()
+		case a := <-received:
+			results[a.idx].BroadcastResult = a.res
+			if a.res.AsError() != nil {
+				rejected++
+				continue
+			}
+			accepted++
+		}
+	}
+
+	if accepted < required {
+		return results, &SubmissionError{Required: required, Results: results}
+	}
+	return results, nil
+}
+
+// requiredAcks returns the number of endpoints that must accept an envelope sent to all endpoints.
+// With n = 3f+1 endpoints, at most f of them are assumed to be faulty, hence f+1 acknowledgements
+// guarantee that at least one correct endpoint accepted the envelope.
+func (oc *OrdererClient) requiredAcks() int {
+	return (len(oc.endpoints)-1)/3 + 1
+}
+
+// warnFailedEndpoints logs the endpoints that did not accept an envelope that was submitted successfully.
+func warnFailedEndpoints(txID string, results []EndpointResult) {
+	for _, r := range results {
+		if err := r.AsError(); err != nil {
+			logger.Warnf("Orderer endpoint %s did not accept transaction %s: %s", r.Endpoint, txID, err)
+		}
+	}
+}
+
+// send pipelines the envelopes over the broadcast session and returns one acknowledgement per envelope.
+// Envelopes that cannot be sent are acknowledged with the error right away.
+func (ep *ordererEndpoint) send(envs []*cb.Envelope) []<-chan BroadcastResult {
+	acks := make([]<-chan BroadcastResult, len(envs))
+
+	session, err := ep.getSession()
+	for i, env := range envs {
+		if err != nil {
+			acks[i] = failedAck(err)
+			continue
+		}
+
+		ack, sendErr := session.Send(env)
+		if sendErr != nil {
+			ack = failedAck(sendErr)
+		}
+		acks[i] = ack
+	}
 
-	sig, err := signer.Sign(payloadBytes)
+	return acks
+}
+
+// await waits for the acknowledgement of an envelope sent to this endpoint.
+func (ep *ordererEndpoint) await(ctx context.Context, ack <-chan BroadcastResult) EndpointResult {
+	select {
+	case <-ctx.Done():
+		return EndpointResult{Endpoint: ep.address, BroadcastResult: BroadcastResult{Err: ctx.Err()}}
+	case res := <-ack:
+		return EndpointResult{Endpoint: ep.address, BroadcastResult: res}
+	}
+}
+
+// getSession returns the current broadcast session, opening a new one if there is
+// none yet or the previous one has failed.
+func (ep *ordererEndpoint) getSession() (*BroadcastSession, error) {
+	if ep.client == nil {
+		return nil, errors.New("require client")
+	}
+
+	ep.sessionMu.Lock()
+	defer ep.sessionMu.Unlock()
+
+	if ep.session != nil && ep.session.Err() == nil {
+		return ep.session, nil
+	}
+
+	if ep.session != nil {
+		_ = ep.session.Close()
+		ep.session = nil
+	}
+
+	session, err := NewBroadcastSession(ep.client)
 	if err != nil {
 		return nil, err
 	}
+	ep.session = session
+
+	return session, nil
+}
+
+// close terminates the broadcast session and the gRPC connection to the endpoint.
+func (ep *ordererEndpoint) close() {
+	ep.sessionMu.Lock()
+	if ep.session != nil {
+		_ = ep.session.Close()
+		ep.session = nil
+	}
+	ep.sessionMu.Unlock()
 
-	return &cb.Envelope{
-		Payload:   payloadBytes,
-		Signature: sig,
-	}, nil
+	if ep.closeF != nil {
+		ep.closeF()
+	}
+}
+
+// failedAck returns an acknowledgement that reports err.
+func failedAck(err error) <-chan BroadcastResult {
+	ack := make(chan BroadcastResult, 1)
+	ack <- BroadcastResult{Err: err}
+	return ack
+}
+
+// createSignedEnvelope wraps the transaction in a signed envelope for the channel of the orderer.
+func (oc *OrdererClient) createSignedEnvelope(
+	signer msp.SigningIdentity,
+	txID string,
+	tx *applicationpb.Tx,
+) (*cb.Envelope, error) {
+	return transaction.CreateSignedEnvelope(signer, oc.cfg.Channel, txID, tx)
 }
@@ -9,6 +9,8 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/adapters"
 	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
 )
 
@@ -86,16 +89,21 @@ func (*testSigningIdentity) GetMSPIdentifier() string                       { re
 func (*testSigningIdentity) Validate() error                                { return nil }
 func (*testSigningIdentity) SatisfiesPrincipal(_ *msppb.MSPPrincipal) error { return nil }
 
-func newTestOrdererClient(mock ab.AtomicBroadcastClient) *OrdererClient {
-	return &OrdererClient{
+// newTestOrdererClient returns an orderer client with one endpoint per mock,
+// named orderer0, orderer1, and so on.
+func newTestOrdererClient(mocks ...ab.AtomicBroadcastClient) *OrdererClient {
+	oc := &OrdererClient{
 		cfg: config.OrdererConfig{
 			EndpointServiceConfig: config.EndpointServiceConfig{
 				ConnectionTimeout: time.Second,
 			},
 			Channel: "mychannel",
 		},
-		client: mock,
 	}
+	for i, mock := range mocks {
+		oc.endpoints = append(oc.endpoints, &ordererEndpoint{address: fmt.Sprintf("orderer%d", i), client: mock})
+	}
+	return oc
 }
 
 func someBroadcastTx() *applicationpb.Tx {
@@ -184,10 +192,13 @@ func TestOrdererClient_Broadcast_Success(t *testing.T) {
 func TestOrdererClient_Close_CallsCloseFunc(t *testing.T) {
 	t.Parallel()
 
-	closed := false
-	oc := &OrdererClient{closeF: func() { closed = true }}
+	closed := 0
+	oc := &OrdererClient{endpoints: []*ordererEndpoint{
+		{closeF: func() { closed++ }},
+		{closeF: func() { closed++ }},
+	}}
 	require.NoError(t, oc.Close())
-	require.True(t, closed)
+	require.Equal(t, 2, closed)
 }
 
 func TestOrdererClient_Close_NilFunc(t *testing.T) {
@@ -196,3 +207,296 @@ func TestOrdererClient_Close_NilFunc(t *testing.T) {
 	oc := &OrdererClient{}
 	require.NoError(t, oc.Close())
 }
+
+// batchBroadcastStream acknowledges envelopes in order with the configured responses.
+// Once the responses are exhausted, Recv reports a closed stream.
+type batchBroadcastStream struct {
+	mockBroadcastStream
+	responses []*ab.BroadcastResponse
+
+	mu       sync.Mutex
+	sent     int
+	received int
+}
+
+func (m *batchBroadcastStream) Send(_ *cb.Envelope) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent++
+	return m.sendErr
+}
+
+func (m *batchBroadcastStream) Recv() (*ab.BroadcastResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.received >= len(m.responses) {
+		return nil, errors.New("stream closed")
+	}
+	m.received++
+	return m.responses[m.received-1], nil
+}
+
+func (m *batchBroadcastStream) sentCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sent
+}
+
+func someBroadcastBatch() []adapters.Transaction {
+	return []adapters.Transaction{
+		{TxID: "tx-1", Tx: someBroadcastTx()},
+		{TxID: "tx-2", Tx: someBroadcastTx()},
+		{TxID: "tx-3", Tx: someBroadcastTx()},
+	}
+}
+
+func TestOrdererClient_BroadcastBatch_NilClient(t *testing.T) {
+	t.Parallel()
+
+	oc := &OrdererClient{}
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	require.Len(t, errs, 3)
+	for _, err := range errs {
+		require.Error(t, err)
+	}
+}
+
+func TestOrdererClient_BroadcastBatch_SingleStream(t *testing.T) {
+	t.Parallel()
+
+	stream := &batchBroadcastStream{responses: []*ab.BroadcastResponse{
+		{Status: cb.Status_SUCCESS},
+		{Status: cb.Status_BAD_REQUEST},
+		{Status: cb.Status_SUCCESS},
+	}}
+	oc := newTestOrdererClient(&mockAtomicBroadcastClient{stream: stream})
+
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	require.NoError(t, errs[2])
+	require.Equal(t, 3, stream.sentCount())
+}
+
+func TestOrdererClient_BroadcastBatch_StreamBreaks(t *testing.T) {
+	t.Parallel()
+
+	stream := &batchBroadcastStream{responses: []*ab.BroadcastResponse{{Status: cb.Status_SUCCESS}}}
+	oc := newTestOrdererClient(&mockAtomicBroadcastClient{stream: stream})
+
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	require.NoError(t, errs[0])
+	require.ErrorContains(t, errs[1], "stream closed")
+	require.ErrorContains(t, errs[2], "stream closed")
+}
+
+func TestOrdererClient_BroadcastEnvelopeBatch(t *testing.T) {
+	t.Parallel()
+
+	stream := &batchBroadcastStream{responses: []*ab.BroadcastResponse{
+		{Status: cb.Status_SUCCESS},
+		{Status: cb.Status_BAD_REQUEST},
+	}}
+	oc := newTestOrdererClient(&mockAtomicBroadcastClient{stream: stream})
+
+	envs := []adapters.SignedEnvelope{
+		{TxID: "tx-1", Envelope: someSignedEnvelope(t, oc)},
+		{TxID: "tx-2", Envelope: someSignedEnvelope(t, oc)},
+	}
+	errs := oc.BroadcastEnvelopeBatch(t.Context(), envs)
+	require.Len(t, errs, 2)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	require.Equal(t, 2, stream.sentCount())
+}
+
+// hangingBroadcastStream never acknowledges an envelope until released.
+type hangingBroadcastStream struct {
+	mockBroadcastStream
+	release chan struct{}
+}
+
+func (m *hangingBroadcastStream) Recv() (*ab.BroadcastResponse, error) {
+	<-m.release
+	return nil, errors.New("released")
+}
+
+func rejectingBroadcastClient(info string) *mockAtomicBroadcastClient {
+	return &mockAtomicBroadcastClient{stream: &mockBroadcastStream{
+		recvResp: &ab.BroadcastResponse{Status: cb.Status_BAD_REQUEST, Info: info},
+	}}
+}
+
+func someSignedEnvelope(t *testing.T, oc *OrdererClient) *cb.Envelope {
+	t.Helper()
+
+	env, err := oc.createSignedEnvelope(&testSigningIdentity{}, "tx-1", someBroadcastTx())
+	require.NoError(t, err)
+	return env
+}
+
+func TestOrdererClient_Failover(t *testing.T) {
+	t.Parallel()
+
+	first := &countingBroadcastClient{AtomicBroadcastClient: &mockAtomicBroadcastClient{
+		broadcastErr: errors.New("router down"),
+	}}
+	second := &countingBroadcastClient{AtomicBroadcastClient: startFakeOrderer(t)}
+	oc := newTestOrdererClient(first, second)
+	t.Cleanup(func() {
+		_ = oc.Close()
+	})
+
+	results, err := oc.BroadcastEnvelope(t.Context(), someSignedEnvelope(t, oc))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "orderer0", results[0].Endpoint)
+	require.ErrorContains(t, results[0].AsError(), "router down")
+	require.Equal(t, "orderer1", results[1].Endpoint)
+	require.NoError(t, results[1].AsError())
+
+	// the endpoint that accepted the envelope is tried first afterwards
+	results, err = oc.BroadcastEnvelope(t.Context(), someSignedEnvelope(t, oc))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "orderer1", results[0].Endpoint)
+	require.Equal(t, 1, first.openedCount())
+	require.Equal(t, 1, second.openedCount())
+}
+
+func TestOrdererClient_Failover_AllEndpointsFail(t *testing.T) {
+	t.Parallel()
+
+	oc := newTestOrdererClient(
+		&mockAtomicBroadcastClient{broadcastErr: errors.New("router down")},
+		rejectingBroadcastClient("bad signature"),
+	)
+
+	err := oc.Broadcast(t.Context(), &testSigningIdentity{}, "tx-1", someBroadcastTx())
+
+	var submissionErr *SubmissionError
+	require.ErrorAs(t, err, &submissionErr)
+	require.Equal(t, 1, submissionErr.Required)
+	require.Len(t, submissionErr.Results, 2)
+
+	var statusErr *BroadcastStatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, "bad signature", statusErr.Info)
+
+	require.EqualError(t, err, "envelope accepted by 0 of 1 required orderer endpoints: "+
+		"orderer0: router down; orderer1: orderer returned status BAD_REQUEST: bad signature")
+}
+
+func TestOrdererClient_Failover_Batch(t *testing.T) {
+	t.Parallel()
+
+	stream := &batchBroadcastStream{responses: []*ab.BroadcastResponse{
+		{Status: cb.Status_SUCCESS},
+		{Status: cb.Status_SERVICE_UNAVAILABLE},
+		{Status: cb.Status_SUCCESS},
+	}}
+	oc := newTestOrdererClient(&mockAtomicBroadcastClient{stream: stream}, startFakeOrderer(t))
+	t.Cleanup(func() {
+		_ = oc.Close()
+	})
+
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	require.Len(t, errs, 3)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, stream.sentCount())
+}
+
+func TestOrdererClient_SubmitToAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		endpoints   func(t *testing.T) []ab.AtomicBroadcastClient
+		expectError string
+	}{
+		{
+			name: "all endpoints accept",
+			endpoints: func(t *testing.T) []ab.AtomicBroadcastClient {
+				t.Helper()
+				return []ab.AtomicBroadcastClient{startFakeOrderer(t), startFakeOrderer(t), startFakeOrderer(t)}
+			},
+		},
+		{
+			name: "f+1 of 3f+1 endpoints accept",
+			endpoints: func(t *testing.T) []ab.AtomicBroadcastClient {
+				t.Helper()
+				return []ab.AtomicBroadcastClient{
+					rejectingBroadcastClient("byzantine"),
+					startFakeOrderer(t),
+					&mockAtomicBroadcastClient{broadcastErr: errors.New("router down")},
+					startFakeOrderer(t),
+				}
+			},
+		},
+		{
+			name: "only f of 3f+1 endpoints accept",
+			endpoints: func(t *testing.T) []ab.AtomicBroadcastClient {
+				t.Helper()
+				return []ab.AtomicBroadcastClient{
+					rejectingBroadcastClient("byzantine"),
+					startFakeOrderer(t),
+					&mockAtomicBroadcastClient{broadcastErr: errors.New("router down")},
+					rejectingBroadcastClient("byzantine"),
+				}
+			},
+			expectError: "of 2 required orderer endpoints",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			oc := newTestOrdererClient(tt.endpoints(t)...)
+			oc.cfg.Submission = config.SubmissionAll
+			t.Cleanup(func() {
+				_ = oc.Close()
+			})
+
+			results, err := oc.BroadcastEnvelope(t.Context(), someSignedEnvelope(t, oc))
+			require.Len(t, results, len(oc.endpoints))
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOrdererClient_SubmitToAll_DoesNotWaitForUnresponsiveEndpoint(t *testing.T) {
+	t.Parallel()
+
+	hanging := &hangingBroadcastStream{release: make(chan struct{})}
+	defer close(hanging.release)
+
+	oc := newTestOrdererClient(
+		startFakeOrderer(t),
+		&mockAtomicBroadcastClient{stream: hanging},
+		startFakeOrderer(t),
+		startFakeOrderer(t),
+	)
+	oc.cfg.Submission = config.SubmissionAll
+
+	errs := oc.BroadcastBatch(t.Context(), &testSigningIdentity{}, someBroadcastBatch())
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestOrdererClient_RequiredAcks(t *testing.T) {
+	t.Parallel()
+
+	for n, expected := range map[int]int{1: 1, 2: 1, 3: 1, 4: 2, 6: 2, 7: 3, 10: 4} {
+		oc := &OrdererClient{endpoints: make([]*ordererEndpoint, n)}
+		require.Equal(t, expected, oc.requiredAcks(), "endpoints: %d", n)
+	}
+}
@@ -60,6 +60,61 @@ func (qc *QueryClient) GetNamespacePolicies(ctx context.Context) (*applicationpb
 	return res, nil
 }
 
+// GetNamespacePolicy retrieves the policy of a single namespace from the meta-namespace.
+// Returns nil if the namespace does not exist. The request is bounded by the configured connection timeout.
+func (qc *QueryClient) GetNamespacePolicy(ctx context.Context, nsID string) (*applicationpb.PolicyItem, error) {
+	if qc.client == nil {
+		return nil, errors.New("require client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, qc.cfg.ConnectionTimeout)
+	defer cancel()
+
+	res, err := qc.client.GetRows(ctx, &committerpb.Query{
+		Namespaces: []*committerpb.QueryNamespace{{
+			NsId: committerpb.MetaNamespaceID,
+			Keys: [][]byte{[]byte(nsID)},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getRows error: %w", err)
+	}
+
+	for _, ns := range res.GetNamespaces() {
+		for _, row := range ns.GetRows() {
+			if string(row.GetKey()) != nsID {
+				continue
+			}
+			return &applicationpb.PolicyItem{
+				Namespace: nsID,
+				Policy:    row.GetValue(),
+				Version:   row.GetVersion(),
+			}, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil // namespace does not exist
+}
+
+// GetTransactionStatus retrieves the final status of committed transactions.
+// Transactions that are not committed (yet) are omitted from the result.
+// The request is bounded by the configured connection timeout.
+func (qc *QueryClient) GetTransactionStatus(ctx context.Context, txIDs []string) ([]*committerpb.TxStatus, error) {
+	if qc.client == nil {
+		return nil, errors.New("require client")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, qc.cfg.ConnectionTimeout)
+	defer cancel()
+
+	res, err := qc.client.GetTransactionStatus(ctx, &committerpb.TxStatusQuery{TxIds: txIDs})
+	if err != nil {
+		return nil, fmt.Errorf("getTransactionStatus error: %w", err)
+	}
+
+	return res.GetStatuses(), nil
+}
+
 // Close terminates the gRPC connection to the query service.
 func (qc *QueryClient) Close() error {
 	if qc.closeF != nil {
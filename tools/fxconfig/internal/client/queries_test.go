@@ -24,6 +24,10 @@ import (
 // mockQueryServiceClient implements committerpb.QueryServiceClient for testing.
 type mockQueryServiceClient struct {
 	policies *applicationpb.NamespacePolicies
+	rows     *committerpb.Rows
+	query    *committerpb.Query
+	statuses *committerpb.TxStatusResponse
+	txQuery  *committerpb.TxStatusQuery
 	err      error
 }
 
@@ -35,12 +39,13 @@ func (m *mockQueryServiceClient) GetNamespacePolicies(
 	return m.policies, m.err
 }
 
-func (*mockQueryServiceClient) GetRows(
+func (m *mockQueryServiceClient) GetRows(
 	_ context.Context,
-	_ *committerpb.Query,
+	query *committerpb.Query,
 	_ ...grpc.CallOption,
 ) (*committerpb.Rows, error) {
-	return nil, nil
+	m.query = query
+	return m.rows, m.err
 }
 
 func (*mockQueryServiceClient) BeginView(
@@ -67,12 +72,13 @@ func (*mockQueryServiceClient) GetConfigTransaction(
 	return nil, nil
 }
 
-func (*mockQueryServiceClient) GetTransactionStatus(
+func (m *mockQueryServiceClient) GetTransactionStatus(
 	_ context.Context,
-	_ *committerpb.TxStatusQuery,
+	query *committerpb.TxStatusQuery,
 	_ ...grpc.CallOption,
 ) (*committerpb.TxStatusResponse, error) {
-	return nil, nil
+	m.txQuery = query
+	return m.statuses, m.err
 }
 
 func newTestQueryClient(mock committerpb.QueryServiceClient) *QueryClient {
@@ -112,6 +118,90 @@ func TestQueryClient_GetNamespacePolicies_Success(t *testing.T) {
 	require.Equal(t, expected, result)
 }
 
+func TestQueryClient_GetNamespacePolicy_NilClient(t *testing.T) {
+	t.Parallel()
+
+	qc := &QueryClient{cfg: config.QueriesConfig{}}
+	_, err := qc.GetNamespacePolicy(t.Context(), "ns1")
+	require.Error(t, err)
+}
+
+func TestQueryClient_GetNamespacePolicy_Error(t *testing.T) {
+	t.Parallel()
+
+	qc := newTestQueryClient(&mockQueryServiceClient{err: errors.New("rpc error")})
+	_, err := qc.GetNamespacePolicy(t.Context(), "ns1")
+	require.ErrorContains(t, err, "rpc error")
+}
+
+func TestQueryClient_GetNamespacePolicy_Success(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockQueryServiceClient{rows: &committerpb.Rows{
+		Namespaces: []*committerpb.RowsNamespace{{
+			NsId: committerpb.MetaNamespaceID,
+			Rows: []*committerpb.Row{{Key: []byte("ns1"), Value: []byte("policy"), Version: 3}},
+		}},
+	}}
+	qc := newTestQueryClient(mock)
+
+	result, err := qc.GetNamespacePolicy(t.Context(), "ns1")
+	require.NoError(t, err)
+	require.Equal(t, "ns1", result.GetNamespace())
+	require.Equal(t, []byte("policy"), result.GetPolicy())
+	require.Equal(t, uint64(3), result.GetVersion())
+
+	require.Len(t, mock.query.GetNamespaces(), 1)
+	require.Equal(t, committerpb.MetaNamespaceID, mock.query.GetNamespaces()[0].GetNsId())
+	require.Equal(t, [][]byte{[]byte("ns1")}, mock.query.GetNamespaces()[0].GetKeys())
+}
+
+func TestQueryClient_GetNamespacePolicy_NotFound(t *testing.T) {
+	t.Parallel()
+
+	qc := newTestQueryClient(&mockQueryServiceClient{rows: &committerpb.Rows{
+		Namespaces: []*committerpb.RowsNamespace{{NsId: committerpb.MetaNamespaceID}},
+	}})
+
+	result, err := qc.GetNamespacePolicy(t.Context(), "ns1")
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestQueryClient_GetTransactionStatus_NilClient(t *testing.T) {
+	t.Parallel()
+
+	qc := &QueryClient{cfg: config.QueriesConfig{}}
+	_, err := qc.GetTransactionStatus(t.Context(), []string{"tx1"})
+	require.Error(t, err)
+}
+
+func TestQueryClient_GetTransactionStatus_Error(t *testing.T) {
+	t.Parallel()
+
+	qc := newTestQueryClient(&mockQueryServiceClient{err: errors.New("rpc error")})
+	_, err := qc.GetTransactionStatus(t.Context(), []string{"tx1"})
+	require.ErrorContains(t, err, "rpc error")
+}
+
+func TestQueryClient_GetTransactionStatus_Success(t *testing.T) {
+	t.Parallel()
+
+	status := &committerpb.TxStatus{
+		Ref:    &committerpb.TxRef{TxId: "tx1", BlockNum: 5, TxNum: 2},
+		Status: committerpb.Status_COMMITTED,
+	}
+	mock := &mockQueryServiceClient{statuses: &committerpb.TxStatusResponse{
+		Statuses: []*committerpb.TxStatus{status},
+	}}
+	qc := newTestQueryClient(mock)
+
+	result, err := qc.GetTransactionStatus(t.Context(), []string{"tx1", "tx2"})
+	require.NoError(t, err)
+	require.Equal(t, []*committerpb.TxStatus{status}, result)
+	require.Equal(t, []string{"tx1", "tx2"}, mock.txQuery.GetTxIds())
+}
+
 func TestQueryClient_Close_CallsCloseFunc(t *testing.T) {
 	t.Parallel()
 
@@ -24,6 +24,7 @@ type Config struct {
 	Orderer       OrdererConfig       `mapstructure:"orderer" yaml:"orderer,omitempty"`
 	Queries       QueriesConfig       `mapstructure:"queries" yaml:"queries,omitempty"`
 	Notifications NotificationsConfig `mapstructure:"notifications" yaml:"notifications,omitempty"`
+	PolicyLint    PolicyLintConfig    `mapstructure:"policyLint" yaml:"policyLint,omitempty"`
 }
 
 // ResolveTLS applies TLS configuration inheritance across all services.
@@ -35,6 +36,11 @@ func (c *Config) ResolveTLS() {
 	c.Orderer.TLS = c.Orderer.TLS.InheritFrom(&c.TLS)
 	c.Orderer.TLS.Normalize()
 
+	for i := range c.Orderer.Endpoints {
+		c.Orderer.Endpoints[i].TLS = c.Orderer.Endpoints[i].TLS.InheritFrom(c.Orderer.TLS)
+		c.Orderer.Endpoints[i].TLS.Normalize()
+	}
+
 	c.Queries.TLS = c.Queries.TLS.InheritFrom(&c.TLS)
 	c.Queries.TLS.Normalize()
 
@@ -42,6 +48,20 @@ func (c *Config) ResolveTLS() {
 	c.Notifications.TLS.Normalize()
 }
 
+// PolicyLintConfig enables checking MSP policies against the MSPs of the channel. Policies that
+// can never be satisfied are rejected; unknown principals are reported as warnings.
+//
+//nolint:revive,lll
+type PolicyLintConfig struct {
+	ConfigBlock string `mapstructure:"configBlock" yaml:"configBlock,omitempty" desc:"Path to a channel config block; enables policy linting if set"`
+	Strict      bool   `mapstructure:"strict" yaml:"strict,omitempty" desc:"Reject policies with warnings, e.g., an unknown MSP in an OR" default:"false"`
+}
+
+// Enabled returns whether policies are checked against the MSPs of the channel.
+func (c *PolicyLintConfig) Enabled() bool {
+	return c.ConfigBlock != ""
+}
+
 // LoggingConfig controls logging behavior.
 type LoggingConfig struct {
 	Level  string `mapstructure:"level" yaml:"level,omitempty" desc:"Logging level" default:"error"`
@@ -51,8 +71,66 @@ type LoggingConfig struct {
 // MSPConfig contains MSP (Membership Service Provider) identity configuration.
 // It specifies which organization identity to use for signing transactions.
 type MSPConfig struct {
-	LocalMspID string `mapstructure:"localMspID" yaml:"localMspID,omitempty" desc:"MSP ID of the organization"`
-	ConfigPath string `mapstructure:"configPath" yaml:"configPath,omitempty" desc:"Path to MSP configuration directory"`
+	LocalMspID string      `mapstructure:"localMspID" yaml:"localMspID,omitempty" desc:"MSP ID of the organization"`
+	ConfigPath string      `mapstructure:"configPath" yaml:"configPath,omitempty" desc:"Path to MSP configuration directory"`
+	BCCSP      BCCSPConfig `mapstructure:"bccsp" yaml:"bccsp,omitempty"`
+	// ExternalSigner, if a command is set, produces the signatures of the MSP identity instead of the BCCSP.
+	ExternalSigner ExternalSignerConfig `mapstructure:"externalSigner" yaml:"externalSigner,omitempty"`
+}
+
+// ExternalSignerConfig configures an executable that signs on behalf of the MSP identity, e.g., by
+// calling a remote signing service or a KMS. The signing certificate is read from the MSP
+// configuration directory; the signer only needs access to the private key.
+//
+//nolint:revive,lll
+type ExternalSignerConfig struct {
+	Command string        `mapstructure:"command" yaml:"command,omitempty" desc:"Signer executable; enables external signing if set"`
+	Args    []string      `mapstructure:"args" yaml:"args,omitempty" desc:"Arguments passed to the signer executable"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty" desc:"Time to wait for a signature" default:"30s"`
+}
+
+// Enabled returns whether signatures are produced by an external signer.
+func (c *ExternalSignerConfig) Enabled() bool {
+	return c.Command != ""
+}
+
+// Crypto service providers of the MSP signing key.
+const (
+	// BCCSPSoftware reads the signing key from the keystore directory of the MSP.
+	BCCSPSoftware = "SW"
+	// BCCSPPKCS11 uses a signing key held by a PKCS#11 token, e.g., an HSM.
+	BCCSPPKCS11 = "PKCS11"
+)
+
+// BCCSPConfig selects the crypto service provider (BCCSP) that holds the signing key of the MSP
+// identity. The signing certificate is always read from the MSP configuration directory.
+//
+//nolint:revive,lll
+type BCCSPConfig struct {
+	Default string       `mapstructure:"default" yaml:"default,omitempty" desc:"Crypto service provider (SW|PKCS11)" default:"SW"`
+	SW      SWConfig     `mapstructure:"sw" yaml:"sw,omitempty"`
+	PKCS11  PKCS11Config `mapstructure:"pkcs11" yaml:"pkcs11,omitempty"`
+}
+
+// SWConfig configures the software crypto service provider.
+//
+//nolint:revive,lll
+type SWConfig struct {
+	Hash     string `mapstructure:"hash" yaml:"hash,omitempty" desc:"Hash family (SHA2|SHA3)" default:"SHA2"`
+	Security int    `mapstructure:"security" yaml:"security,omitempty" desc:"Security level in bits (256|384)" default:"256"`
+	KeyStore string `mapstructure:"keyStore" yaml:"keyStore,omitempty" desc:"Path to the key store directory (default is <configPath>/keystore)"`
+}
+
+// PKCS11Config configures the PKCS#11 crypto service provider.
+// The signing key is looked up on the token by the subject key identifier of the signing certificate.
+//
+//nolint:revive,lll
+type PKCS11Config struct {
+	Library  string `mapstructure:"library" yaml:"library,omitempty" desc:"Path to the PKCS#11 library"`
+	Label    string `mapstructure:"label" yaml:"label,omitempty" desc:"Label of the token holding the signing key"`
+	Pin      string `mapstructure:"pin" yaml:"pin,omitempty" desc:"User PIN of the token"`
+	Hash     string `mapstructure:"hash" yaml:"hash,omitempty" desc:"Hash family (SHA2|SHA3)" default:"SHA2"`
+	Security int    `mapstructure:"security" yaml:"security,omitempty" desc:"Security level in bits (256|384)" default:"256"`
 }
 
 // TLSConfig specifies TLS settings for secure communication.
@@ -111,12 +189,50 @@ func (c *TLSConfig) IsEnabled() bool {
 	return *c.Enabled
 }
 
-// OrdererConfig contains configuration for the ordering service endpoint.
+// Submission strategies for an ordering service with multiple endpoints.
+const (
+	// SubmissionFailover sends each envelope to one endpoint and fails over to the next one on error.
+	SubmissionFailover = "failover"
+	// SubmissionAll sends each envelope to all endpoints and requires f+1 of them to accept it.
+	SubmissionAll = "all"
+)
+
+// OrdererConfig contains configuration for the ordering service endpoints.
+// Either a single address or a list of endpoints (e.g., the routers of an Arma deployment)
+// can be configured. Each endpoint inherits the orderer TLS section unless it provides overrides.
 //
 //nolint:revive,lll
 type OrdererConfig struct {
 	EndpointServiceConfig `mapstructure:",squash" yaml:",inline"`
-	Channel               string `mapstructure:"channel" yaml:"channel,omitempty" desc:"Orderer channel name" default:"mychannel"`
+	Endpoints             []OrdererEndpointConfig `mapstructure:"endpoints" yaml:"endpoints,omitempty" desc:"Orderer endpoints; used instead of address"`
+	Submission            string                  `mapstructure:"submission" yaml:"submission,omitempty" desc:"Submission strategy for multiple endpoints (failover|all)" default:"failover"`
+	Channel               string                  `mapstructure:"channel" yaml:"channel,omitempty" desc:"Orderer channel name" default:"mychannel"`
+}
+
+// OrdererEndpointConfig defines a single orderer endpoint.
+//
+//nolint:revive,lll
+type OrdererEndpointConfig struct {
+	Address string     `mapstructure:"address" yaml:"address,omitempty" desc:"Endpoint address (host:port)"`
+	TLS     *TLSConfig `mapstructure:"tls" yaml:"tls,omitempty" desc:"(Optional) Overrides orderer TLS section"`
+}
+
+// EndpointConfigs returns the connection settings of every configured orderer endpoint.
+// If no endpoints are listed, the orderer address is the only endpoint.
+func (c *OrdererConfig) EndpointConfigs() []EndpointServiceConfig {
+	if len(c.Endpoints) == 0 {
+		return []EndpointServiceConfig{c.EndpointServiceConfig}
+	}
+
+	cfgs := make([]EndpointServiceConfig, len(c.Endpoints))
+	for i, ep := range c.Endpoints {
+		cfgs[i] = EndpointServiceConfig{
+			Address:           ep.Address,
+			ConnectionTimeout: c.ConnectionTimeout,
+			TLS:               ep.TLS,
+		}
+	}
+	return cfgs
 }
 
 // QueriesConfig contains configuration for the query service endpoint.
@@ -125,12 +241,15 @@ type QueriesConfig struct {
 }
 
 // NotificationsConfig contains configuration for the notifications service endpoint.
-// Includes a waiting timeout for notification processing operations.
+// Includes a waiting timeout for notification processing operations and the retry
+// budget used to re-establish a broken notification stream.
 //
 //nolint:revive,lll
 type NotificationsConfig struct {
 	EndpointServiceConfig `mapstructure:",squash" yaml:",inline"`
 	WaitingTimeout        time.Duration `mapstructure:"waitingTimeout" yaml:"waitingTimeout,omitempty" desc:"Time to wait for notification processing" default:"30s"`
+	ReconnectAttempts     int           `mapstructure:"reconnectAttempts" yaml:"reconnectAttempts,omitempty" desc:"Number of consecutive attempts to re-open a failed notification stream (0 disables reconnection)" default:"5"`
+	ReconnectBackoff      time.Duration `mapstructure:"reconnectBackoff" yaml:"reconnectBackoff,omitempty" desc:"Initial delay between reconnection attempts; doubled after each failed attempt" default:"1s"`
 }
 
 // EndpointServiceConfig defines connection settings for a Fabric-X service.
@@ -8,6 +8,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -196,3 +197,65 @@ func TestConfig_ResolveTLS_ServiceOverrides(t *testing.T) {
 	require.Equal(t, []string{"parent-ca.pem"}, cfg.Queries.TLS.RootCertPaths)
 	require.Equal(t, []string{"parent-ca.pem"}, cfg.Notifications.TLS.RootCertPaths)
 }
+
+func TestConfig_ResolveTLS_OrdererEndpoints(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{
+		TLS: TLSConfig{
+			Enabled:       boolPtr(true),
+			RootCertPaths: []string{"parent-ca.pem"},
+		},
+		Orderer: OrdererConfig{
+			EndpointServiceConfig: EndpointServiceConfig{
+				TLS: &TLSConfig{RootCertPaths: []string{"orderer-ca.pem"}},
+			},
+			Endpoints: []OrdererEndpointConfig{
+				{Address: "router1:7050"},
+				{Address: "router2:7050", TLS: &TLSConfig{ServerNameOverride: "router2.example.com"}},
+			},
+		},
+	}
+
+	cfg.ResolveTLS()
+
+	require.True(t, cfg.Orderer.Endpoints[0].TLS.IsEnabled())
+	require.Equal(t, []string{"orderer-ca.pem"}, cfg.Orderer.Endpoints[0].TLS.RootCertPaths)
+
+	require.True(t, cfg.Orderer.Endpoints[1].TLS.IsEnabled())
+	require.Equal(t, []string{"orderer-ca.pem"}, cfg.Orderer.Endpoints[1].TLS.RootCertPaths)
+	require.Equal(t, "router2.example.com", cfg.Orderer.Endpoints[1].TLS.ServerNameOverride)
+}
+
+func TestOrdererConfig_EndpointConfigs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single address", func(t *testing.T) {
+		t.Parallel()
+
+		c := OrdererConfig{EndpointServiceConfig: EndpointServiceConfig{
+			Address:           "localhost:7050",
+			ConnectionTimeout: time.Second,
+		}}
+
+		require.Equal(t, []EndpointServiceConfig{c.EndpointServiceConfig}, c.EndpointConfigs())
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		t.Parallel()
+
+		tlsCfg := &TLSConfig{ServerNameOverride: "router2.example.com"}
+		c := OrdererConfig{
+			EndpointServiceConfig: EndpointServiceConfig{ConnectionTimeout: time.Second},
+			Endpoints: []OrdererEndpointConfig{
+				{Address: "router1:7050"},
+				{Address: "router2:7050", TLS: tlsCfg},
+			},
+		}
+
+		require.Equal(t, []EndpointServiceConfig{
+			{Address: "router1:7050", ConnectionTimeout: time.Second},
+			{Address: "router2:7050", ConnectionTimeout: time.Second, TLS: tlsCfg},
+		}, c.EndpointConfigs())
+	})
+}
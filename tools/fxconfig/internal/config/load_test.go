@@ -52,6 +52,28 @@ func TestLoad_WaitingTimeoutDefault(t *testing.T) {
 	assert.Equal(t, 30*time.Second, cfg.Notifications.WaitingTimeout)
 }
 
+func TestLoad_ReconnectDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := Load(WithOverride("msp.localMspID", "TestMSP"))
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	assert.Equal(t, 5, cfg.Notifications.ReconnectAttempts)
+	assert.Equal(t, time.Second, cfg.Notifications.ReconnectBackoff)
+}
+
+func TestLoad_SubmissionDefault(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := Load(WithOverride("msp.localMspID", "TestMSP"))
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	assert.Equal(t, SubmissionFailover, cfg.Orderer.Submission)
+	assert.Empty(t, cfg.Orderer.Endpoints)
+}
+
 func TestLoad_LoggingDefaults(t *testing.T) {
 	t.Parallel()
 
@@ -401,6 +423,45 @@ notifications:
 	assert.Equal(t, []string{"/path/to/ca.pem"}, cfg.Notifications.TLS.RootCertPaths)
 }
 
+// TestLoad_OrdererEndpoints verifies that a list of orderer endpoints with
+// per-endpoint TLS overrides is loaded and inherits the orderer TLS section.
+func TestLoad_OrdererEndpoints(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configContent := `
+orderer:
+  submission: all
+  tls:
+    enabled: true
+    rootCerts:
+      - /path/to/orderer-ca.pem
+  endpoints:
+    - address: router1:7050
+    - address: router2:7050
+      tls:
+        serverNameOverride: router2.example.com
+`
+	err := os.WriteFile(configPath, []byte(configContent), 0o600)
+	require.NoError(t, err)
+
+	cfg, err := Load(WithConfigFile(configPath))
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	assert.Equal(t, SubmissionAll, cfg.Orderer.Submission)
+	require.Len(t, cfg.Orderer.Endpoints, 2)
+
+	assert.Equal(t, "router1:7050", cfg.Orderer.Endpoints[0].Address)
+	assert.True(t, cfg.Orderer.Endpoints[0].TLS.IsEnabled())
+	assert.Equal(t, []string{"/path/to/orderer-ca.pem"}, cfg.Orderer.Endpoints[0].TLS.RootCertPaths)
+
+	assert.Equal(t, "router2:7050", cfg.Orderer.Endpoints[1].Address)
+	assert.True(t, cfg.Orderer.Endpoints[1].TLS.IsEnabled())
+	assert.Equal(t, "router2.example.com", cfg.Orderer.Endpoints[1].TLS.ServerNameOverride)
+}
+
 // TestLoad_TLSEnabledFlag verifies that the tls.enabled boolean flag
 // is correctly round-tripped through viper unmarshal.
 func TestLoad_TLSEnabledFlag(t *testing.T) {
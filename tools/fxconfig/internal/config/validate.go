@@ -28,15 +28,139 @@ func (c *MSPConfig) Validate(vctx validation.Context) error {
 		return fmt.Errorf("invalid configPath: %w", err)
 	}
 
+	if err := c.BCCSP.Validate(vctx); err != nil {
+		return fmt.Errorf("invalid bccsp: %w", err)
+	}
+
+	if err := c.ExternalSigner.Validate(vctx); err != nil {
+		return fmt.Errorf("invalid externalSigner: %w", err)
+	}
+
+	return nil
+}
+
+// Validate validates external signer configuration.
+// The settings are only checked if a signer command is configured.
+func (c *ExternalSignerConfig) Validate(_ validation.Context) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.Timeout < 0 {
+		return errors.New("invalid timeout: must not be negative")
+	}
+
+	return nil
+}
+
+// Validate validates policy lint configuration.
+// The config block is only checked if policy linting is enabled.
+func (c *PolicyLintConfig) Validate(vctx validation.Context) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if err := vctx.FileChecker.Exists(c.ConfigBlock); err != nil {
+		return fmt.Errorf("invalid configBlock: %w", err)
+	}
+
+	return nil
+}
+
+// Validate validates BCCSP configuration.
+// Checks the settings of the selected provider; the settings of other providers are ignored.
+func (c *BCCSPConfig) Validate(vctx validation.Context) error {
+	switch c.Default {
+	case "", BCCSPSoftware:
+		if err := validateHashFamily(c.SW.Hash, c.SW.Security); err != nil {
+			return fmt.Errorf("invalid sw: %w", err)
+		}
+		if c.SW.KeyStore != "" {
+			if err := vctx.DirectoryChecker.Exists(c.SW.KeyStore); err != nil {
+				return fmt.Errorf("invalid sw.keyStore: %w", err)
+			}
+		}
+	case BCCSPPKCS11:
+		if err := validateHashFamily(c.PKCS11.Hash, c.PKCS11.Security); err != nil {
+			return fmt.Errorf("invalid pkcs11: %w", err)
+		}
+		if err := vctx.FileChecker.Exists(c.PKCS11.Library); err != nil {
+			return fmt.Errorf("invalid pkcs11.library: %w", err)
+		}
+		if err := errorIfEmpty(c.PKCS11.Label, "must not be empty"); err != nil {
+			return fmt.Errorf("invalid pkcs11.label: %w", err)
+		}
+		if err := errorIfEmpty(c.PKCS11.Pin, "must not be empty"); err != nil {
+			return fmt.Errorf("invalid pkcs11.pin: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid default: unknown provider %q (want %s|%s)", c.Default, BCCSPSoftware, BCCSPPKCS11)
+	}
+
+	return nil
+}
+
+// validateHashFamily checks the hash family and security level of a crypto service provider.
+// Empty values select the defaults.
+func validateHashFamily(hash string, security int) error {
+	switch hash {
+	case "", "SHA2", "SHA3":
+	default:
+		return fmt.Errorf("invalid hash: unknown hash family %q (want SHA2|SHA3)", hash)
+	}
+
+	switch security {
+	case 0, 256, 384:
+	default:
+		return fmt.Errorf("invalid security: unsupported level %d (want 256|384)", security)
+	}
+
 	return nil
 }
 
 // Validate validates Orderer configuration.
-// Check channel name and endpoint service configuration.
+// Check channel name, submission strategy, and the configuration of every endpoint.
 func (c *OrdererConfig) Validate(vctx validation.Context) error {
 	if err := errorIfEmpty(c.Channel, "empty"); err != nil {
 		return fmt.Errorf("invalid channel: %w", err)
 	}
+
+	switch c.Submission {
+	case "", SubmissionFailover, SubmissionAll:
+	default:
+		return fmt.Errorf("invalid submission: unknown strategy %q", c.Submission)
+	}
+
+	if len(c.Endpoints) == 0 {
+		return c.EndpointServiceConfig.Validate(vctx)
+	}
+
+	if c.Address != "" {
+		return errors.New("invalid address: must not be set together with endpoints")
+	}
+
+	for i, ep := range c.EndpointConfigs() {
+		if err := ep.Validate(vctx); err != nil {
+			return fmt.Errorf("invalid endpoints[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate validates Notifications configuration.
+// Checks the reconnection settings and endpoint service configuration.
+func (c *NotificationsConfig) Validate(vctx validation.Context) error {
+	if c.ReconnectAttempts < 0 {
+		return errors.New("invalid reconnectAttempts: must not be negative")
+	}
+
+	if c.ReconnectAttempts > 0 {
+		if err := errorIfZeroDuration(c.ReconnectBackoff, "must be non-zero"); err != nil {
+			return fmt.Errorf("invalid reconnectBackoff: %w", err)
+		}
+	}
+
 	return c.EndpointServiceConfig.Validate(vctx)
 }
 
@@ -7,9 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
 )
 
 // TestErrorIfEmpty tests the errorIfEmpty helper function.
@@ -76,3 +81,332 @@ func TestErrorIfEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationsConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() NotificationsConfig {
+		return NotificationsConfig{
+			EndpointServiceConfig: EndpointServiceConfig{
+				Address:           "localhost:7001",
+				ConnectionTimeout: time.Second,
+			},
+			WaitingTimeout:    time.Second,
+			ReconnectAttempts: 3,
+			ReconnectBackoff:  time.Second,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(c *NotificationsConfig)
+		expectError string
+	}{
+		{
+			name:   "valid",
+			modify: func(*NotificationsConfig) {},
+		},
+		{
+			name: "reconnection disabled without backoff",
+			modify: func(c *NotificationsConfig) {
+				c.ReconnectAttempts = 0
+				c.ReconnectBackoff = 0
+			},
+		},
+		{
+			name:        "negative reconnect attempts",
+			modify:      func(c *NotificationsConfig) { c.ReconnectAttempts = -1 },
+			expectError: "invalid reconnectAttempts",
+		},
+		{
+			name:        "zero backoff",
+			modify:      func(c *NotificationsConfig) { c.ReconnectBackoff = 0 },
+			expectError: "invalid reconnectBackoff",
+		},
+		{
+			name:        "invalid endpoint",
+			modify:      func(c *NotificationsConfig) { c.Address = "" },
+			expectError: "invalid address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := valid()
+			tt.modify(&c)
+
+			err := c.Validate(validation.NewValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOrdererConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() OrdererConfig {
+		return OrdererConfig{
+			EndpointServiceConfig: EndpointServiceConfig{
+				Address:           "localhost:7050",
+				ConnectionTimeout: time.Second,
+			},
+			Submission: SubmissionFailover,
+			Channel:    "mychannel",
+		}
+	}
+
+	withEndpoints := func(c *OrdererConfig) {
+		c.Address = ""
+		c.Endpoints = []OrdererEndpointConfig{{Address: "router1:7050"}, {Address: "router2:7050"}}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(c *OrdererConfig)
+		expectError string
+	}{
+		{
+			name:   "valid",
+			modify: func(*OrdererConfig) {},
+		},
+		{
+			name: "valid endpoints",
+			modify: func(c *OrdererConfig) {
+				withEndpoints(c)
+				c.Submission = SubmissionAll
+			},
+		},
+		{
+			name:        "empty channel",
+			modify:      func(c *OrdererConfig) { c.Channel = "" },
+			expectError: "invalid channel",
+		},
+		{
+			name:        "unknown submission strategy",
+			modify:      func(c *OrdererConfig) { c.Submission = "random" },
+			expectError: "invalid submission",
+		},
+		{
+			name: "address and endpoints",
+			modify: func(c *OrdererConfig) {
+				c.Endpoints = []OrdererEndpointConfig{{Address: "router1:7050"}}
+			},
+			expectError: "must not be set together with endpoints",
+		},
+		{
+			name: "invalid endpoint address",
+			modify: func(c *OrdererConfig) {
+				withEndpoints(c)
+				c.Endpoints[1].Address = "router2"
+			},
+			expectError: "invalid endpoints[1]: invalid address",
+		},
+		{
+			name: "invalid endpoint tls",
+			modify: func(c *OrdererConfig) {
+				withEndpoints(c)
+				c.Endpoints[0].TLS = &TLSConfig{Enabled: boolPtr(true)}
+			},
+			expectError: "invalid endpoints[0]: invalid tls configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := valid()
+			tt.modify(&c)
+
+			err := c.Validate(validation.NewValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBCCSPConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	library := filepath.Join(t.TempDir(), "libsofthsm2.so")
+	require.NoError(t, os.WriteFile(library, nil, 0o600))
+
+	pkcs11 := func() BCCSPConfig {
+		return BCCSPConfig{
+			Default: BCCSPPKCS11,
+			PKCS11:  PKCS11Config{Library: library, Label: "ForFabric", Pin: "98765432", Hash: "SHA2", Security: 256},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		config      func() BCCSPConfig
+		expectError string
+	}{
+		{
+			name:   "default",
+			config: func() BCCSPConfig { return BCCSPConfig{} },
+		},
+		{
+			name: "software",
+			config: func() BCCSPConfig {
+				return BCCSPConfig{Default: BCCSPSoftware, SW: SWConfig{Hash: "SHA3", Security: 384}}
+			},
+		},
+		{
+			name:   "pkcs11",
+			config: pkcs11,
+		},
+		{
+			name:        "unknown provider",
+			config:      func() BCCSPConfig { return BCCSPConfig{Default: "TPM"} },
+			expectError: `invalid default: unknown provider "TPM"`,
+		},
+		{
+			name:        "unknown hash family",
+			config:      func() BCCSPConfig { return BCCSPConfig{SW: SWConfig{Hash: "MD5"}} },
+			expectError: "invalid sw: invalid hash",
+		},
+		{
+			name:        "missing keystore",
+			config:      func() BCCSPConfig { return BCCSPConfig{SW: SWConfig{KeyStore: "/does/not/exist"}} },
+			expectError: "invalid sw.keyStore",
+		},
+		{
+			name: "unsupported security level",
+			config: func() BCCSPConfig {
+				c := pkcs11()
+				c.PKCS11.Security = 128
+				return c
+			},
+			expectError: "invalid pkcs11: invalid security",
+		},
+		{
+			name: "missing library",
+			config: func() BCCSPConfig {
+				c := pkcs11()
+				c.PKCS11.Library = "/does/not/exist.so"
+				return c
+			},
+			expectError: "invalid pkcs11.library",
+		},
+		{
+			name: "missing label",
+			config: func() BCCSPConfig {
+				c := pkcs11()
+				c.PKCS11.Label = ""
+				return c
+			},
+			expectError: "invalid pkcs11.label",
+		},
+		{
+			name: "missing pin",
+			config: func() BCCSPConfig {
+				c := pkcs11()
+				c.PKCS11.Pin = ""
+				return c
+			},
+			expectError: "invalid pkcs11.pin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := tt.config()
+			err := c.Validate(validation.NewValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExternalSignerConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      ExternalSignerConfig
+		expectError string
+	}{
+		{
+			name:   "disabled",
+			config: ExternalSignerConfig{Timeout: -time.Second},
+		},
+		{
+			name:   "enabled",
+			config: ExternalSignerConfig{Command: "kms-signer", Args: []string{"--key", "alias/org1"}, Timeout: time.Second},
+		},
+		{
+			name:        "negative timeout",
+			config:      ExternalSignerConfig{Command: "kms-signer", Timeout: -time.Second},
+			expectError: "invalid timeout: must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.config.Validate(validation.NewValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicyLintConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	block := filepath.Join(t.TempDir(), "config.block")
+	require.NoError(t, os.WriteFile(block, []byte("block"), 0o600))
+
+	tests := []struct {
+		name        string
+		config      PolicyLintConfig
+		expectError string
+	}{
+		{
+			name:   "disabled",
+			config: PolicyLintConfig{Strict: true},
+		},
+		{
+			name:   "enabled",
+			config: PolicyLintConfig{ConfigBlock: block},
+		},
+		{
+			name:        "missing config block",
+			config:      PolicyLintConfig{ConfigBlock: filepath.Join(filepath.Dir(block), "missing.block")},
+			expectError: "invalid configBlock: file does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.config.Validate(validation.NewValidationContext())
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/fabric-lib-go/bccsp/sw"
+	"github.com/hyperledger/fabric-lib-go/bccsp/utils"
+
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+// ExternalSignRequest is written as JSON to the standard input of the external signer.
+// Byte fields are encoded as base64.
+type ExternalSignRequest struct {
+	// MSPID is the MSP ID of the signing identity.
+	MSPID string `json:"mspId"`
+	// Certificate is the PEM-encoded signing certificate; it identifies the key to sign with.
+	Certificate string `json:"certificate"`
+	// Message is the message to sign.
+	Message []byte `json:"message"`
+	// Digest is the SHA-256 digest of the message, for signers that sign prehashed input.
+	Digest []byte `json:"digest"`
+}
+
+// ExternalSignResponse is read as JSON from the standard output of the external signer.
+type ExternalSignResponse struct {
+	// Signature is the ASN.1 DER-encoded ECDSA signature over the digest.
+	Signature []byte `json:"signature"`
+}
+
+// externalSigningIdentity is an MSP signing identity whose signatures are produced by an
+// external signer executable. The signer is invoked once per signature.
+type externalSigningIdentity struct {
+	msp.Identity
+
+	command string
+	cfg     config.ExternalSignerConfig
+	request ExternalSignRequest
+	pubKey  *ecdsa.PublicKey
+}
+
+// newExternalSigningIdentity creates a signing identity for the signing certificate of the MSP
+// configuration directory that delegates signing to the configured external signer.
+//
+//nolint:ireturn
+func newExternalSigningIdentity(mspCfg config.MSPConfig) (msp.SigningIdentity, error) {
+	command, err := exec.LookPath(mspCfg.ExternalSigner.Command)
+	if err != nil {
+		return nil, fmt.Errorf("signer command not found: %w", err)
+	}
+
+	conf, err := msp.GetVerifyingMspConfig(mspCfg.ConfigPath, mspCfg.LocalMspID, msp.ProviderTypeToString(msp.FABRIC))
+	if err != nil {
+		return nil, fmt.Errorf("error getting msp config from %v: %w", mspCfg.ConfigPath, err)
+	}
+
+	cp, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewDummyKeyStore())
+	if err != nil {
+		return nil, fmt.Errorf("bccsp setup error: %w", err)
+	}
+	thisMSP, err := msp.New(&msp.BCCSPNewOpts{NewBaseOpts: msp.NewBaseOpts{Version: msp.MSPv1_0}}, cp)
+	if err != nil {
+		return nil, err
+	}
+	if err = thisMSP.Setup(conf); err != nil {
+		return nil, err
+	}
+
+	certPEM, err := readSignCert(filepath.Join(mspCfg.ConfigPath, "signcerts"))
+	if err != nil {
+		return nil, err
+	}
+	id, err := thisMSP.DeserializeIdentity(msppb.NewIdentity(mspCfg.LocalMspID, certPEM))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	if err = id.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+
+	// the signing certificate was parsed by the MSP
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+	pubKey, _ := cert.PublicKey.(*ecdsa.PublicKey)
+
+	return &externalSigningIdentity{
+		Identity: id,
+		command:  command,
+		cfg:      mspCfg.ExternalSigner,
+		request:  ExternalSignRequest{MSPID: mspCfg.LocalMspID, Certificate: string(certPEM)},
+		pubKey:   pubKey,
+	}, nil
+}
+
+// readSignCert reads the PEM-encoded signing certificate from the signcerts directory.
+func readSignCert(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read signing certificate: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read signing certificate: %w", err)
+		}
+		if block, _ := pem.Decode(data); block != nil {
+			return pem.EncodeToMemory(block), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no signing certificate found in directory %s", dir)
+}
+
+// Sign requests a signature of msg from the external signer. ECDSA signatures are normalized to
+// low-S, and every signature is checked with the signing certificate before it is returned.
+func (id *externalSigningIdentity) Sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	req := id.request
+	req.Message = msg
+	req.Digest = digest[:]
+
+	sig, err := id.requestSignature(&req)
+	if err != nil {
+		return nil, fmt.Errorf("external signer error: %w", err)
+	}
+
+	if id.pubKey != nil {
+		sig, err = utils.SignatureToLowS(id.pubKey, sig)
+		if err != nil {
+			return nil, fmt.Errorf("external signer returned a malformed signature: %w", err)
+		}
+	}
+
+	if err = id.Verify(msg, sig); err != nil {
+		return nil, fmt.Errorf("external signer returned an invalid signature: %w", err)
+	}
+
+	return sig, nil
+}
+
+// GetPublicVersion returns the identity without signing capability.
+//
+//nolint:ireturn
+func (id *externalSigningIdentity) GetPublicVersion() msp.Identity {
+	return id.Identity
+}
+
+// requestSignature runs the signer executable with the request on its standard input.
+func (id *externalSigningIdentity) requestSignature(req *ExternalSignRequest) ([]byte, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if id.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, id.cfg.Timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, id.command, id.cfg.Args...) //nolint:gosec // the command is configured by the user
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err = cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	var resp ExternalSignResponse
+	if err = json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if len(resp.Signature) == 0 {
+		return nil, errors.New("invalid response: missing signature")
+	}
+
+	return resp.Signature, nil
+}
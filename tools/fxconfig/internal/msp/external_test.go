@@ -0,0 +1,226 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// Modes of the stub signer.
+const (
+	stubSign      = "sign"
+	stubWrongKey  = "wrong-key"
+	stubFail      = "fail"
+	stubMalformed = "malformed"
+	stubHang      = "hang"
+)
+
+// stubSignerConfig returns an external signer configuration that runs the test binary as a
+// stub signer in the given mode. The stub signs with the key of the testdata MSP.
+func stubSignerConfig(mode string) config.ExternalSignerConfig {
+	return config.ExternalSignerConfig{
+		Command: os.Args[0],
+		Args:    []string{"-test.run=^TestStubSigner$", "--", mode, testdataMSPDir() + "/keystore/priv_sk"},
+		Timeout: 10 * time.Second,
+	}
+}
+
+// TestStubSigner implements the external signer protocol when the test binary is run by
+// stubSignerConfig. It does nothing in a regular test run.
+func TestStubSigner(t *testing.T) {
+	t.Parallel()
+
+	args := flag.Args()
+	if len(args) != 2 {
+		return
+	}
+	mode, keyFile := args[0], args[1]
+
+	var req ExternalSignRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		stubExit(fmt.Sprintf("invalid request: %v", err))
+	}
+
+	var key *ecdsa.PrivateKey
+	switch mode {
+	case stubFail:
+		stubExit("key is disabled")
+	case stubMalformed:
+		fmt.Print(`{"signature":"bm90IGEgc2lnbmF0dXJl"}`)
+		os.Exit(0)
+	case stubHang:
+		time.Sleep(time.Minute)
+	case stubWrongKey:
+		key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		key = readStubKey(keyFile)
+	}
+
+	if digest := sha256.Sum256(req.Message); string(digest[:]) != string(req.Digest) {
+		stubExit("digest mismatch")
+	}
+	sig, err := ecdsa.SignASN1(rand.Reader, key, req.Digest)
+	if err != nil {
+		stubExit(err.Error())
+	}
+	out, _ := json.Marshal(&ExternalSignResponse{Signature: sig})
+	fmt.Print(string(out))
+	os.Exit(0)
+}
+
+func readStubKey(keyFile string) *ecdsa.PrivateKey {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		stubExit(err.Error())
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		stubExit("invalid key file")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		stubExit(err.Error())
+	}
+	return key.(*ecdsa.PrivateKey) //nolint:forcetypeassert
+}
+
+func stubExit(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+	os.Exit(1)
+}
+
+func TestGetSignerIdentityFromMSP_ExternalSigner(t *testing.T) {
+	t.Parallel()
+
+	sid, err := GetSignerIdentityFromMSP(config.MSPConfig{
+		LocalMspID:     "Org1MSP",
+		ConfigPath:     testdataMSPDir(),
+		ExternalSigner: stubSignerConfig(stubSign),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Org1MSP", sid.GetMSPIdentifier())
+
+	local, err := GetSignerIdentityFromMSP(config.MSPConfig{LocalMspID: "Org1MSP", ConfigPath: testdataMSPDir()})
+	require.NoError(t, err)
+
+	t.Run("same identity as the local MSP", func(t *testing.T) {
+		t.Parallel()
+
+		serialized, err := sid.Serialize()
+		require.NoError(t, err)
+		localSerialized, err := local.Serialize()
+		require.NoError(t, err)
+		require.Equal(t, localSerialized, serialized)
+	})
+
+	t.Run("sign", func(t *testing.T) {
+		t.Parallel()
+
+		msg := []byte("test message")
+		sig, err := sid.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, local.Verify(msg, sig))
+	})
+
+	t.Run("endorse", func(t *testing.T) {
+		t.Parallel()
+
+		tx := transaction.CreateNamespacesTx(&applicationpb.NamespacePolicy{}, "payments", 0)
+		endorsed, err := transaction.Endorse(sid, "tx-1", tx)
+		require.NoError(t, err)
+
+		verification, err := transaction.VerifyEndorsements("tx-1", endorsed, 0, mspPolicy(t, "OR('Org1MSP.member')"))
+		require.NoError(t, err)
+		require.True(t, verification.Satisfied, verification.Error)
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		t.Parallel()
+
+		env, err := transaction.CreateSignedEnvelope(sid, "mychannel", "tx-1", &applicationpb.Tx{})
+		require.NoError(t, err)
+		require.NoError(t, local.Verify(env.GetPayload(), env.GetSignature()))
+	})
+}
+
+func TestGetSignerIdentityFromMSP_ExternalSignerErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("setup errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetSignerIdentityFromMSP(config.MSPConfig{
+			LocalMspID:     "Org1MSP",
+			ConfigPath:     testdataMSPDir(),
+			ExternalSigner: config.ExternalSignerConfig{Command: "/does/not/exist"},
+		})
+		require.ErrorContains(t, err, "external signer setup error: signer command not found")
+
+		_, err = GetSignerIdentityFromMSP(config.MSPConfig{
+			LocalMspID:     "Org1MSP",
+			ConfigPath:     "/does/not/exist",
+			ExternalSigner: stubSignerConfig(stubSign),
+		})
+		require.ErrorContains(t, err, "external signer setup error")
+	})
+
+	tests := []struct {
+		mode        string
+		timeout     time.Duration
+		expectError string
+	}{
+		{mode: stubFail, expectError: "external signer error: exit status 1: key is disabled"},
+		{mode: stubWrongKey, expectError: "external signer returned an invalid signature"},
+		{mode: stubMalformed, expectError: "external signer returned a malformed signature"},
+		{mode: stubHang, timeout: 100 * time.Millisecond, expectError: "external signer error: context deadline exceeded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			t.Parallel()
+
+			signer := stubSignerConfig(tt.mode)
+			if tt.timeout > 0 {
+				signer.Timeout = tt.timeout
+			}
+			sid, err := GetSignerIdentityFromMSP(config.MSPConfig{
+				LocalMspID:     "Org1MSP",
+				ConfigPath:     testdataMSPDir(),
+				ExternalSigner: signer,
+			})
+			require.NoError(t, err)
+
+			_, err = sid.Sign([]byte("test message"))
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
+
+func mspPolicy(t *testing.T, expression string) *applicationpb.NamespacePolicy {
+	t.Helper()
+
+	p, err := transaction.CreateMspPolicy(expression)
+	require.NoError(t, err)
+	return p
+}
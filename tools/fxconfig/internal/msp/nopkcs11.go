@@ -0,0 +1,24 @@
+//go:build !pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-lib-go/bccsp"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+// newPKCS11Provider reports that PKCS#11 support is not compiled in.
+//
+//nolint:ireturn
+func newPKCS11Provider(config.PKCS11Config) (bccsp.BCCSP, error) {
+	return nil, errors.New("PKCS11 support is not available in this build (build with -tags pkcs11)")
+}
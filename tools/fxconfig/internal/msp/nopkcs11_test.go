@@ -0,0 +1,33 @@
+//go:build !pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+func TestGetSignerIdentityFromMSP_PKCS11NotAvailable(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.MSPConfig{
+		LocalMspID: "Org1MSP",
+		ConfigPath: testdataMSPDir(),
+		BCCSP: config.BCCSPConfig{
+			Default: config.BCCSPPKCS11,
+			PKCS11:  config.PKCS11Config{Library: "/usr/lib/softhsm/libsofthsm2.so", Label: "ForFabric", Pin: "1234"},
+		},
+	}
+
+	_, err := GetSignerIdentityFromMSP(cfg)
+	require.ErrorContains(t, err, "build with -tags pkcs11")
+}
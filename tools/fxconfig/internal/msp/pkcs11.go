@@ -0,0 +1,34 @@
+//go:build pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"github.com/hyperledger/fabric-lib-go/bccsp"
+	"github.com/hyperledger/fabric-lib-go/bccsp/factory"
+	"github.com/hyperledger/fabric-lib-go/bccsp/pkcs11"
+
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+)
+
+// newPKCS11Provider creates a BCCSP using the keys of a PKCS#11 token.
+// Keys are looked up by the subject key identifier of the certificate, as Fabric does.
+//
+//nolint:ireturn
+func newPKCS11Provider(cfg config.PKCS11Config) (bccsp.BCCSP, error) {
+	return factory.GetBCCSPFromOpts(&factory.FactoryOpts{
+		Default: factory.PKCS11BasedFactoryName,
+		PKCS11: &pkcs11.PKCS11Opts{
+			Security: securityLevel(cfg.Security),
+			Hash:     hashFamily(cfg.Hash),
+			Library:  cfg.Library,
+			Label:    cfg.Label,
+			Pin:      cfg.Pin,
+		},
+	})
+}
@@ -0,0 +1,149 @@
+//go:build pkcs11
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-lib-go/bccsp"
+	"github.com/hyperledger/fabric-lib-go/bccsp/pkcs11"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/config"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction"
+)
+
+// softHSMConfig returns the PKCS#11 configuration of the SoftHSM token used for testing.
+// The token is configured with PKCS11_LIB, PKCS11_LABEL, and PKCS11_PIN, as for the Fabric
+// BCCSP tests, e.g., after 'softhsm2-util --init-token --slot 0 --label ForFabric --pin 98765432'.
+// The tests are skipped if no library is found, unless PKCS11_LIB is set explicitly, as in CI.
+func softHSMConfig(t *testing.T) config.PKCS11Config {
+	t.Helper()
+
+	lib, pin, label := pkcs11.FindPKCS11Lib()
+	if _, err := os.Stat(lib); err != nil {
+		if os.Getenv("PKCS11_LIB") != "" {
+			t.Fatalf("PKCS#11 library not available: %v", err)
+		}
+		t.Skipf("PKCS#11 library not available: %v", err)
+	}
+
+	return config.PKCS11Config{Library: lib, Label: label, Pin: pin, Hash: "SHA2", Security: 256}
+}
+
+// writeHSMBackedMSP generates a signing key on the token and writes an MSP directory whose
+// signing certificate is issued for it. The keystore of the MSP directory is empty.
+func writeHSMBackedMSP(t *testing.T, cfg config.PKCS11Config) (string, *ecdsa.PublicKey) {
+	t.Helper()
+
+	csp, err := newPKCS11Provider(cfg)
+	require.NoError(t, err)
+	key, err := csp.KeyGen(&bccsp.ECDSAP256KeyGenOpts{Temporary: false})
+	require.NoError(t, err)
+	pubKey, err := key.PublicKey()
+	require.NoError(t, err)
+	der, err := pubKey.Bytes()
+	require.NoError(t, err)
+	pub, err := x509.ParsePKIXPublicKey(der)
+	require.NoError(t, err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca.org1.example.com", Organization: []string{"org1"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	signer := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "admin@org1.example.com", Organization: []string{"org1"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		SubjectKeyId: key.SKI(),
+	}
+	signerDER, err := x509.CreateCertificate(rand.Reader, signer, ca, pub, caKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	for sub, certDER := range map[string][]byte{"cacerts": caDER, "signcerts": signerDER} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0o750))
+		pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+		require.NoError(t, os.WriteFile(filepath.Join(dir, sub, "cert.pem"), pemBytes, 0o600))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "keystore"), 0o750))
+
+	return dir, pub.(*ecdsa.PublicKey) //nolint:forcetypeassert
+}
+
+func TestGetSignerIdentityFromMSP_PKCS11(t *testing.T) {
+	t.Parallel()
+
+	hsm := softHSMConfig(t)
+	dir, pub := writeHSMBackedMSP(t, hsm)
+
+	sid, err := GetSignerIdentityFromMSP(config.MSPConfig{
+		LocalMspID: "Org1MSP",
+		ConfigPath: dir,
+		BCCSP:      config.BCCSPConfig{Default: config.BCCSPPKCS11, PKCS11: hsm},
+	})
+	require.NoError(t, err)
+
+	t.Run("sign", func(t *testing.T) {
+		t.Parallel()
+
+		msg := []byte("test message")
+		sig, err := sid.Sign(msg)
+		require.NoError(t, err)
+
+		digest := sha256.Sum256(msg)
+		require.True(t, ecdsa.VerifyASN1(pub, digest[:], sig))
+	})
+
+	t.Run("endorse", func(t *testing.T) {
+		t.Parallel()
+
+		tx := transaction.CreateNamespacesTx(&applicationpb.NamespacePolicy{}, "payments", 0)
+		endorsed, err := transaction.Endorse(sid, "tx-1", tx)
+		require.NoError(t, err)
+
+		verification, err := transaction.VerifyEndorsements("tx-1", endorsed, 0, mspPolicy(t, "OR('Org1MSP.member')"))
+		require.NoError(t, err)
+		require.True(t, verification.Satisfied, verification.Error)
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		t.Parallel()
+
+		env, err := transaction.CreateSignedEnvelope(sid, "mychannel", "tx-1", &applicationpb.Tx{})
+		require.NoError(t, err)
+
+		digest := sha256.Sum256(env.GetPayload())
+		require.True(t, ecdsa.VerifyASN1(pub, digest[:], env.GetSignature()))
+	})
+}
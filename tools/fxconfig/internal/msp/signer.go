@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/hyperledger/fabric-lib-go/bccsp"
 	"github.com/hyperledger/fabric-lib-go/bccsp/sw"
 
 	"github.com/hyperledger/fabric-x-common/msp"
@@ -18,9 +19,18 @@ import (
 )
 
 // GetSignerIdentityFromMSP returns the default signing identity from MSP configuration.
+// If an external signer is configured, signatures of the identity are produced by the signer.
 //
 //nolint:ireturn
 func GetSignerIdentityFromMSP(cfg config.MSPConfig) (msp.SigningIdentity, error) {
+	if cfg.ExternalSigner.Enabled() {
+		sid, err := newExternalSigningIdentity(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("external signer setup error: %w", err)
+		}
+		return sid, nil
+	}
+
 	thisMSP, err := setupMSP(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("msp setup error: %w", err)
@@ -34,7 +44,7 @@ func GetSignerIdentityFromMSP(cfg config.MSPConfig) (msp.SigningIdentity, error)
 	return sid, nil
 }
 
-// setupMSP creates an MSP instance with file-based BCCSP keystore from the given configuration.
+// setupMSP creates an MSP instance with the configured BCCSP from the given configuration.
 //
 //nolint:ireturn
 func setupMSP(mspCfg config.MSPConfig) (msp.MSP, error) {
@@ -43,17 +53,9 @@ func setupMSP(mspCfg config.MSPConfig) (msp.MSP, error) {
 		return nil, fmt.Errorf("error getting local msp config from %v: %w", mspCfg.ConfigPath, err)
 	}
 
-	// TODO: get proper BCCSP connfiguration via config
-
-	dir := path.Join(mspCfg.ConfigPath, "keystore")
-	ks, err := sw.NewFileBasedKeyStore(nil, dir, true)
+	cp, err := newCryptoProvider(mspCfg)
 	if err != nil {
-		return nil, err
-	}
-
-	cp, err := sw.NewDefaultSecurityLevelWithKeystore(ks)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("bccsp setup error: %w", err)
 	}
 
 	mspOpts := &msp.BCCSPNewOpts{
@@ -74,3 +76,54 @@ func setupMSP(mspCfg config.MSPConfig) (msp.MSP, error) {
 
 	return thisMSP, nil
 }
+
+// newCryptoProvider creates the BCCSP holding the signing key of the MSP identity.
+//
+//nolint:ireturn
+func newCryptoProvider(mspCfg config.MSPConfig) (bccsp.BCCSP, error) {
+	switch mspCfg.BCCSP.Default {
+	case "", config.BCCSPSoftware:
+		return newSoftwareProvider(mspCfg)
+	case config.BCCSPPKCS11:
+		return newPKCS11Provider(mspCfg.BCCSP.PKCS11)
+	default:
+		return nil, fmt.Errorf("unknown provider %q", mspCfg.BCCSP.Default)
+	}
+}
+
+// newSoftwareProvider creates a software BCCSP reading keys from the MSP keystore directory.
+//
+//nolint:ireturn
+func newSoftwareProvider(mspCfg config.MSPConfig) (bccsp.BCCSP, error) {
+	swCfg := mspCfg.BCCSP.SW
+
+	dir := swCfg.KeyStore
+	if dir == "" {
+		dir = path.Join(mspCfg.ConfigPath, "keystore")
+	}
+	ks, err := sw.NewFileBasedKeyStore(nil, dir, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return sw.NewWithParams(securityLevel(swCfg.Security), hashFamily(swCfg.Hash), ks)
+}
+
+func securityLevel(security int) int {
+	if security == 0 {
+		return defaultSecurityLevel
+	}
+	return security
+}
+
+func hashFamily(hash string) string {
+	if hash == "" {
+		return defaultHashFamily
+	}
+	return hash
+}
+
+const (
+	defaultSecurityLevel = 256
+	defaultHashFamily    = "SHA2"
+)
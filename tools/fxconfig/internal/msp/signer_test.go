@@ -97,4 +97,40 @@ func TestGetSignerIdentityFromMSP(t *testing.T) {
 		require.NoError(t, err)
 		require.NotEmpty(t, serialized)
 	})
+	t.Run("success with configured software keystore", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MSPConfig{
+			LocalMspID: "Org1MSP",
+			ConfigPath: testdataMSPDir(),
+			BCCSP: config.BCCSPConfig{
+				Default: config.BCCSPSoftware,
+				SW: config.SWConfig{
+					Hash:     "SHA2",
+					Security: 256,
+					KeyStore: testdataMSPDir() + "/keystore",
+				},
+			},
+		}
+
+		sid, err := GetSignerIdentityFromMSP(cfg)
+		require.NoError(t, err)
+
+		sig, err := sid.Sign([]byte("test message"))
+		require.NoError(t, err)
+		require.NotEmpty(t, sig)
+	})
+
+	t.Run("error with unknown provider", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MSPConfig{
+			LocalMspID: "Org1MSP",
+			ConfigPath: testdataMSPDir(),
+			BCCSP:      config.BCCSPConfig{Default: "TPM"},
+		}
+
+		_, err := GetSignerIdentityFromMSP(cfg)
+		require.ErrorContains(t, err, `bccsp setup error: unknown provider "TPM"`)
+	})
 }
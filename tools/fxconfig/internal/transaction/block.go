@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"fmt"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// BlockTx is a transaction decoded from a committed block.
+type BlockTx struct {
+	TxNum      uint32
+	TxID       string
+	ChannelID  string
+	HeaderType cb.HeaderType
+	Timestamp  time.Time
+	// Creator is the identity that signed the envelope, i.e., the submitter.
+	Creator *msppb.Identity
+	// Tx is set for application transactions (HeaderType MESSAGE).
+	Tx *applicationpb.Tx
+	// Status is the validation status recorded by the committer in the block metadata.
+	Status committerpb.Status
+	// Err is set if the envelope could not be decoded.
+	Err error
+}
+
+// DecodeBlockTxs decodes all transactions of a block committed by the Fabric-X committer.
+// The validation status of each transaction is taken from the TRANSACTIONS_FILTER block metadata;
+// transactions without a recorded status report STATUS_UNSPECIFIED.
+func DecodeBlockTxs(block *cb.Block) []*BlockTx {
+	statuses := blockTxStatuses(block)
+
+	txs := make([]*BlockTx, len(block.GetData().GetData()))
+	for i, data := range block.GetData().GetData() {
+		btx := &BlockTx{TxNum: uint32(i)} //nolint:gosec // number of txs in a block fits in uint32
+		if i < len(statuses) {
+			btx.Status = committerpb.Status(statuses[i])
+		}
+		btx.Err = decodeEnvelope(data, btx)
+		txs[i] = btx
+	}
+
+	return txs
+}
+
+// blockTxStatuses returns the raw status codes stored in the block metadata.
+func blockTxStatuses(block *cb.Block) []byte {
+	metadata := block.GetMetadata().GetMetadata()
+	if len(metadata) <= int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		return nil
+	}
+	return metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER]
+}
+
+// decodeEnvelope decodes the envelope bytes into btx.
+func decodeEnvelope(data []byte, btx *BlockTx) error {
+	env, err := protoutil.UnmarshalEnvelope(data)
+	if err != nil {
+		return err
+	}
+
+	payload, err := protoutil.UnmarshalPayload(env.GetPayload())
+	if err != nil {
+		return err
+	}
+
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return err
+	}
+	btx.TxID = chdr.GetTxId()
+	btx.ChannelID = chdr.GetChannelId()
+	btx.HeaderType = cb.HeaderType(chdr.GetType())
+	if ts := chdr.GetTimestamp(); ts != nil {
+		btx.Timestamp = ts.AsTime()
+	}
+
+	shdr, err := protoutil.UnmarshalSignatureHeader(payload.GetHeader().GetSignatureHeader())
+	if err != nil {
+		return err
+	}
+	if len(shdr.GetCreator()) > 0 {
+		creator, err := protoutil.UnmarshalIdentity(shdr.GetCreator())
+		if err != nil {
+			return err
+		}
+		btx.Creator = creator
+	}
+
+	if btx.HeaderType != cb.HeaderType_MESSAGE {
+		return nil
+	}
+
+	var tx applicationpb.Tx
+	if err := proto.Unmarshal(payload.GetData(), &tx); err != nil {
+		return fmt.Errorf("error unmarshalling transaction: %w", err)
+	}
+	btx.Tx = &tx
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+func someEnvelopeBytes(t *testing.T, headerType cb.HeaderType, txID string, data []byte) []byte {
+	t.Helper()
+
+	signer := &mockSigningIdentity{mspID: "Org1MSP"}
+	chdr := protoutil.MakeChannelHeader(headerType, 0, "mychannel", 0)
+	chdr.TxId = txID
+
+	payload := &cb.Payload{
+		Header: protoutil.MakePayloadHeader(chdr, protoutil.NewSignatureHeaderOrPanic(signer)),
+		Data:   data,
+	}
+
+	return protoutil.MarshalOrPanic(&cb.Envelope{Payload: protoutil.MarshalOrPanic(payload)})
+}
+
+func TestDecodeBlockTxs(t *testing.T) {
+	t.Parallel()
+
+	tx := CreateNamespacesTx(&applicationpb.NamespacePolicy{}, "ns1", -1)
+
+	block := protoutil.NewBlock(7, nil)
+	block.Data.Data = [][]byte{
+		someEnvelopeBytes(t, cb.HeaderType_MESSAGE, "tx-1", protoutil.MarshalOrPanic(tx)),
+		someEnvelopeBytes(t, cb.HeaderType_CONFIG, "tx-2", nil),
+		[]byte("garbage"),
+	}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+		byte(committerpb.Status_COMMITTED),
+		byte(committerpb.Status_ABORTED_MVCC_CONFLICT),
+		byte(committerpb.Status_MALFORMED_BAD_ENVELOPE),
+	}
+
+	txs := DecodeBlockTxs(block)
+	require.Len(t, txs, 3)
+
+	require.NoError(t, txs[0].Err)
+	require.Equal(t, uint32(0), txs[0].TxNum)
+	require.Equal(t, "tx-1", txs[0].TxID)
+	require.Equal(t, "mychannel", txs[0].ChannelID)
+	require.Equal(t, cb.HeaderType_MESSAGE, txs[0].HeaderType)
+	require.Equal(t, "Org1MSP", txs[0].Creator.GetMspId())
+	require.False(t, txs[0].Timestamp.IsZero())
+	require.True(t, proto.Equal(tx, txs[0].Tx))
+	require.Equal(t, committerpb.Status_COMMITTED, txs[0].Status)
+
+	require.NoError(t, txs[1].Err)
+	require.Equal(t, "tx-2", txs[1].TxID)
+	require.Nil(t, txs[1].Tx)
+	require.Equal(t, committerpb.Status_ABORTED_MVCC_CONFLICT, txs[1].Status)
+
+	require.Error(t, txs[2].Err)
+	require.Equal(t, uint32(2), txs[2].TxNum)
+	require.Equal(t, committerpb.Status_MALFORMED_BAD_ENVELOPE, txs[2].Status)
+}
+
+func TestDecodeBlockTxs_WithoutStatus(t *testing.T) {
+	t.Parallel()
+
+	block := &cb.Block{
+		Header: &cb.BlockHeader{Number: 1},
+		Data: &cb.BlockData{Data: [][]byte{
+			someEnvelopeBytes(t, cb.HeaderType_MESSAGE, "tx-1", nil),
+		}},
+	}
+
+	txs := DecodeBlockTxs(block)
+	require.Len(t, txs, 1)
+	require.NoError(t, txs[0].Err)
+	require.Equal(t, committerpb.Status_STATUS_UNSPECIFIED, txs[0].Status)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+	"github.com/hyperledger/fabric-x-common/protolator"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// BlockReport is a readable representation of a block committed by the Fabric-X committer.
+// Hashes are rendered as hex.
+type BlockReport struct {
+	Number       uint64              `json:"number"`
+	PreviousHash string              `json:"previousHash"`
+	DataHash     string              `json:"dataHash"`
+	Transactions []BlockTxReport     `json:"transactions"`
+	Metadata     BlockMetadataReport `json:"metadata"`
+}
+
+// BlockTxReport describes an envelope of a block with the validation status recorded by the committer.
+type BlockTxReport struct {
+	TxNum     uint32          `json:"txNum"`
+	TxID      string          `json:"txId,omitempty"`
+	Type      string          `json:"type,omitempty"`
+	ChannelID string          `json:"channelId,omitempty"`
+	Timestamp *time.Time      `json:"timestamp,omitempty"`
+	Creator   *IdentityReport `json:"creator,omitempty"`
+	Status    string          `json:"status"`
+	Code      int32           `json:"code"`
+	// Transaction is the decoded Fabric-X transaction of an application envelope.
+	Transaction *TxReport `json:"transaction,omitempty"`
+	// Config is the deeply decoded envelope of a config transaction.
+	Config json.RawMessage `json:"config,omitempty"`
+	// Error is set if the envelope or its payload cannot be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// IdentityReport describes the identity that signed an envelope or a block.
+type IdentityReport struct {
+	MSPID   string `json:"mspId"`
+	Subject string `json:"subject,omitempty"`
+	// CertificateID is set if the identity references a certificate known to the committer.
+	CertificateID string `json:"certificateId,omitempty"`
+}
+
+// BlockMetadataReport describes the metadata of a block written by the orderer and the committer.
+type BlockMetadataReport struct {
+	// LastConfig is the number of the latest config block when the block was ordered.
+	LastConfig *uint64                `json:"lastConfig,omitempty"`
+	Signatures []BlockSignatureReport `json:"signatures,omitempty"`
+	// ValidationCodes are the statuses recorded by the committer, indexed by transaction number.
+	ValidationCodes []string `json:"validationCodes,omitempty"`
+	// Error is set if the orderer metadata cannot be decoded.
+	Error string `json:"error,omitempty"`
+}
+
+// BlockSignatureReport describes an orderer signature over a block.
+type BlockSignatureReport struct {
+	// Signer is set if the signature header names the signing identity.
+	Signer *IdentityReport `json:"signer,omitempty"`
+	// Identifier is set if the signature references a consenter by its ID.
+	Identifier *uint32 `json:"identifier,omitempty"`
+	Signature  string  `json:"signature"`
+}
+
+// DescribeBlock decodes a committed block into a readable report. Application envelopes are decoded
+// into Fabric-X transactions, including the policies written to the meta-namespace and the
+// verification of their endorsements; config envelopes are decoded completely.
+// Envelopes that cannot be decoded are reported with an error.
+func DescribeBlock(block *cb.Block) *BlockReport {
+	report := &BlockReport{
+		Number:       block.GetHeader().GetNumber(),
+		PreviousHash: hex.EncodeToString(block.GetHeader().GetPreviousHash()),
+		DataHash:     hex.EncodeToString(block.GetHeader().GetDataHash()),
+		Transactions: []BlockTxReport{},
+		Metadata:     describeBlockMetadata(block),
+	}
+
+	data := block.GetData().GetData()
+	for i, btx := range DecodeBlockTxs(block) {
+		report.Transactions = append(report.Transactions, describeBlockTx(btx, data[i]))
+	}
+
+	return report
+}
+
+func describeBlockTx(btx *BlockTx, data []byte) BlockTxReport {
+	r := BlockTxReport{
+		TxNum:  btx.TxNum,
+		Status: btx.Status.String(),
+		Code:   int32(btx.Status),
+	}
+	if btx.Err != nil {
+		r.Error = btx.Err.Error()
+		return r
+	}
+
+	r.TxID = btx.TxID
+	r.Type = btx.HeaderType.String()
+	r.ChannelID = btx.ChannelID
+	if !btx.Timestamp.IsZero() {
+		r.Timestamp = &btx.Timestamp
+	}
+	if btx.Creator != nil {
+		r.Creator = describeIdentity(btx.Creator)
+	}
+
+	switch {
+	case btx.Tx != nil:
+		tx, err := Inspect(btx.TxID, btx.Tx)
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.Transaction = tx
+	case btx.HeaderType == cb.HeaderType_CONFIG:
+		config, err := deepMarshalEnvelope(data)
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.Config = config
+	}
+
+	return r
+}
+
+// deepMarshalEnvelope decodes an envelope, including all nested messages, into JSON.
+func deepMarshalEnvelope(data []byte) (json.RawMessage, error) {
+	env, err := protoutil.UnmarshalEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := protolator.DeepMarshalJSON(&buf, env); err != nil {
+		return nil, fmt.Errorf("cannot decode config envelope: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func describeBlockMetadata(block *cb.Block) BlockMetadataReport {
+	var r BlockMetadataReport
+	for _, code := range blockTxStatuses(block) {
+		r.ValidationCodes = append(r.ValidationCodes, committerpb.Status(code).String())
+	}
+
+	md, err := protoutil.GetMetadataFromBlock(block, cb.BlockMetadataIndex_SIGNATURES)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	if len(md.GetValue()) > 0 {
+		obm := &cb.OrdererBlockMetadata{}
+		if err := proto.Unmarshal(md.GetValue(), obm); err != nil {
+			r.Error = fmt.Sprintf("cannot decode orderer block metadata: %v", err)
+			return r
+		}
+		if obm.GetLastConfig() != nil {
+			index := obm.GetLastConfig().GetIndex()
+			r.LastConfig = &index
+		}
+	}
+
+	for _, sig := range md.GetSignatures() {
+		s, err := describeBlockSignature(sig)
+		if err != nil {
+			r.Error = err.Error()
+			return r
+		}
+		r.Signatures = append(r.Signatures, s)
+	}
+
+	return r
+}
+
+func describeBlockSignature(sig *cb.MetadataSignature) (BlockSignatureReport, error) {
+	s := BlockSignatureReport{Signature: hex.EncodeToString(sig.GetSignature())}
+
+	if len(sig.GetIdentifierHeader()) > 0 {
+		ihdr := &cb.IdentifierHeader{}
+		if err := proto.Unmarshal(sig.GetIdentifierHeader(), ihdr); err != nil {
+			return s, fmt.Errorf("cannot decode signature identifier header: %w", err)
+		}
+		id := ihdr.GetIdentifier()
+		s.Identifier = &id
+		return s, nil
+	}
+
+	shdr, err := protoutil.UnmarshalSignatureHeader(sig.GetSignatureHeader())
+	if err != nil {
+		return s, fmt.Errorf("cannot decode signature header: %w", err)
+	}
+	if len(shdr.GetCreator()) > 0 {
+		creator, err := protoutil.UnmarshalIdentity(shdr.GetCreator())
+		if err != nil {
+			return s, fmt.Errorf("cannot decode signer: %w", err)
+		}
+		s.Signer = describeIdentity(creator)
+	}
+	return s, nil
+}
+
+// describeIdentity returns the MSP ID and certificate subject of an identity.
+// The subject is omitted if the certificate cannot be parsed.
+func describeIdentity(id *msppb.Identity) *IdentityReport {
+	r := &IdentityReport{MSPID: id.GetMspId(), CertificateID: id.GetCertificateId()}
+
+	certBytes := id.GetCertificate()
+	if block, _ := pem.Decode(certBytes); block != nil {
+		certBytes = block.Bytes
+	}
+	if cert, err := x509.ParseCertificate(certBytes); err == nil {
+		r.Subject = cert.Subject.String()
+	}
+	return r
+}
@@ -0,0 +1,140 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"encoding/json"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// someOrdererMetadata returns SIGNATURES block metadata with the last config index and
+// a signature of the given orderer and of consenter 2.
+func someOrdererMetadata(lastConfig uint64, orderer *testEndorser) []byte {
+	creator := protoutil.MarshalOrPanic(&msppb.Identity{
+		MspId:   orderer.mspID,
+		Creator: &msppb.Identity_Certificate{Certificate: orderer.cert},
+	})
+
+	return protoutil.MarshalOrPanic(&cb.Metadata{
+		Value: protoutil.MarshalOrPanic(&cb.OrdererBlockMetadata{LastConfig: &cb.LastConfig{Index: lastConfig}}),
+		Signatures: []*cb.MetadataSignature{
+			{
+				SignatureHeader: protoutil.MarshalOrPanic(&cb.SignatureHeader{Creator: creator}),
+				Signature:       []byte{0xca, 0xfe},
+			},
+			{
+				IdentifierHeader: protoutil.MarshalOrPanic(&cb.IdentifierHeader{Identifier: 2}),
+				Signature:        []byte{0xbe, 0xef},
+			},
+		},
+	})
+}
+
+func TestDescribeBlock(t *testing.T) {
+	t.Parallel()
+
+	org1 := newTestEndorser(t, "Org1MSP")
+	orderer := newTestEndorser(t, "OrdererMSP")
+
+	tx := someVerifyTx(t)
+	org1.endorse(t, "tx-1", tx)
+	config := &cb.ConfigEnvelope{Config: &cb.Config{Sequence: 3}}
+
+	block := protoutil.NewBlock(7, []byte{0x01, 0x02})
+	block.Header.DataHash = []byte{0xab}
+	block.Data.Data = [][]byte{
+		someEnvelopeBytes(t, cb.HeaderType_MESSAGE, "tx-1", protoutil.MarshalOrPanic(tx)),
+		someEnvelopeBytes(t, cb.HeaderType_CONFIG, "tx-2", protoutil.MarshalOrPanic(config)),
+		[]byte("garbage"),
+	}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = someOrdererMetadata(4, orderer)
+	block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+		byte(committerpb.Status_COMMITTED),
+		byte(committerpb.Status_COMMITTED),
+		byte(committerpb.Status_MALFORMED_BAD_ENVELOPE),
+	}
+
+	report := DescribeBlock(block)
+	require.Equal(t, uint64(7), report.Number)
+	require.Equal(t, "0102", report.PreviousHash)
+	require.Equal(t, "ab", report.DataHash)
+	require.Len(t, report.Transactions, 3)
+
+	// application transaction with a policy written to the meta-namespace
+	app := report.Transactions[0]
+	require.Empty(t, app.Error)
+	require.Equal(t, "tx-1", app.TxID)
+	require.Equal(t, "MESSAGE", app.Type)
+	require.Equal(t, "mychannel", app.ChannelID)
+	require.NotNil(t, app.Timestamp)
+	require.Equal(t, "Org1MSP", app.Creator.MSPID)
+	require.Equal(t, "COMMITTED", app.Status)
+	require.Equal(t, int32(committerpb.Status_COMMITTED), app.Code)
+	require.Nil(t, app.Config)
+	require.Len(t, app.Transaction.Namespaces, 1)
+	ns := app.Transaction.Namespaces[0]
+	require.Equal(t, committerpb.MetaNamespaceID, ns.NsID)
+	require.Equal(t, "payments", ns.ReadWrites[0].Key)
+	require.Equal(t, "msp OR('Org1MSP.member')", ns.ReadWrites[0].Policy.String())
+	require.True(t, ns.Endorsements[0].Valid)
+
+	// config transaction
+	cfg := report.Transactions[1]
+	require.Empty(t, cfg.Error)
+	require.Equal(t, "CONFIG", cfg.Type)
+	require.Nil(t, cfg.Transaction)
+	var decoded struct {
+		Payload struct {
+			Data struct {
+				Config struct {
+					Sequence string `json:"sequence"`
+				} `json:"config"`
+			} `json:"data"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(cfg.Config, &decoded))
+	require.Equal(t, "3", decoded.Payload.Data.Config.Sequence)
+
+	// undecodable envelope
+	garbage := report.Transactions[2]
+	require.NotEmpty(t, garbage.Error)
+	require.Equal(t, uint32(2), garbage.TxNum)
+	require.Equal(t, "MALFORMED_BAD_ENVELOPE", garbage.Status)
+
+	// metadata
+	md := report.Metadata
+	require.Empty(t, md.Error)
+	require.Equal(t, uint64(4), *md.LastConfig)
+	require.Equal(t, []string{"COMMITTED", "COMMITTED", "MALFORMED_BAD_ENVELOPE"}, md.ValidationCodes)
+	require.Len(t, md.Signatures, 2)
+	require.Equal(t, "OrdererMSP", md.Signatures[0].Signer.MSPID)
+	require.Equal(t, "CN=user@OrdererMSP", md.Signatures[0].Signer.Subject)
+	require.Equal(t, "cafe", md.Signatures[0].Signature)
+	require.Nil(t, md.Signatures[0].Identifier)
+	require.Equal(t, uint32(2), *md.Signatures[1].Identifier)
+	require.Nil(t, md.Signatures[1].Signer)
+
+	_, err := json.Marshal(report)
+	require.NoError(t, err)
+}
+
+func TestDescribeBlock_WithoutMetadata(t *testing.T) {
+	t.Parallel()
+
+	report := DescribeBlock(&cb.Block{Header: &cb.BlockHeader{Number: 1}})
+	require.Equal(t, uint64(1), report.Number)
+	require.Empty(t, report.Transactions)
+	require.Nil(t, report.Metadata.LastConfig)
+	require.NotEmpty(t, report.Metadata.Error)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/common/configtx"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x-common/tools/configtxlator/update"
+)
+
+// ConfigFromBlock returns the channel ID and the channel config of a config block.
+func ConfigFromBlock(block *cb.Block) (string, *cb.Config, error) {
+	env, err := protoutil.ExtractEnvelope(block, 0)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid config block: %w", err)
+	}
+	payload, err := protoutil.UnmarshalPayload(env.GetPayload())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid config block: %w", err)
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid config block: %w", err)
+	}
+	if cb.HeaderType(chdr.GetType()) != cb.HeaderType_CONFIG {
+		return "", nil, fmt.Errorf("block %d is not a config block: unexpected header type %s",
+			block.GetHeader().GetNumber(), cb.HeaderType(chdr.GetType()))
+	}
+	configEnv, err := protoutil.UnmarshalConfigEnvelope(payload.GetData())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid config block: %w", err)
+	}
+	if configEnv.GetConfig() == nil {
+		return "", nil, errors.New("invalid config block: missing config")
+	}
+
+	return chdr.GetChannelId(), configEnv.GetConfig(), nil
+}
+
+// ComputeConfigUpdate computes the config update that changes the original into the updated
+// channel config. The returned envelope carries no signatures.
+func ComputeConfigUpdate(channel string, original, updated *cb.Config) (*cb.ConfigUpdateEnvelope, error) {
+	if channel == "" {
+		return nil, errors.New("missing channel")
+	}
+
+	cu, err := update.Compute(original, updated)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute config update: %w", err)
+	}
+	cu.ChannelId = channel
+
+	return &cb.ConfigUpdateEnvelope{ConfigUpdate: protoutil.MarshalOrPanic(cu)}, nil
+}
+
+// UnmarshalConfigUpdateEnvelope decodes a config update envelope and ensures that it
+// contains a config update for a channel.
+func UnmarshalConfigUpdateEnvelope(data []byte) (*cb.ConfigUpdateEnvelope, *cb.ConfigUpdate, error) {
+	env, err := configtx.UnmarshalConfigUpdateEnvelope(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid config update envelope: %w", err)
+	}
+	cu, err := decodeConfigUpdate(env)
+	if err != nil {
+		return nil, nil, err
+	}
+	return env, cu, nil
+}
+
+// ConfigUpdateChannel returns the channel ID of the config update of an envelope.
+func ConfigUpdateChannel(env *cb.ConfigUpdateEnvelope) (string, error) {
+	cu, err := decodeConfigUpdate(env)
+	if err != nil {
+		return "", err
+	}
+	return cu.GetChannelId(), nil
+}
+
+func decodeConfigUpdate(env *cb.ConfigUpdateEnvelope) (*cb.ConfigUpdate, error) {
+	cu, err := configtx.UnmarshalConfigUpdate(env.GetConfigUpdate())
+	if err != nil {
+		return nil, fmt.Errorf("invalid config update: %w", err)
+	}
+	if cu.GetChannelId() == "" {
+		return nil, errors.New("invalid config update: missing channel ID")
+	}
+	return cu, nil
+}
+
+// SignConfigUpdate appends the signature of the signer over the config update to the envelope.
+// The signature is computed over the signature header and the serialized config update, as
+// checked against the mod policies of the modified config elements.
+func SignConfigUpdate(signer msp.SigningIdentity, env *cb.ConfigUpdateEnvelope) (*cb.ConfigUpdateEnvelope, error) {
+	if signer == nil {
+		return nil, errors.New("require Signer")
+	}
+
+	sigHdr, err := protoutil.NewSignatureHeader(signer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create signature header: %w", err)
+	}
+	sigHdrBytes := protoutil.MarshalOrPanic(sigHdr)
+
+	sig, err := signer.Sign(bytes.Join([][]byte{sigHdrBytes, env.GetConfigUpdate()}, nil))
+	if err != nil {
+		return nil, fmt.Errorf("cannot sign config update: %w", err)
+	}
+
+	signed := proto.CloneOf(env)
+	signed.Signatures = append(signed.Signatures, &cb.ConfigSignature{
+		SignatureHeader: sigHdrBytes,
+		Signature:       sig,
+	})
+	return signed, nil
+}
+
+// MergeConfigUpdates combines the signatures of multiple envelopes of the same config update.
+// Signatures are deduplicated by the MSP ID of the signer and sorted alphabetically by MSP ID.
+// Requires at least 2 envelopes.
+func MergeConfigUpdates(envs []*cb.ConfigUpdateEnvelope) (*cb.ConfigUpdateEnvelope, error) {
+	if len(envs) < 2 {
+		return nil, errors.New("at least two config updates required for merge")
+	}
+
+	type signature struct {
+		mspID string
+		sig   *cb.ConfigSignature
+	}
+	var signatures []signature
+	seen := make(map[string]struct{})
+
+	for i, env := range envs {
+		if !bytes.Equal(env.GetConfigUpdate(), envs[0].GetConfigUpdate()) {
+			return nil, fmt.Errorf("config update %d: content mismatch", i)
+		}
+
+		signers, err := ConfigUpdateSigners(env)
+		if err != nil {
+			return nil, fmt.Errorf("config update %d: %w", i, err)
+		}
+		for j, mspID := range signers {
+			if _, exists := seen[mspID]; exists {
+				continue
+			}
+			seen[mspID] = struct{}{}
+			signatures = append(signatures, signature{mspID: mspID, sig: env.GetSignatures()[j]})
+		}
+	}
+
+	// we sort signatures by the MspID of the signer
+	sort.Slice(signatures, func(i, j int) bool {
+		return signatures[i].mspID < signatures[j].mspID
+	})
+
+	merged := &cb.ConfigUpdateEnvelope{ConfigUpdate: envs[0].GetConfigUpdate()}
+	for _, s := range signatures {
+		merged.Signatures = append(merged.Signatures, s.sig)
+	}
+	return merged, nil
+}
+
+// ConfigUpdateSigners returns the MSP IDs of the signers of a config update envelope.
+func ConfigUpdateSigners(env *cb.ConfigUpdateEnvelope) ([]string, error) {
+	signed, err := protoutil.ConfigUpdateEnvelopeAsSignedData(env)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	signers := make([]string, len(signed))
+	for i, sd := range signed {
+		signers[i] = sd.Identity.GetMspId()
+	}
+	return signers, nil
+}
+
+// CreateConfigUpdateEnvelope wraps a signed config update in an envelope of type CONFIG_UPDATE
+// for the channel of the config update, signed by the submitter. It returns the transaction ID
+// of the envelope, which is derived from the nonce and the creator of the signature header.
+func CreateConfigUpdateEnvelope(
+	signer msp.SigningIdentity,
+	channel string,
+	env *cb.ConfigUpdateEnvelope,
+) (string, *cb.Envelope, error) {
+	if signer == nil {
+		return "", nil, errors.New("require Signer")
+	}
+
+	signatureHdr, err := protoutil.NewSignatureHeader(signer)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot create signature header: %w", err)
+	}
+	txID := protoutil.ComputeTxID(signatureHdr.GetNonce(), signatureHdr.GetCreator())
+
+	channelHdr := protoutil.MakeChannelHeader(cb.HeaderType_CONFIG_UPDATE, 0, channel, 0)
+	channelHdr.TxId = txID
+
+	payloadBytes := protoutil.MarshalOrPanic(&cb.Payload{
+		Header: protoutil.MakePayloadHeader(channelHdr, signatureHdr),
+		Data:   protoutil.MarshalOrPanic(env),
+	})
+
+	sig, err := signer.Sign(payloadBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return txID, &cb.Envelope{Payload: payloadBytes, Signature: sig}, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/transaction/transactiontest"
+)
+
+// someConfigUpdate returns an unsigned config update that adds Org2MSP to the application group.
+func someConfigUpdate(t *testing.T) *cb.ConfigUpdateEnvelope {
+	t.Helper()
+
+	env, err := ComputeConfigUpdate("mychannel",
+		transactiontest.ChannelConfig("Org1MSP"), transactiontest.ChannelConfig("Org1MSP", "Org2MSP"))
+	require.NoError(t, err)
+	return env
+}
+
+func TestConfigFromBlock(t *testing.T) {
+	t.Parallel()
+
+	config := transactiontest.ChannelConfig("Org1MSP")
+	block := protoutil.NewBlock(2, nil)
+	block.Data.Data = [][]byte{
+		someEnvelopeBytes(t, cb.HeaderType_CONFIG, "", protoutil.MarshalOrPanic(&cb.ConfigEnvelope{Config: config})),
+	}
+
+	channel, decoded, err := ConfigFromBlock(block)
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", channel)
+	require.True(t, proto.Equal(config, decoded))
+
+	block.Data.Data = [][]byte{someEnvelopeBytes(t, cb.HeaderType_MESSAGE, "tx-1", nil)}
+	_, _, err = ConfigFromBlock(block)
+	require.EqualError(t, err, "block 2 is not a config block: unexpected header type MESSAGE")
+
+	_, _, err = ConfigFromBlock(protoutil.NewBlock(3, nil))
+	require.ErrorContains(t, err, "invalid config block")
+}
+
+func TestComputeConfigUpdate(t *testing.T) {
+	t.Parallel()
+
+	env := someConfigUpdate(t)
+	require.Empty(t, env.GetSignatures())
+
+	_, cu, err := UnmarshalConfigUpdateEnvelope(protoutil.MarshalOrPanic(env))
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", cu.GetChannelId())
+	require.Contains(t, cu.GetWriteSet().GetGroups()["Application"].GetGroups(), "Org2MSP")
+	require.Equal(t, uint64(1), cu.GetWriteSet().GetGroups()["Application"].GetVersion())
+
+	channel, err := ConfigUpdateChannel(env)
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", channel)
+
+	_, err = ComputeConfigUpdate("mychannel",
+		transactiontest.ChannelConfig("Org1MSP"), transactiontest.ChannelConfig("Org1MSP"))
+	require.EqualError(t, err,
+		"cannot compute config update: no differences detected between original and updated config")
+
+	_, err = ComputeConfigUpdate("", transactiontest.ChannelConfig("Org1MSP"), transactiontest.ChannelConfig("Org2MSP"))
+	require.EqualError(t, err, "missing channel")
+}
+
+func TestUnmarshalConfigUpdateEnvelope_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := UnmarshalConfigUpdateEnvelope([]byte("garbage"))
+	require.ErrorContains(t, err, "invalid config update envelope")
+
+	noChannel := &cb.ConfigUpdateEnvelope{ConfigUpdate: protoutil.MarshalOrPanic(&cb.ConfigUpdate{})}
+	_, _, err = UnmarshalConfigUpdateEnvelope(protoutil.MarshalOrPanic(noChannel))
+	require.EqualError(t, err, "invalid config update: missing channel ID")
+
+	_, err = ConfigUpdateChannel(noChannel)
+	require.EqualError(t, err, "invalid config update: missing channel ID")
+}
+
+func TestSignConfigUpdate(t *testing.T) {
+	t.Parallel()
+
+	env := someConfigUpdate(t)
+
+	var signed []byte
+	signer := &mockSigningIdentity{mspID: "Org1MSP", signFunc: func(msg []byte) ([]byte, error) {
+		signed = msg
+		return []byte("org1-signature"), nil
+	}}
+	out, err := SignConfigUpdate(signer, env)
+	require.NoError(t, err)
+	require.Empty(t, env.GetSignatures(), "the input envelope must not be modified")
+	require.Len(t, out.GetSignatures(), 1)
+	require.Equal(t, []byte("org1-signature"), out.GetSignatures()[0].GetSignature())
+
+	// the signature covers the signature header and the config update
+	sig := out.GetSignatures()[0]
+	require.True(t, bytes.HasPrefix(signed, sig.GetSignatureHeader()))
+	require.True(t, bytes.HasSuffix(signed, env.GetConfigUpdate()))
+
+	signers, err := ConfigUpdateSigners(out)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Org1MSP"}, signers)
+
+	_, err = SignConfigUpdate(nil, env)
+	require.EqualError(t, err, "require Signer")
+
+	failing := &mockSigningIdentity{signFunc: func([]byte) ([]byte, error) {
+		return nil, errors.New("hsm unavailable")
+	}}
+	_, err = SignConfigUpdate(failing, env)
+	require.EqualError(t, err, "cannot sign config update: hsm unavailable")
+}
+
+func TestMergeConfigUpdates(t *testing.T) {
+	t.Parallel()
+
+	env := someConfigUpdate(t)
+	sign := func(env *cb.ConfigUpdateEnvelope, mspID string) *cb.ConfigUpdateEnvelope {
+		signed, err := SignConfigUpdate(&mockSigningIdentity{mspID: mspID}, env)
+		require.NoError(t, err)
+		return signed
+	}
+
+	org2 := sign(env, "Org2MSP")
+	org1 := sign(env, "Org1MSP")
+	both := sign(sign(env, "Org1MSP"), "Org3MSP")
+
+	merged, err := MergeConfigUpdates([]*cb.ConfigUpdateEnvelope{org2, org1, both})
+	require.NoError(t, err)
+	require.Equal(t, env.GetConfigUpdate(), merged.GetConfigUpdate())
+
+	signers, err := ConfigUpdateSigners(merged)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Org1MSP", "Org2MSP", "Org3MSP"}, signers)
+
+	_, err = MergeConfigUpdates([]*cb.ConfigUpdateEnvelope{org1})
+	require.EqualError(t, err, "at least two config updates required for merge")
+
+	other, err := ComputeConfigUpdate("mychannel",
+		transactiontest.ChannelConfig("Org1MSP"), transactiontest.ChannelConfig("Org3MSP"))
+	require.NoError(t, err)
+	_, err = MergeConfigUpdates([]*cb.ConfigUpdateEnvelope{org1, other})
+	require.EqualError(t, err, "config update 1: content mismatch")
+
+	corrupt := proto.CloneOf(org2)
+	corrupt.Signatures[0].SignatureHeader = []byte("garbage")
+	_, err = MergeConfigUpdates([]*cb.ConfigUpdateEnvelope{org1, corrupt})
+	require.ErrorContains(t, err, "config update 1: invalid signature")
+}
+
+func TestCreateConfigUpdateEnvelope(t *testing.T) {
+	t.Parallel()
+
+	env := someConfigUpdate(t)
+	txID, out, err := CreateConfigUpdateEnvelope(&mockSigningIdentity{mspID: "Org1MSP"}, "mychannel", env)
+	require.NoError(t, err)
+	require.NotEmpty(t, txID)
+	require.Equal(t, []byte("mock-signature"), out.GetSignature())
+
+	payload, err := protoutil.UnmarshalPayload(out.GetPayload())
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", chdr.GetChannelId())
+	require.Equal(t, txID, chdr.GetTxId())
+	require.Equal(t, int32(cb.HeaderType_CONFIG_UPDATE), chdr.GetType())
+
+	decoded, err := protoutil.EnvelopeToConfigUpdate(out)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(env, decoded))
+
+	_, _, err = CreateConfigUpdateEnvelope(nil, "mychannel", env)
+	require.EqualError(t, err, "require Signer")
+
+	// an identity that cannot be serialized is reported instead of panicking
+	broken := &mockSigningIdentity{mspID: "Org1MSP", serializeErr: errors.New("no identity")}
+	_, _, err = CreateConfigUpdateEnvelope(broken, "mychannel", env)
+	require.ErrorContains(t, err, "cannot create signature header")
+	require.ErrorContains(t, err, "no identity")
+}
@@ -22,8 +22,8 @@ import (
 )
 
 // Endorse signs a transaction with the provided identity for all namespaces.
-// Returns a cloned transaction with added endorsements. Currently uses threshold ECDSA;
-// MSP-based endorsement support is planned.
+// Returns a cloned transaction with added endorsements. Namespaces governed by a threshold
+// policy are endorsed with EndorseThreshold instead.
 func Endorse(signer msp.SigningIdentity, txID string, tx *applicationpb.Tx) (*applicationpb.Tx, error) {
 	if tx == nil {
 		return nil, errors.New("nil transaction")
@@ -26,6 +26,8 @@ type mockSigningIdentity struct {
 	signFunc    func([]byte) ([]byte, error)
 	certPEMFunc func() ([]byte, error)
 	mspID       string
+	// serializeErr is returned by Serialize if set.
+	serializeErr error
 }
 
 func (m *mockSigningIdentity) Sign(msg []byte) ([]byte, error) {
@@ -50,6 +52,9 @@ func (m *mockSigningIdentity) GetIdentifier() *msp.IdentityIdentifier {
 }
 
 func (m *mockSigningIdentity) Serialize() ([]byte, error) {
+	if m.serializeErr != nil {
+		return nil, m.serializeErr
+	}
 	sid := &fxmsppb.Identity{
 		MspId: m.mspID,
 	}
@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"errors"
+	"fmt"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// CreateSignedEnvelope wraps the transaction in a signed envelope for submission to the orderer.
+// The envelope contains the channel header, signature header, and transaction payload.
+func CreateSignedEnvelope(
+	signer msp.SigningIdentity,
+	channel string,
+	txID string,
+	tx *applicationpb.Tx,
+) (*cb.Envelope, error) {
+	if signer == nil {
+		return nil, errors.New("require Signer")
+	}
+
+	signatureHdr := protoutil.NewSignatureHeaderOrPanic(signer)
+
+	// prepare transaction submission
+	// create signed envelope
+	channelHdr := protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, channel, 0)
+	channelHdr.TxId = txID
+
+	payloadHdr := protoutil.MakePayloadHeader(channelHdr, signatureHdr)
+	txBytes := protoutil.MarshalOrPanic(tx)
+
+	payloadBytes := protoutil.MarshalOrPanic(
+		&cb.Payload{
+			Header: payloadHdr,
+			Data:   txBytes,
+		},
+	)
+
+	sig, err := signer.Sign(payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cb.Envelope{
+		Payload:   payloadBytes,
+		Signature: sig,
+	}, nil
+}
+
+// UnwrapEnvelope returns the transaction ID and the transaction of a serialized envelope
+// created by CreateSignedEnvelope. The envelope signature is not verified.
+func UnwrapEnvelope(data []byte) (string, *applicationpb.Tx, error) {
+	var btx BlockTx
+	if err := decodeEnvelope(data, &btx); err != nil {
+		return "", nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+	if btx.HeaderType != cb.HeaderType_MESSAGE {
+		return "", nil, fmt.Errorf("invalid envelope: unexpected header type %s", btx.HeaderType)
+	}
+	return btx.TxID, btx.Tx, nil
+}
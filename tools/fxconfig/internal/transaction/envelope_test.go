@@ -0,0 +1,65 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"errors"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+func TestCreateSignedEnvelope(t *testing.T) {
+	t.Parallel()
+
+	tx := CreateNamespacesTx(&applicationpb.NamespacePolicy{}, "payments", 0)
+	env, err := CreateSignedEnvelope(&mockSigningIdentity{mspID: "Org1MSP"}, "mychannel", "tx-1", tx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("mock-signature"), env.GetSignature())
+
+	payload, err := protoutil.UnmarshalPayload(env.GetPayload())
+	require.NoError(t, err)
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	require.NoError(t, err)
+	require.Equal(t, "mychannel", chdr.GetChannelId())
+	require.Equal(t, "tx-1", chdr.GetTxId())
+	require.Equal(t, int32(cb.HeaderType_MESSAGE), chdr.GetType())
+
+	txID, decoded, err := UnwrapEnvelope(protoutil.MarshalOrPanic(env))
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", txID)
+	require.True(t, proto.Equal(tx, decoded))
+}
+
+func TestCreateSignedEnvelope_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, err := CreateSignedEnvelope(nil, "mychannel", "tx-1", &applicationpb.Tx{})
+	require.EqualError(t, err, "require Signer")
+
+	signer := &mockSigningIdentity{signFunc: func([]byte) ([]byte, error) {
+		return nil, errors.New("hsm unavailable")
+	}}
+	_, err = CreateSignedEnvelope(signer, "mychannel", "tx-1", &applicationpb.Tx{})
+	require.EqualError(t, err, "hsm unavailable")
+}
+
+func TestUnwrapEnvelope_Errors(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := UnwrapEnvelope([]byte("not an envelope"))
+	require.ErrorContains(t, err, "invalid envelope")
+
+	config := someEnvelopeBytes(t, cb.HeaderType_CONFIG, "", nil)
+	_, _, err = UnwrapEnvelope(config)
+	require.EqualError(t, err, "invalid envelope: unexpected header type CONFIG")
+}
@@ -0,0 +1,125 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/committerpb"
+)
+
+// TxReport is a human-readable representation of a transaction.
+type TxReport struct {
+	TxID       string            `json:"txId" yaml:"txId"`
+	Namespaces []NamespaceReport `json:"namespaces" yaml:"namespaces"`
+}
+
+// NamespaceReport describes the reads, writes, and endorsements of a transaction namespace.
+type NamespaceReport struct {
+	NsID         string             `json:"name" yaml:"name"`
+	Version      uint64             `json:"version" yaml:"version"`
+	Reads        []KeyReport        `json:"reads,omitempty" yaml:"reads,omitempty"`
+	ReadWrites   []KeyReport        `json:"readWrites,omitempty" yaml:"readWrites,omitempty"`
+	BlindWrites  []KeyReport        `json:"blindWrites,omitempty" yaml:"blindWrites,omitempty"`
+	Endorsements []EndorsementCheck `json:"endorsements,omitempty" yaml:"endorsements,omitempty"`
+}
+
+// KeyReport describes a read or written key. Keys and values are rendered as text if they are
+// printable, and as hex prefixed with "0x" otherwise. Values written to the meta-namespace are
+// decoded as namespace policies.
+type KeyReport struct {
+	Key string `json:"key" yaml:"key"`
+	// Version is the read version of the key; nil if the key is expected not to exist.
+	Version     *uint64            `json:"version,omitempty" yaml:"version,omitempty"`
+	Value       string             `json:"value,omitempty" yaml:"value,omitempty"`
+	Policy      *PolicyDescription `json:"policy,omitempty" yaml:"policy,omitempty"`
+	PolicyError string             `json:"policyError,omitempty" yaml:"policyError,omitempty"`
+}
+
+// Inspect decodes a transaction into a readable report.
+// Endorsements are verified with the certificate of the endorsing identity; endorsements without
+// a certificate, e.g., of threshold policies, are reported as not verified.
+func Inspect(txID string, tx *applicationpb.Tx) (*TxReport, error) {
+	if tx == nil {
+		return nil, errors.New("nil transaction")
+	}
+
+	report := &TxReport{TxID: txID, Namespaces: make([]NamespaceReport, len(tx.GetNamespaces()))}
+	for i, ns := range tx.GetNamespaces() {
+		r := NamespaceReport{NsID: ns.GetNsId(), Version: ns.GetNsVersion()}
+		isMeta := ns.GetNsId() == committerpb.MetaNamespaceID
+
+		for _, rd := range ns.GetReadsOnly() {
+			r.Reads = append(r.Reads, KeyReport{Key: formatBytes(rd.GetKey()), Version: rd.Version})
+		}
+		for _, rw := range ns.GetReadWrites() {
+			r.ReadWrites = append(r.ReadWrites, newKeyReport(rw.GetKey(), rw.Version, rw.GetValue(), isMeta))
+		}
+		for _, w := range ns.GetBlindWrites() {
+			r.BlindWrites = append(r.BlindWrites, newKeyReport(w.GetKey(), nil, w.GetValue(), isMeta))
+		}
+
+		if i < len(tx.GetEndorsements()) {
+			msg, err := ns.ASN1Marshal(txID)
+			if err != nil {
+				return nil, fmt.Errorf("failed asn1 marshal tx: %w", err)
+			}
+			for _, e := range tx.GetEndorsements()[i].GetEndorsementsWithIdentity() {
+				check, _ := checkIdentityEndorsement(e, msg)
+				r.Endorsements = append(r.Endorsements, check)
+			}
+		}
+
+		report.Namespaces[i] = r
+	}
+
+	return report, nil
+}
+
+func newKeyReport(key []byte, version *uint64, value []byte, isMeta bool) KeyReport {
+	r := KeyReport{Key: formatBytes(key), Version: version}
+	if !isMeta {
+		r.Value = formatBytes(value)
+		return r
+	}
+
+	p, err := DescribePolicy(value)
+	if err != nil {
+		r.Value = formatBytes(value)
+		r.PolicyError = err.Error()
+		return r
+	}
+	r.Policy = p
+	return r
+}
+
+// formatBytes renders b as text if it is printable UTF-8, and as hex prefixed with "0x" otherwise.
+func formatBytes(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	if utf8.Valid(b) && isPrintable(string(b)) {
+		return string(b)
+	}
+	return "0x" + hex.EncodeToString(b)
+}
+
+func isPrintable(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	// avoid ambiguity with hex-rendered values
+	return !strings.HasPrefix(s, "0x")
+}
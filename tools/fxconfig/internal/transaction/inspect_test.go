@@ -0,0 +1,100 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+)
+
+func TestInspect_NamespaceTx(t *testing.T) {
+	t.Parallel()
+
+	tx := someVerifyTx(t)
+	endorser := newTestEndorser(t, "Org1MSP")
+	endorser.endorse(t, "tx-1", tx)
+	// an endorsement of a different transaction does not verify
+	endorser.endorse(t, "tx-other", tx)
+	tx.Endorsements[0].EndorsementsWithIdentity = append(tx.Endorsements[0].EndorsementsWithIdentity,
+		&applicationpb.EndorsementWithIdentity{Endorsement: []byte("threshold-signature")})
+
+	report, err := Inspect("tx-1", tx)
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", report.TxID)
+	require.Len(t, report.Namespaces, 1)
+
+	ns := report.Namespaces[0]
+	require.Equal(t, "_meta", ns.NsID)
+	require.Len(t, ns.ReadWrites, 1)
+	require.Equal(t, "payments", ns.ReadWrites[0].Key)
+	require.Nil(t, ns.ReadWrites[0].Version)
+	require.Equal(t, "msp OR('Org1MSP.member')", ns.ReadWrites[0].Policy.String())
+	require.Empty(t, ns.ReadWrites[0].Value)
+
+	require.Len(t, ns.Endorsements, 3)
+	require.True(t, ns.Endorsements[0].Valid)
+	require.Equal(t, "Org1MSP", ns.Endorsements[0].MSPID)
+	require.Equal(t, "CN=user@Org1MSP", ns.Endorsements[0].Subject)
+	require.NotNil(t, ns.Endorsements[0].Expires)
+	require.False(t, ns.Endorsements[1].Valid)
+	require.Equal(t, "signature mismatch", ns.Endorsements[1].Error)
+	require.False(t, ns.Endorsements[2].Valid)
+	require.Equal(t, "endorsement has no identity", ns.Endorsements[2].Error)
+}
+
+func TestInspect_DataTx(t *testing.T) {
+	t.Parallel()
+
+	version := uint64(3)
+	tx := &applicationpb.Tx{Namespaces: []*applicationpb.TxNamespace{{
+		NsId:      "payments",
+		NsVersion: 1,
+		ReadsOnly: []*applicationpb.Read{{Key: []byte("account/alice"), Version: &version}},
+		ReadWrites: []*applicationpb.ReadWrite{
+			{Key: []byte{0x00, 0xff}, Value: []byte("100")},
+		},
+		BlindWrites: []*applicationpb.Write{{Key: []byte("0xabc"), Value: []byte{0x01, 0x02}}},
+	}}}
+
+	report, err := Inspect("tx-1", tx)
+	require.NoError(t, err)
+
+	ns := report.Namespaces[0]
+	require.Equal(t, uint64(1), ns.Version)
+	require.Equal(t, []KeyReport{{Key: "account/alice", Version: &version}}, ns.Reads)
+	require.Equal(t, []KeyReport{{Key: "0x00ff", Value: "100"}}, ns.ReadWrites)
+	// text that looks like hex is rendered as hex
+	require.Equal(t, []KeyReport{{Key: "0x3078616263", Value: "0x0102"}}, ns.BlindWrites)
+	require.Empty(t, ns.Endorsements)
+}
+
+func TestInspect_UndecodablePolicy(t *testing.T) {
+	t.Parallel()
+
+	tx := &applicationpb.Tx{Namespaces: []*applicationpb.TxNamespace{{
+		NsId:       "_meta",
+		ReadWrites: []*applicationpb.ReadWrite{{Key: []byte("payments"), Value: []byte{0xff}}},
+	}}}
+
+	report, err := Inspect("tx-1", tx)
+	require.NoError(t, err)
+
+	rw := report.Namespaces[0].ReadWrites[0]
+	require.Nil(t, rw.Policy)
+	require.Equal(t, "0xff", rw.Value)
+	require.NotEmpty(t, rw.PolicyError)
+}
+
+func TestInspect_NilTransaction(t *testing.T) {
+	t.Parallel()
+
+	_, err := Inspect("tx-1", nil)
+	require.EqualError(t, err, "nil transaction")
+}
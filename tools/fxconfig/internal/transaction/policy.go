@@ -8,12 +8,19 @@ package transaction
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"os"
 
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
 	"github.com/hyperledger/fabric-x-common/common/policydsl"
 	"github.com/hyperledger/fabric-x-common/protoutil"
@@ -36,15 +43,21 @@ func CreateMspPolicy(policy string) (*applicationpb.NamespacePolicy, error) {
 	return nsPolicy, nil
 }
 
-// CreateThresholdPolicy creates a threshold ECDSA namespace policy from a PEM file.
-// The file must contain an ECDSA public key or X.509 certificate with an ECDSA key.
-func CreateThresholdPolicy(path string) (*applicationpb.NamespacePolicy, error) {
+// CreateThresholdPolicy creates a threshold namespace policy of the given signature scheme from a
+// PEM file; an empty scheme selects ECDSA. For ECDSA and EdDSA the file must contain a public key
+// or X.509 certificate with a key of the scheme; for BLS a "BLS PUBLIC KEY" block.
+func CreateThresholdPolicy(scheme, path string) (*applicationpb.NamespacePolicy, error) {
+	scheme, err := ParseThresholdScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
+
 	pkData, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	serializedPublicKey, err := getPubKeyFromPemData(pkData)
+	serializedPublicKey, err := getPubKeyFromPemData(scheme, pkData)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +65,7 @@ func CreateThresholdPolicy(path string) (*applicationpb.NamespacePolicy, error)
 	nsPolicy := &applicationpb.NamespacePolicy{
 		Rule: &applicationpb.NamespacePolicy_ThresholdRule{
 			ThresholdRule: &applicationpb.ThresholdRule{
-				Scheme:    "ECDSA",
+				Scheme:    scheme,
 				PublicKey: serializedPublicKey,
 			},
 		},
@@ -61,9 +74,112 @@ func CreateThresholdPolicy(path string) (*applicationpb.NamespacePolicy, error)
 	return nsPolicy, nil
 }
 
-// getPubKeyFromPemData extracts an ECDSA public key from PEM-encoded content.
-// It searches through multiple PEM blocks and returns the first valid ECDSA public key found.
-func getPubKeyFromPemData(pemContent []byte) ([]byte, error) {
+// Policy types of a PolicyDescription.
+const (
+	PolicyTypeMSP       = "msp"
+	PolicyTypeThreshold = "threshold"
+)
+
+// PolicyDescription is a human-readable representation of a namespace policy.
+// MSP policies are described by their DSL expression; threshold policies by their
+// signature scheme, PEM-encoded public key, and the key fingerprint.
+type PolicyDescription struct {
+	Type        string `json:"type" yaml:"type"`
+	Expression  string `json:"expression,omitempty" yaml:"expression,omitempty"`
+	Scheme      string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	PublicKey   string `json:"publicKey,omitempty" yaml:"publicKey,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+}
+
+// String returns a single-line summary of the policy.
+func (d *PolicyDescription) String() string {
+	if d.Type == PolicyTypeThreshold {
+		return fmt.Sprintf("%s %s", d.Type, d.Scheme)
+	}
+	return fmt.Sprintf("%s %s", d.Type, d.Expression)
+}
+
+// Equivalent reports whether both descriptions denote the same policy.
+// Threshold policies are compared by scheme and key fingerprint, so that the
+// encoding of the stored public key does not matter.
+func (d *PolicyDescription) Equivalent(other *PolicyDescription) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	return d.Type == other.Type &&
+		d.Expression == other.Expression &&
+		d.Scheme == other.Scheme &&
+		d.Fingerprint == other.Fingerprint
+}
+
+// DescribePolicy decodes a serialized namespace policy as stored in the meta-namespace.
+func DescribePolicy(policyBytes []byte) (*PolicyDescription, error) {
+	var p applicationpb.NamespacePolicy
+	if err := proto.Unmarshal(policyBytes, &p); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal namespace policy: %w", err)
+	}
+
+	return DescribeNamespacePolicy(&p)
+}
+
+// DescribeNamespacePolicy returns the human-readable representation of a namespace policy.
+func DescribeNamespacePolicy(p *applicationpb.NamespacePolicy) (*PolicyDescription, error) {
+	switch r := p.GetRule().(type) {
+	case *applicationpb.NamespacePolicy_MspRule:
+		var env cb.SignaturePolicyEnvelope
+		if err := proto.Unmarshal(r.MspRule, &env); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal msp rule: %w", err)
+		}
+
+		expr, err := SignaturePolicyToString(&env)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PolicyDescription{Type: PolicyTypeMSP, Expression: expr}, nil
+
+	case *applicationpb.NamespacePolicy_ThresholdRule:
+		pemKey, derKey := publicKeyPEM(r.ThresholdRule.GetScheme(), r.ThresholdRule.GetPublicKey())
+		return &PolicyDescription{
+			Type:        PolicyTypeThreshold,
+			Scheme:      r.ThresholdRule.GetScheme(),
+			PublicKey:   string(pemKey),
+			Fingerprint: KeyFingerprint(derKey),
+		}, nil
+
+	default:
+		return nil, errors.New("namespace policy has no rule")
+	}
+}
+
+// KeyFingerprint returns the SHA-256 fingerprint of a DER-encoded public key.
+func KeyFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return "SHA256:" + hex.EncodeToString(sum[:])
+}
+
+// publicKeyPEM returns the public key of a threshold policy PEM- and DER-encoded. Keys that are
+// not PEM-encoded already are assumed to be DER-encoded, except for raw EdDSA and BLS keys.
+func publicKeyPEM(scheme string, key []byte) ([]byte, []byte) {
+	if block, _ := pem.Decode(key); block != nil {
+		return key, block.Bytes
+	}
+
+	switch {
+	case scheme == SchemeEdDSA && len(key) == ed25519.PublicKeySize:
+		if der, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(key)); err == nil {
+			key = der
+		}
+	case scheme == SchemeBLS:
+		return pem.EncodeToMemory(&pem.Block{Type: blsPublicKeyBlock, Bytes: key}), key
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: key}), key
+}
+
+// getPubKeyFromPemData extracts a public key of the given scheme from PEM-encoded content, in the
+// encoding expected by the committer: PEM for ECDSA, raw bytes for EdDSA, and a compressed G2
+// point for BLS. It searches through multiple PEM blocks and returns the first valid key found.
+func getPubKeyFromPemData(scheme string, pemContent []byte) ([]byte, error) {
 	for {
 		block, rest := pem.Decode(pemContent)
 		if block == nil {
@@ -71,18 +187,26 @@ func getPubKeyFromPemData(pemContent []byte) ([]byte, error) {
 		}
 		pemContent = rest
 
-		key, err := parseCertificateOrPublicKey(block.Bytes)
-		if err != nil {
-			continue
+		switch scheme {
+		case SchemeEdDSA:
+			if key, err := parseEd25519PublicKey(block.Bytes); err == nil {
+				return key, nil
+			}
+		case SchemeBLS:
+			if key, err := parseBLSPublicKey(block); err == nil {
+				return key, nil
+			}
+		default:
+			if key, err := parseCertificateOrPublicKey(block.Bytes); err == nil {
+				return pem.EncodeToMemory(&pem.Block{
+					Type:  "PUBLIC KEY",
+					Bytes: key,
+				}), nil
+			}
 		}
-
-		return pem.EncodeToMemory(&pem.Block{
-			Type:  "PUBLIC KEY",
-			Bytes: key,
-		}), nil
 	}
 
-	return nil, errors.New("no ECDSA public key in pem file")
+	return nil, fmt.Errorf("no %s public key in pem file", scheme)
 }
 
 func parseCertificateOrPublicKey(blockBytes []byte) ([]byte, error) {
@@ -115,3 +239,31 @@ func parseCertificateOrPublicKey(blockBytes []byte) ([]byte, error) {
 	}
 	return key, nil
 }
+
+func parseEd25519PublicKey(blockBytes []byte) ([]byte, error) {
+	var publicKey any
+	if cert, err := x509.ParseCertificate(blockBytes); err == nil {
+		publicKey = cert.PublicKey
+	} else if publicKey, err = x509.ParsePKIXPublicKey(blockBytes); err != nil {
+		return nil, err
+	}
+
+	key, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an ed25519 public key")
+	}
+	return key, nil
+}
+
+func parseBLSPublicKey(block *pem.Block) ([]byte, error) {
+	if block.Type != blsPublicKeyBlock {
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+
+	var key bn254.G2Affine
+	if _, err := key.SetBytes(block.Bytes); err != nil {
+		return nil, fmt.Errorf("invalid BLS public key: %w", err)
+	}
+	b := key.Bytes()
+	return b[:], nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
 )
 
 // TestGetPubKeyFromPemData tests the getPubKeyFromPemData function.
@@ -104,7 +105,7 @@ MIIBogIBAAJBALRiMLAA
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			result, err := getPubKeyFromPemData(tt.pemContent)
+			result, err := getPubKeyFromPemData(SchemeECDSA, tt.pemContent)
 
 			if tt.expectError {
 				require.Error(t, err, tt.description)
@@ -172,7 +173,7 @@ func TestCreateThresholdPolicy(t *testing.T) {
 	t.Run("valid key file", func(t *testing.T) {
 		t.Parallel()
 
-		policy, err := CreateThresholdPolicy(keyFile)
+		policy, err := CreateThresholdPolicy("", keyFile)
 		require.NoError(t, err)
 		require.NotNil(t, policy)
 
@@ -185,8 +186,139 @@ func TestCreateThresholdPolicy(t *testing.T) {
 	t.Run("non-existent file", func(t *testing.T) {
 		t.Parallel()
 
-		policy, err := CreateThresholdPolicy(filepath.Join(tmpDir, "missing.pem"))
+		policy, err := CreateThresholdPolicy("ECDSA", filepath.Join(tmpDir, "missing.pem"))
 		require.Error(t, err)
 		require.Nil(t, policy)
 	})
+
+	t.Run("key of another scheme", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := CreateThresholdPolicy("eddsa", keyFile)
+		require.EqualError(t, err, "no EDDSA public key in pem file")
+		require.Nil(t, policy)
+
+		policy, err = CreateThresholdPolicy("bls", keyFile)
+		require.EqualError(t, err, "no BLS public key in pem file")
+		require.Nil(t, policy)
+	})
+
+	t.Run("unknown scheme", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := CreateThresholdPolicy("rsa", keyFile)
+		require.EqualError(t, err, `unsupported scheme "rsa" (want ECDSA|EDDSA|BLS)`)
+		require.Nil(t, policy)
+	})
+}
+
+func TestDescribePolicy(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	pubKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKeyDER})
+	fingerprint := KeyFingerprint(pubKeyDER)
+
+	mspPolicy, err := CreateMspPolicy("AND('Org1MSP.member', 'Org2MSP.member')")
+	require.NoError(t, err)
+
+	thresholdPolicy := func(key []byte) *applicationpb.NamespacePolicy {
+		return &applicationpb.NamespacePolicy{
+			Rule: &applicationpb.NamespacePolicy_ThresholdRule{
+				ThresholdRule: &applicationpb.ThresholdRule{Scheme: "ECDSA", PublicKey: key},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policy      []byte
+		expected    *PolicyDescription
+		expectError string
+	}{
+		{
+			name:   "msp policy",
+			policy: protoutil.MarshalOrPanic(mspPolicy),
+			expected: &PolicyDescription{
+				Type:       PolicyTypeMSP,
+				Expression: "AND('Org1MSP.member', 'Org2MSP.member')",
+			},
+		},
+		{
+			name:   "threshold policy with pem key",
+			policy: protoutil.MarshalOrPanic(thresholdPolicy(pubKeyPEM)),
+			expected: &PolicyDescription{
+				Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: string(pubKeyPEM), Fingerprint: fingerprint,
+			},
+		},
+		{
+			name:   "threshold policy with der key",
+			policy: protoutil.MarshalOrPanic(thresholdPolicy(pubKeyDER)),
+			expected: &PolicyDescription{
+				Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: string(pubKeyPEM), Fingerprint: fingerprint,
+			},
+		},
+		{
+			name:        "no rule",
+			policy:      protoutil.MarshalOrPanic(&applicationpb.NamespacePolicy{}),
+			expectError: "no rule",
+		},
+		{
+			name:        "malformed policy",
+			policy:      []byte{0xff},
+			expectError: "cannot unmarshal namespace policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := DescribePolicy(tt.policy)
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, d)
+		})
+	}
+}
+
+func TestPolicyDescription_String(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "msp OR('Org1MSP.member')",
+		(&PolicyDescription{Type: PolicyTypeMSP, Expression: "OR('Org1MSP.member')"}).String())
+	require.Equal(t, "threshold ECDSA",
+		(&PolicyDescription{Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: "key"}).String())
+}
+
+func TestKeyFingerprint(t *testing.T) {
+	t.Parallel()
+
+	// sha256 of the empty input
+	require.Equal(t, "SHA256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", KeyFingerprint(nil))
+	require.NotEqual(t, KeyFingerprint([]byte("a")), KeyFingerprint([]byte("b")))
+}
+
+func TestPolicyDescription_Equivalent(t *testing.T) {
+	t.Parallel()
+
+	msp := &PolicyDescription{Type: PolicyTypeMSP, Expression: "OR('Org1MSP.member')"}
+	threshold := &PolicyDescription{Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: "pem", Fingerprint: "SHA256:ab"}
+
+	require.True(t, msp.Equivalent(&PolicyDescription{Type: PolicyTypeMSP, Expression: "OR('Org1MSP.member')"}))
+	require.False(t, msp.Equivalent(&PolicyDescription{Type: PolicyTypeMSP, Expression: "OR('Org2MSP.member')"}))
+	require.False(t, msp.Equivalent(threshold))
+	require.False(t, msp.Equivalent(nil))
+
+	// the key encoding does not matter
+	require.True(t, threshold.Equivalent(
+		&PolicyDescription{Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: "der", Fingerprint: "SHA256:ab"}))
+	require.False(t, threshold.Equivalent(
+		&PolicyDescription{Type: PolicyTypeThreshold, Scheme: "ECDSA", PublicKey: "pem", Fingerprint: "SHA256:cd"}))
 }
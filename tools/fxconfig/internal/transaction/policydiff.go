@@ -0,0 +1,242 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"fmt"
+	"slices"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+)
+
+// PolicyDiff is a semantic comparison of a committed and a desired namespace policy.
+type PolicyDiff struct {
+	Current *PolicyDescription `json:"current" yaml:"current"`
+	Desired *PolicyDescription `json:"desired" yaml:"desired"`
+	// AddedPrincipals and RemovedPrincipals list the MSP principals, e.g., 'Org1MSP.member',
+	// that only appear in the desired or only in the committed policy.
+	AddedPrincipals   []string `json:"addedPrincipals,omitempty" yaml:"addedPrincipals,omitempty"`
+	RemovedPrincipals []string `json:"removedPrincipals,omitempty" yaml:"removedPrincipals,omitempty"`
+	// Changes describes changes of the policy type, signature thresholds, scheme, and key.
+	Changes []string `json:"changes,omitempty" yaml:"changes,omitempty"`
+}
+
+// Empty reports whether both policies are equivalent.
+func (d *PolicyDiff) Empty() bool {
+	return len(d.AddedPrincipals) == 0 && len(d.RemovedPrincipals) == 0 && len(d.Changes) == 0
+}
+
+// DiffPolicies compares the committed policy with the desired policy.
+// MSP policies are compared by their principals and by the signature threshold of every rule;
+// threshold policies are compared by scheme and public key.
+func DiffPolicies(current, desired *applicationpb.NamespacePolicy) (*PolicyDiff, error) {
+	cur, err := DescribeNamespacePolicy(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current policy: %w", err)
+	}
+	des, err := DescribeNamespacePolicy(desired)
+	if err != nil {
+		return nil, fmt.Errorf("invalid desired policy: %w", err)
+	}
+
+	diff := &PolicyDiff{Current: cur, Desired: des}
+
+	curMSP, err := mspRule(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current policy: %w", err)
+	}
+	desMSP, err := mspRule(desired)
+	if err != nil {
+		return nil, fmt.Errorf("invalid desired policy: %w", err)
+	}
+
+	// principals
+	curPrincipals, err := principalStrings(curMSP)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current policy: %w", err)
+	}
+	desPrincipals, err := principalStrings(desMSP)
+	if err != nil {
+		return nil, fmt.Errorf("invalid desired policy: %w", err)
+	}
+	diff.AddedPrincipals = subtract(desPrincipals, curPrincipals)
+	diff.RemovedPrincipals = subtract(curPrincipals, desPrincipals)
+
+	switch {
+	case cur.Type != des.Type:
+		diff.Changes = append(diff.Changes, fmt.Sprintf("policy type: %s -> %s", cur.Type, des.Type))
+
+	case cur.Type == PolicyTypeThreshold:
+		if cur.Scheme != des.Scheme {
+			diff.Changes = append(diff.Changes, fmt.Sprintf("scheme: %s -> %s", cur.Scheme, des.Scheme))
+		}
+		if cur.Fingerprint != des.Fingerprint {
+			diff.Changes = append(diff.Changes, fmt.Sprintf("public key: %s -> %s", cur.Fingerprint, des.Fingerprint))
+		}
+
+	default:
+		err := diffRules("policy", curMSP.GetRule(), desMSP.GetRule(),
+			curPrincipals, desPrincipals, &diff.Changes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
+
+// mspRule returns the signature policy of an MSP namespace policy, or nil for other policies.
+func mspRule(p *applicationpb.NamespacePolicy) (*cb.SignaturePolicyEnvelope, error) {
+	r, ok := p.GetRule().(*applicationpb.NamespacePolicy_MspRule)
+	if !ok {
+		return nil, nil
+	}
+
+	var env cb.SignaturePolicyEnvelope
+	if err := proto.Unmarshal(r.MspRule, &env); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal msp rule: %w", err)
+	}
+	return &env, nil
+}
+
+// principalStrings renders the identities of a signature policy, indexed as referenced by its rules.
+func principalStrings(env *cb.SignaturePolicyEnvelope) ([]string, error) {
+	principals := make([]string, len(env.GetIdentities()))
+	for i, id := range env.GetIdentities() {
+		p, err := principalToString(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identity %d: %w", i, err)
+		}
+		principals[i] = p
+	}
+	return principals, nil
+}
+
+// diffRules records the differences between two signature policy rules.
+// The order of sub-rules is not significant. Sub-rules that appear in both rules are ignored;
+// if exactly one sub-rule was replaced, the replacement is compared recursively.
+func diffRules(
+	path string,
+	cur, des *cb.SignaturePolicy,
+	curPrincipals, desPrincipals []string,
+	changes *[]string,
+) error {
+	curKey, err := canonicalRule(cur, curPrincipals)
+	if err != nil {
+		return err
+	}
+	desKey, err := canonicalRule(des, desPrincipals)
+	if err != nil {
+		return err
+	}
+	if curKey == desKey {
+		return nil
+	}
+
+	curGate, curOK := cur.GetType().(*cb.SignaturePolicy_NOutOf_)
+	desGate, desOK := des.GetType().(*cb.SignaturePolicy_NOutOf_)
+	if !curOK || !desOK {
+		*changes = append(*changes, fmt.Sprintf("%s: %s -> %s", path, curKey, desKey))
+		return nil
+	}
+
+	curRules, desRules := curGate.NOutOf.GetRules(), desGate.NOutOf.GetRules()
+	curN, desN := curGate.NOutOf.GetN(), desGate.NOutOf.GetN()
+	if curN != desN || len(curRules) != len(desRules) {
+		*changes = append(*changes, fmt.Sprintf("%s: requires %d of %d -> %d of %d",
+			path, curN, len(curRules), desN, len(desRules)))
+	}
+
+	// match sub-rules that appear in both rules
+	curKeys, err := canonicalRules(curRules, curPrincipals)
+	if err != nil {
+		return err
+	}
+	desKeys, err := canonicalRules(desRules, desPrincipals)
+	if err != nil {
+		return err
+	}
+	curOnly := unmatched(curKeys, desKeys)
+	desOnly := unmatched(desKeys, curKeys)
+
+	if len(curOnly) == 1 && len(desOnly) == 1 {
+		return diffRules(fmt.Sprintf("%s[%d]", path, desOnly[0]), curRules[curOnly[0]], desRules[desOnly[0]],
+			curPrincipals, desPrincipals, changes)
+	}
+	for _, i := range curOnly {
+		*changes = append(*changes, fmt.Sprintf("%s: removed %s", path, curKeys[i]))
+	}
+	for _, j := range desOnly {
+		*changes = append(*changes, fmt.Sprintf("%s: added %s", path, desKeys[j]))
+	}
+
+	return nil
+}
+
+// canonicalRule renders a rule like ruleToString, but with sorted sub-rules,
+// so that equivalent rules have the same representation.
+func canonicalRule(rule *cb.SignaturePolicy, principals []string) (string, error) {
+	gate, ok := rule.GetType().(*cb.SignaturePolicy_NOutOf_)
+	if !ok {
+		return ruleToString(rule, principals)
+	}
+
+	args, err := canonicalRules(gate.NOutOf.GetRules(), principals)
+	if err != nil {
+		return "", err
+	}
+	slices.Sort(args)
+
+	return gateToString(int(gate.NOutOf.GetN()), args), nil
+}
+
+// canonicalRules renders each rule with canonicalRule.
+func canonicalRules(rules []*cb.SignaturePolicy, principals []string) ([]string, error) {
+	keys := make([]string, len(rules))
+	for i, r := range rules {
+		k, err := canonicalRule(r, principals)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = k
+	}
+	return keys, nil
+}
+
+// unmatched returns the indices of keys that have no counterpart in other.
+// Duplicate keys are matched at most once.
+func unmatched(keys, other []string) []int {
+	available := make(map[string]int, len(other))
+	for _, k := range other {
+		available[k]++
+	}
+
+	var out []int
+	for i, k := range keys {
+		if available[k] > 0 {
+			available[k]--
+			continue
+		}
+		out = append(out, i)
+	}
+	return out
+}
+
+// subtract returns the sorted, distinct elements of a that are not in b.
+func subtract(a, b []string) []string {
+	var out []string
+	for _, s := range a {
+		if !slices.Contains(b, s) && !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
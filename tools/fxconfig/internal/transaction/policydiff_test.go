@@ -0,0 +1,149 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	t.Parallel()
+
+	threshold := func(scheme string, key []byte) *applicationpb.NamespacePolicy {
+		return &applicationpb.NamespacePolicy{
+			Rule: &applicationpb.NamespacePolicy_ThresholdRule{
+				ThresholdRule: &applicationpb.ThresholdRule{Scheme: scheme, PublicKey: key},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		current         string
+		desired         string
+		currentPolicy   *applicationpb.NamespacePolicy
+		desiredPolicy   *applicationpb.NamespacePolicy
+		expectedAdded   []string
+		expectedRemoved []string
+		expectedChanges []string
+	}{
+		{
+			name:    "equal",
+			current: "AND('Org1MSP.member', 'Org2MSP.member')",
+			desired: "AND('Org1MSP.member', 'Org2MSP.member')",
+		},
+		{
+			name:    "reordered",
+			current: "AND('Org1MSP.member', OR('Org2MSP.member', 'Org3MSP.member'))",
+			desired: "AND(OR('Org3MSP.member', 'Org2MSP.member'), 'Org1MSP.member')",
+		},
+		{
+			name:            "threshold raised",
+			current:         "OR('Org1MSP.member', 'Org2MSP.member')",
+			desired:         "AND('Org1MSP.member', 'Org2MSP.member')",
+			expectedChanges: []string{"policy: requires 1 of 2 -> 2 of 2"},
+		},
+		{
+			name:            "principal added",
+			current:         "OutOf(2, 'Org1MSP.member', 'Org2MSP.member')",
+			desired:         "OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+			expectedAdded:   []string{"'Org3MSP.member'"},
+			expectedChanges: []string{"policy: requires 2 of 2 -> 2 of 3", "policy: added 'Org3MSP.member'"},
+		},
+		{
+			name:            "principal replaced",
+			current:         "OR('Org1MSP.member', 'Org2MSP.member')",
+			desired:         "OR('Org1MSP.member', 'Org2MSP.admin')",
+			expectedAdded:   []string{"'Org2MSP.admin'"},
+			expectedRemoved: []string{"'Org2MSP.member'"},
+			expectedChanges: []string{"policy[1]: 'Org2MSP.member' -> 'Org2MSP.admin'"},
+		},
+		{
+			name:            "nested threshold changed",
+			current:         "AND('Org1MSP.member', OR('Org2MSP.member', 'Org3MSP.member'))",
+			desired:         "AND('Org1MSP.member', AND('Org2MSP.member', 'Org3MSP.member'))",
+			expectedChanges: []string{"policy[1]: requires 1 of 2 -> 2 of 2"},
+		},
+		{
+			name:            "several sub-rules replaced",
+			current:         "OR('Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+			desired:         "OR('Org1MSP.member', 'Org4MSP.member', 'Org5MSP.member')",
+			expectedAdded:   []string{"'Org4MSP.member'", "'Org5MSP.member'"},
+			expectedRemoved: []string{"'Org2MSP.member'", "'Org3MSP.member'"},
+			expectedChanges: []string{
+				"policy: removed 'Org2MSP.member'",
+				"policy: removed 'Org3MSP.member'",
+				"policy: added 'Org4MSP.member'",
+				"policy: added 'Org5MSP.member'",
+			},
+		},
+		{
+			name:            "msp to threshold",
+			current:         "OR('Org1MSP.member')",
+			desiredPolicy:   threshold("ECDSA", []byte("key")),
+			expectedRemoved: []string{"'Org1MSP.member'"},
+			expectedChanges: []string{"policy type: msp -> threshold"},
+		},
+		{
+			name:          "threshold equal",
+			currentPolicy: threshold("ECDSA", []byte("key")),
+			desiredPolicy: threshold("ECDSA", []byte("key")),
+		},
+		{
+			name:          "threshold key and scheme changed",
+			currentPolicy: threshold("ECDSA", []byte("key1")),
+			desiredPolicy: threshold("EDDSA", []byte("key2")),
+			expectedChanges: []string{
+				"scheme: ECDSA -> EDDSA",
+				"public key: " + KeyFingerprint([]byte("key1")) + " -> " + KeyFingerprint([]byte("key2")),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current, desired := tt.currentPolicy, tt.desiredPolicy
+			var err error
+			if current == nil {
+				current, err = CreateMspPolicy(tt.current)
+				require.NoError(t, err)
+			}
+			if desired == nil {
+				desired, err = CreateMspPolicy(tt.desired)
+				require.NoError(t, err)
+			}
+
+			diff, err := DiffPolicies(current, desired)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedAdded, diff.AddedPrincipals)
+			require.Equal(t, tt.expectedRemoved, diff.RemovedPrincipals)
+			require.Equal(t, tt.expectedChanges, diff.Changes)
+			require.Equal(t, len(tt.expectedChanges) == 0 && len(tt.expectedAdded) == 0, diff.Empty())
+			require.NotNil(t, diff.Current)
+			require.NotNil(t, diff.Desired)
+		})
+	}
+}
+
+func TestDiffPolicies_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	valid, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+
+	_, err = DiffPolicies(&applicationpb.NamespacePolicy{}, valid)
+	require.ErrorContains(t, err, "invalid current policy")
+
+	_, err = DiffPolicies(valid, &applicationpb.NamespacePolicy{})
+	require.ErrorContains(t, err, "invalid desired policy")
+}
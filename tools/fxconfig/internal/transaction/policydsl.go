@@ -0,0 +1,92 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mb "github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+)
+
+// SignaturePolicyToString renders a signature policy as a policy DSL expression.
+// It is the inverse of policydsl.FromString, e.g., "AND('Org1MSP.member', 'Org2MSP.admin')".
+// Rules requiring one signature are rendered as OR, rules requiring all signatures as AND,
+// and any other threshold as OutOf.
+func SignaturePolicyToString(env *cb.SignaturePolicyEnvelope) (string, error) {
+	if env.GetRule() == nil {
+		return "", errors.New("signature policy has no rule")
+	}
+
+	principals := make([]string, len(env.GetIdentities()))
+	for i, id := range env.GetIdentities() {
+		p, err := principalToString(id)
+		if err != nil {
+			return "", fmt.Errorf("invalid identity %d: %w", i, err)
+		}
+		principals[i] = p
+	}
+
+	return ruleToString(env.GetRule(), principals)
+}
+
+// ruleToString renders a signature policy rule, referring to principals by index.
+func ruleToString(rule *cb.SignaturePolicy, principals []string) (string, error) {
+	switch r := rule.GetType().(type) {
+	case *cb.SignaturePolicy_SignedBy:
+		if r.SignedBy < 0 || int(r.SignedBy) >= len(principals) {
+			return "", fmt.Errorf("identity index %d out of range", r.SignedBy)
+		}
+		return principals[r.SignedBy], nil
+
+	case *cb.SignaturePolicy_NOutOf_:
+		rules := r.NOutOf.GetRules()
+		args := make([]string, len(rules))
+		for i, sub := range rules {
+			s, err := ruleToString(sub, principals)
+			if err != nil {
+				return "", err
+			}
+			args[i] = s
+		}
+
+		return gateToString(int(r.NOutOf.GetN()), args), nil
+
+	default:
+		return "", fmt.Errorf("unknown signature policy type %T", r)
+	}
+}
+
+// gateToString renders a rule requiring n of the rendered sub-rules.
+func gateToString(n int, args []string) string {
+	switch {
+	case n == 1:
+		return fmt.Sprintf("OR(%s)", strings.Join(args, ", "))
+	case n == len(args):
+		return fmt.Sprintf("AND(%s)", strings.Join(args, ", "))
+	default:
+		return fmt.Sprintf("OutOf(%d, %s)", n, strings.Join(args, ", "))
+	}
+}
+
+// principalToString renders an MSP role principal as 'MSPID.role'.
+// Only role principals can be expressed in the policy DSL.
+func principalToString(principal *mb.MSPPrincipal) (string, error) {
+	if principal.GetPrincipalClassification() != mb.MSPPrincipal_ROLE {
+		return "", fmt.Errorf("unsupported principal classification %s", principal.GetPrincipalClassification())
+	}
+
+	var role mb.MSPRole
+	if err := proto.Unmarshal(principal.GetPrincipal(), &role); err != nil {
+		return "", fmt.Errorf("cannot unmarshal msp role: %w", err)
+	}
+
+	return fmt.Sprintf("'%s.%s'", role.GetMspIdentifier(), strings.ToLower(role.GetRole().String())), nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mb "github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/common/policydsl"
+)
+
+func TestSignaturePolicyToString_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policy   string
+		expected string
+	}{
+		{
+			name:     "single member",
+			policy:   "OR('Org1MSP.member')",
+			expected: "OR('Org1MSP.member')",
+		},
+		{
+			name:     "and",
+			policy:   "AND('Org1MSP.member', 'Org2MSP.admin')",
+			expected: "AND('Org1MSP.member', 'Org2MSP.admin')",
+		},
+		{
+			name:     "or",
+			policy:   "OR('Org1MSP.peer', 'Org2MSP.client')",
+			expected: "OR('Org1MSP.peer', 'Org2MSP.client')",
+		},
+		{
+			name:     "out of",
+			policy:   "OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+			expected: "OutOf(2, 'Org1MSP.member', 'Org2MSP.member', 'Org3MSP.member')",
+		},
+		{
+			name:     "nested",
+			policy:   "AND('Org1MSP.admin', OR('Org2MSP.member', 'Org3MSP.member'))",
+			expected: "AND('Org1MSP.admin', OR('Org2MSP.member', 'Org3MSP.member'))",
+		},
+		{
+			name:     "single and is rendered as or",
+			policy:   "AND('Org1MSP.member')",
+			expected: "OR('Org1MSP.member')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env, err := policydsl.FromString(tt.policy)
+			require.NoError(t, err)
+
+			s, err := SignaturePolicyToString(env)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, s)
+
+			// the rendered expression must parse into the same policy
+			reparsed, err := policydsl.FromString(s)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(env, reparsed))
+		})
+	}
+}
+
+func TestSignaturePolicyToString_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		env         *cb.SignaturePolicyEnvelope
+		expectError string
+	}{
+		{
+			name:        "no rule",
+			env:         &cb.SignaturePolicyEnvelope{},
+			expectError: "no rule",
+		},
+		{
+			name: "identity index out of range",
+			env: &cb.SignaturePolicyEnvelope{
+				Rule: policydsl.SignedBy(1),
+			},
+			expectError: "identity index 1 out of range",
+		},
+		{
+			name: "unsupported principal",
+			env: &cb.SignaturePolicyEnvelope{
+				Rule: policydsl.SignedBy(0),
+				Identities: []*mb.MSPPrincipal{
+					{PrincipalClassification: mb.MSPPrincipal_IDENTITY, Principal: []byte("cert")},
+				},
+			},
+			expectError: "unsupported principal classification",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := SignaturePolicyToString(tt.env)
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
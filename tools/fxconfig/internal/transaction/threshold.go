@@ -0,0 +1,288 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-committer/utils/signature"
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+)
+
+// Signature schemes of threshold policies, as named by the committer.
+const (
+	SchemeECDSA = signature.Ecdsa
+	SchemeEdDSA = signature.Eddsa
+	SchemeBLS   = signature.Bls
+)
+
+// thresholdSchemes lists the supported signature schemes of threshold policies.
+var thresholdSchemes = []string{SchemeECDSA, SchemeEdDSA, SchemeBLS}
+
+// eddsaContext is the Ed25519ctx context string of EdDSA signatures verified by the committer.
+const eddsaContext = "Example_ed25519ctx"
+
+// PEM block types of BLS keys. The public key is a compressed bn254 G2 point; the private key is
+// the big-endian scalar.
+const (
+	blsPublicKeyBlock  = "BLS PUBLIC KEY"
+	blsPrivateKeyBlock = "BLS PRIVATE KEY"
+)
+
+// ParseThresholdScheme returns the committer name of a threshold signature scheme, e.g., EDDSA for
+// "eddsa". An empty scheme selects ECDSA.
+func ParseThresholdScheme(scheme string) (string, error) {
+	if scheme == "" {
+		return SchemeECDSA, nil
+	}
+
+	s := strings.ToUpper(scheme)
+	if !slices.Contains(thresholdSchemes, s) {
+		return "", fmt.Errorf("unsupported scheme %q (want %s)", scheme, strings.Join(thresholdSchemes, "|"))
+	}
+	return s, nil
+}
+
+// SplitSchemePath splits a threshold key reference of the form "[scheme:]path", e.g.,
+// "eddsa:/path/key.pem". The scheme is empty if the reference has no scheme prefix.
+func SplitSchemePath(ref string) (string, string) {
+	prefix, path, ok := strings.Cut(ref, ":")
+	// a single letter is a drive letter rather than a scheme
+	if !ok || len(prefix) < 2 || strings.ContainsFunc(prefix, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		return "", ref
+	}
+	return prefix, path
+}
+
+// ThresholdSigner signs namespaces governed by a threshold policy with the private key
+// matching the public key of the policy.
+type ThresholdSigner interface {
+	// Scheme returns the signature scheme of the threshold policy, e.g., ECDSA.
+	Scheme() string
+	// SignDigest signs the SHA-256 digest of a namespace.
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// LoadThresholdSigner reads the private key of a threshold policy from a PEM file.
+//
+//nolint:ireturn
+func LoadThresholdSigner(scheme, path string) (ThresholdSigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := NewThresholdSigner(scheme, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid threshold key %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// NewThresholdSigner creates a signer for the given scheme from a PEM-encoded private key;
+// an empty scheme selects ECDSA. ECDSA keys are accepted in PKCS#8 ("PRIVATE KEY") and SEC 1
+// ("EC PRIVATE KEY") encoding, EdDSA keys in PKCS#8 encoding, and BLS keys as "BLS PRIVATE KEY".
+//
+//nolint:ireturn
+func NewThresholdSigner(scheme string, key []byte) (ThresholdSigner, error) {
+	scheme, err := ParseThresholdScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	switch scheme {
+	case SchemeEdDSA:
+		k, err := parseEd25519PrivateKey(block)
+		if err != nil {
+			return nil, err
+		}
+		return &eddsaThresholdSigner{key: k}, nil
+	case SchemeBLS:
+		k, err := parseBLSPrivateKey(block)
+		if err != nil {
+			return nil, err
+		}
+		return &blsThresholdSigner{key: k}, nil
+	default:
+		k, err := parseECDSAPrivateKey(block)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsaThresholdSigner{key: k}, nil
+	}
+}
+
+// ecdsaThresholdSigner signs with an ECDSA key; signatures are ASN.1 DER-encoded.
+type ecdsaThresholdSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (*ecdsaThresholdSigner) Scheme() string {
+	return SchemeECDSA
+}
+
+func (s *ecdsaThresholdSigner) SignDigest(digest []byte) ([]byte, error) {
+	return ecdsa.SignASN1(rand.Reader, s.key, digest)
+}
+
+// eddsaThresholdSigner signs with an Ed25519 key in the Ed25519ctx variant.
+type eddsaThresholdSigner struct {
+	key ed25519.PrivateKey
+}
+
+func (*eddsaThresholdSigner) Scheme() string {
+	return SchemeEdDSA
+}
+
+func (s *eddsaThresholdSigner) SignDigest(digest []byte) ([]byte, error) {
+	return s.key.Sign(nil, digest, &ed25519.Options{Context: eddsaContext})
+}
+
+// blsThresholdSigner signs with a BLS key on bn254; signatures are compressed G1 points.
+type blsThresholdSigner struct {
+	key *big.Int
+}
+
+func (*blsThresholdSigner) Scheme() string {
+	return SchemeBLS
+}
+
+func (s *blsThresholdSigner) SignDigest(digest []byte) ([]byte, error) {
+	h, err := bn254.HashToG1(digest, []byte(signature.BlsHashPrefix))
+	if err != nil {
+		return nil, err
+	}
+	sig := h.ScalarMultiplication(&h, s.key).Bytes()
+	return sig[:], nil
+}
+
+func parseECDSAPrivateKey(block *pem.Block) (*ecdsa.PrivateKey, error) {
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		k, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+func parseEd25519PrivateKey(block *pem.Block) (ed25519.PrivateKey, error) {
+	if block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return k, nil
+}
+
+func parseBLSPrivateKey(block *pem.Block) (*big.Int, error) {
+	if block.Type != blsPrivateKeyBlock {
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+
+	k := new(big.Int).SetBytes(block.Bytes)
+	if k.Sign() == 0 || k.Cmp(fr.Modulus()) >= 0 {
+		return nil, errors.New("invalid BLS private key")
+	}
+	return k, nil
+}
+
+// EndorseThreshold signs the namespaces of a transaction with the key of a threshold policy.
+// Only the namespaces in nsIDs are endorsed; all namespaces if nsIDs is empty.
+// Returns a cloned transaction with added endorsements.
+//
+// The committer verifies only the first endorsement of a namespace with a threshold policy, so
+// the endorsement is placed first. It carries no identity; an earlier endorsement without identity
+// is replaced.
+func EndorseThreshold(
+	signer ThresholdSigner,
+	txID string,
+	tx *applicationpb.Tx,
+	nsIDs []string,
+) (*applicationpb.Tx, error) {
+	if tx == nil {
+		return nil, errors.New("nil transaction")
+	}
+
+	for _, nsID := range nsIDs {
+		if !slices.ContainsFunc(tx.GetNamespaces(), func(ns *applicationpb.TxNamespace) bool {
+			return ns.GetNsId() == nsID
+		}) {
+			return nil, fmt.Errorf("namespace %s is not part of the transaction", nsID)
+		}
+	}
+
+	tx = proto.CloneOf(tx)
+	for len(tx.Endorsements) < len(tx.GetNamespaces()) {
+		tx.Endorsements = append(tx.Endorsements, &applicationpb.Endorsements{})
+	}
+
+	for nsIdx, ns := range tx.GetNamespaces() {
+		if len(nsIDs) > 0 && !slices.Contains(nsIDs, ns.GetNsId()) {
+			continue
+		}
+
+		msg, err := ns.ASN1Marshal(txID)
+		if err != nil {
+			return nil, fmt.Errorf("failed asn1 marshal tx: %w", err)
+		}
+
+		digest := sha256.Sum256(msg)
+		sig, err := signer.SignDigest(digest[:])
+		if err != nil {
+			return nil, fmt.Errorf("failed signing tx: %w", err)
+		}
+
+		endorsements := tx.Endorsements[nsIdx].GetEndorsementsWithIdentity()
+		if len(endorsements) > 0 && endorsements[0].GetIdentity() == nil {
+			endorsements = endorsements[1:]
+		}
+		tx.Endorsements[nsIdx].EndorsementsWithIdentity = append(
+			[]*applicationpb.EndorsementWithIdentity{{Endorsement: sig}}, endorsements...)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,273 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-committer/service/verifier/policy"
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// writeThresholdKeys writes a fresh ECDSA key pair to PEM files and returns the paths of the
+// private key, in the given PEM block type, and of the public key.
+func writeThresholdKeys(t *testing.T, blockType string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var der []byte
+	if blockType == "EC PRIVATE KEY" {
+		der, err = x509.MarshalECPrivateKey(key)
+	} else {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+	}
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return writeKeyPair(t, &pem.Block{Type: blockType, Bytes: der}, &pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+}
+
+// writeEdDSAThresholdKeys writes a fresh Ed25519 key pair to PEM files and returns the paths of
+// the private and public key.
+func writeEdDSAThresholdKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	return writeKeyPair(t, &pem.Block{Type: "PRIVATE KEY", Bytes: der}, &pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+}
+
+// writeBLSThresholdKeys writes a fresh BLS key pair to PEM files and returns the paths of the
+// private and public key.
+func writeBLSThresholdKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	sk, err := rand.Int(rand.Reader, fr.Modulus())
+	require.NoError(t, err)
+	_, _, _, g2 := bn254.Generators()
+	var pub bn254.G2Affine
+	pubBytes := pub.ScalarMultiplication(&g2, sk).Bytes()
+
+	return writeKeyPair(t,
+		&pem.Block{Type: "BLS PRIVATE KEY", Bytes: sk.Bytes()},
+		&pem.Block{Type: "BLS PUBLIC KEY", Bytes: pubBytes[:]})
+}
+
+// writeKeyPair writes the PEM blocks of a key pair to files and returns their paths.
+func writeKeyPair(t *testing.T, key, pub *pem.Block) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key.pem")
+	pubFile := filepath.Join(dir, "pub.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(key), 0o600))
+	require.NoError(t, os.WriteFile(pubFile, pem.EncodeToMemory(pub), 0o600))
+
+	return keyFile, pubFile
+}
+
+func TestEndorseThreshold_CommitterVerifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		scheme string
+		keys   func(t *testing.T) (string, string)
+	}{
+		{
+			name:   "ECDSA PKCS#8",
+			scheme: "ecdsa",
+			keys: func(t *testing.T) (string, string) {
+				t.Helper()
+				return writeThresholdKeys(t, "PRIVATE KEY")
+			},
+		},
+		{
+			name:   "ECDSA SEC 1",
+			scheme: "",
+			keys: func(t *testing.T) (string, string) {
+				t.Helper()
+				return writeThresholdKeys(t, "EC PRIVATE KEY")
+			},
+		},
+		{name: "EdDSA", scheme: "eddsa", keys: writeEdDSAThresholdKeys},
+		{name: "BLS", scheme: "BLS", keys: writeBLSThresholdKeys},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyFile, pubFile := tt.keys(t)
+			nsPolicy, err := CreateThresholdPolicy(tt.scheme, pubFile)
+			require.NoError(t, err)
+
+			signer, err := LoadThresholdSigner(tt.scheme, keyFile)
+			require.NoError(t, err)
+			require.Equal(t, nsPolicy.GetThresholdRule().GetScheme(), signer.Scheme())
+
+			tx := &applicationpb.Tx{Namespaces: []*applicationpb.TxNamespace{
+				{NsId: "payments", NsVersion: 1, BlindWrites: []*applicationpb.Write{{Key: []byte("k"), Value: []byte("v")}}},
+			}}
+			endorsed, err := EndorseThreshold(signer, "tx-1", tx, nil)
+			require.NoError(t, err)
+			require.Empty(t, tx.GetEndorsements(), "input must not be modified")
+
+			// the committer's verifier accepts the endorsement
+			v, err := policy.CreateNamespaceVerifier(&applicationpb.PolicyItem{
+				Namespace: "payments",
+				Policy:    protoutil.MarshalOrPanic(nsPolicy),
+			}, nil)
+			require.NoError(t, err)
+			require.NoError(t, v.VerifyNs("tx-1", endorsed, 0))
+			require.Error(t, v.VerifyNs("tx-2", endorsed, 0))
+
+			res, err := VerifyEndorsements("tx-1", endorsed, 0, nsPolicy)
+			require.NoError(t, err)
+			require.True(t, res.Satisfied, res.Error)
+
+			// the stored key is described as a PEM key with a stable fingerprint
+			desc, err := DescribeNamespacePolicy(nsPolicy)
+			require.NoError(t, err)
+			block, _ := pem.Decode([]byte(desc.PublicKey))
+			require.NotNil(t, block)
+			require.Equal(t, KeyFingerprint(block.Bytes), desc.Fingerprint)
+		})
+	}
+}
+
+func TestSplitSchemePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref    string
+		scheme string
+		path   string
+	}{
+		{ref: "/path/key.pem", path: "/path/key.pem"},
+		{ref: "eddsa:/path/key.pem", scheme: "eddsa", path: "/path/key.pem"},
+		{ref: "BLS:keys/key.pem", scheme: "BLS", path: "keys/key.pem"},
+		{ref: `C:\keys\key.pem`, path: `C:\keys\key.pem`},
+		{ref: "keys/a:b.pem", path: "keys/a:b.pem"},
+	}
+
+	for _, tt := range tests {
+		scheme, path := SplitSchemePath(tt.ref)
+		require.Equal(t, tt.scheme, scheme, tt.ref)
+		require.Equal(t, tt.path, path, tt.ref)
+	}
+}
+
+func TestEndorseThreshold_Namespaces(t *testing.T) {
+	t.Parallel()
+
+	keyFile, _ := writeThresholdKeys(t, "PRIVATE KEY")
+	signer, err := LoadThresholdSigner("ECDSA", keyFile)
+	require.NoError(t, err)
+
+	endorser := newTestEndorser(t, "Org1MSP")
+	tx := &applicationpb.Tx{Namespaces: []*applicationpb.TxNamespace{{NsId: "payments"}, {NsId: "assets"}}}
+	endorser.endorse(t, "tx-1", tx)
+
+	endorsed, err := EndorseThreshold(signer, "tx-1", tx, []string{"payments"})
+	require.NoError(t, err)
+
+	// the threshold endorsement is placed before the MSP endorsement
+	payments := endorsed.GetEndorsements()[0].GetEndorsementsWithIdentity()
+	require.Len(t, payments, 2)
+	require.Nil(t, payments[0].GetIdentity())
+	require.NotNil(t, payments[1].GetIdentity())
+
+	// namespaces that are not selected are not endorsed
+	require.Len(t, endorsed.GetEndorsements(), 2)
+	require.Empty(t, endorsed.GetEndorsements()[1].GetEndorsementsWithIdentity())
+
+	// a repeated threshold endorsement replaces the previous one
+	again, err := EndorseThreshold(signer, "tx-1", endorsed, []string{"payments"})
+	require.NoError(t, err)
+	require.Len(t, again.GetEndorsements()[0].GetEndorsementsWithIdentity(), 2)
+
+	_, err = EndorseThreshold(signer, "tx-1", tx, []string{"unknown"})
+	require.EqualError(t, err, "namespace unknown is not part of the transaction")
+
+	_, err = EndorseThreshold(signer, "tx-1", nil, nil)
+	require.EqualError(t, err, "nil transaction")
+}
+
+func TestLoadThresholdSigner_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		return path
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	keyFile, pubFile := writeThresholdKeys(t, "PRIVATE KEY")
+
+	tests := []struct {
+		name        string
+		scheme      string
+		path        string
+		expectError string
+	}{
+		{name: "missing file", scheme: "ECDSA", path: filepath.Join(dir, "missing.pem"), expectError: "no such file"},
+		{name: "not PEM", scheme: "ECDSA", path: write("text", []byte("key")), expectError: "no PEM data found"},
+		{name: "public key", scheme: "ECDSA", path: pubFile, expectError: `unsupported PEM block type "PUBLIC KEY"`},
+		{
+			name:        "not ECDSA",
+			scheme:      "ECDSA",
+			path:        write("ed25519.pem", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDER})),
+			expectError: "unsupported private key type ed25519.PrivateKey",
+		},
+		{name: "unknown scheme", scheme: "RSA", path: keyFile, expectError: `unsupported scheme "RSA"`},
+		{name: "ECDSA key for EdDSA", scheme: "EDDSA", path: keyFile, expectError: "unsupported private key type"},
+		{name: "ECDSA key for BLS", scheme: "BLS", path: keyFile, expectError: `unsupported PEM block type "PRIVATE KEY"`},
+		{
+			name:        "BLS key out of range",
+			scheme:      "BLS",
+			path:        write("bls.pem", pem.EncodeToMemory(&pem.Block{Type: "BLS PRIVATE KEY", Bytes: []byte{0}})),
+			expectError: "invalid BLS private key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LoadThresholdSigner(tt.scheme, tt.path)
+			require.ErrorContains(t, err, tt.expectError)
+		})
+	}
+}
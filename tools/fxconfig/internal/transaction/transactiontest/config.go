@@ -0,0 +1,26 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package transactiontest provides channel config fixtures for tests.
+package transactiontest
+
+import (
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+
+	"github.com/hyperledger/fabric-x-common/common/channelconfig"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// ChannelConfig returns a channel config with the given organizations in the application group.
+func ChannelConfig(orgs ...string) *cb.Config {
+	app := protoutil.NewConfigGroup()
+	for _, org := range orgs {
+		app.Groups[org] = protoutil.NewConfigGroup()
+	}
+	channel := protoutil.NewConfigGroup()
+	channel.Groups[channelconfig.ApplicationGroupKey] = app
+	return &cb.Config{Sequence: 1, ChannelGroup: channel}
+}
@@ -0,0 +1,331 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mb "github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-committer/utils/signature"
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+)
+
+// EndorsementCheck is the verification result of a single endorsement.
+// Subject and Expires are taken from the certificate of the endorsing identity, if any.
+type EndorsementCheck struct {
+	MSPID   string     `json:"mspId,omitempty" yaml:"mspId,omitempty"`
+	Subject string     `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Expires *time.Time `json:"expires,omitempty" yaml:"expires,omitempty"`
+	Valid   bool       `json:"valid" yaml:"valid"`
+	Error   string     `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// PrincipalCheck reports whether a principal of an MSP policy is matched by a valid endorsement.
+type PrincipalCheck struct {
+	Principal string `json:"principal" yaml:"principal"`
+	Satisfied bool   `json:"satisfied" yaml:"satisfied"`
+}
+
+// NamespaceVerification is the result of evaluating the endorsements of a transaction namespace
+// against the namespace policy.
+type NamespaceVerification struct {
+	NsID         string             `json:"name" yaml:"name"`
+	Policy       *PolicyDescription `json:"policy,omitempty" yaml:"policy,omitempty"`
+	Endorsements []EndorsementCheck `json:"endorsements" yaml:"endorsements"`
+	// Principals is only set for MSP policies.
+	Principals []PrincipalCheck `json:"principals,omitempty" yaml:"principals,omitempty"`
+	Satisfied  bool             `json:"satisfied" yaml:"satisfied"`
+	// Error explains why the policy is not satisfied, or why it could not be evaluated.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// VerifyEndorsements verifies every endorsement of the namespace at nsIdx over
+// TxNamespace.ASN1Marshal(txID) and evaluates the namespace policy like the committer does.
+//
+// MSP identities are verified offline with the public key of the embedded certificate.
+// Certificate chains are not validated against the channel MSPs; roles other than member
+// are matched by the node OU of the certificate, e.g., OU=admin.
+func VerifyEndorsements(
+	txID string,
+	tx *applicationpb.Tx,
+	nsIdx int,
+	nsPolicy *applicationpb.NamespacePolicy,
+) (*NamespaceVerification, error) {
+	if nsIdx < 0 || nsIdx >= len(tx.GetNamespaces()) {
+		return nil, fmt.Errorf("namespace index %d out of range", nsIdx)
+	}
+	ns := tx.GetNamespaces()[nsIdx]
+
+	desc, err := DescribeNamespacePolicy(nsPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ns.ASN1Marshal(txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed asn1 marshal tx: %w", err)
+	}
+
+	var endorsements []*applicationpb.EndorsementWithIdentity
+	if nsIdx < len(tx.GetEndorsements()) {
+		endorsements = tx.GetEndorsements()[nsIdx].GetEndorsementsWithIdentity()
+	}
+
+	res := &NamespaceVerification{NsID: ns.GetNsId(), Policy: desc}
+	if desc.Type == PolicyTypeThreshold {
+		err = verifyThreshold(res, nsPolicy.GetThresholdRule(), msg, endorsements)
+	} else {
+		err = verifyMSP(res, nsPolicy.GetMspRule(), msg, endorsements)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// verifyThreshold verifies the endorsements with the policy key.
+// As in the committer, only the first endorsement is relevant for the policy.
+func verifyThreshold(
+	res *NamespaceVerification,
+	rule *applicationpb.ThresholdRule,
+	msg []byte,
+	endorsements []*applicationpb.EndorsementWithIdentity,
+) error {
+	res.Endorsements = make([]EndorsementCheck, len(endorsements))
+	for i, e := range endorsements {
+		res.Endorsements[i].MSPID = e.GetIdentity().GetMspId()
+	}
+
+	scheme := strings.ToUpper(rule.GetScheme())
+	if scheme == signature.NoScheme || scheme == "" {
+		// the committer accepts any endorsement
+		res.Satisfied = true
+		return nil
+	}
+
+	v, err := signature.NewNsVerifierFromKey(scheme, rule.GetPublicKey())
+	if err != nil {
+		return fmt.Errorf("invalid threshold policy: %w", err)
+	}
+
+	for i, e := range endorsements {
+		if err := v.Verify(msg, []*applicationpb.EndorsementWithIdentity{e}); err != nil {
+			res.Endorsements[i].Error = err.Error()
+			continue
+		}
+		res.Endorsements[i].Valid = true
+	}
+
+	switch {
+	case len(endorsements) == 0:
+		res.Error = "no endorsements"
+	case !res.Endorsements[0].Valid:
+		res.Error = "first endorsement does not match the policy key"
+	default:
+		res.Satisfied = true
+	}
+	return nil
+}
+
+// verifyMSP verifies the endorsements with the certificates of the endorsing identities
+// and evaluates the signature policy.
+func verifyMSP(
+	res *NamespaceVerification,
+	mspRule []byte,
+	msg []byte,
+	endorsements []*applicationpb.EndorsementWithIdentity,
+) error {
+	var env cb.SignaturePolicyEnvelope
+	if err := proto.Unmarshal(mspRule, &env); err != nil {
+		return fmt.Errorf("cannot unmarshal msp rule: %w", err)
+	}
+
+	principals := make([]*mb.MSPRole, len(env.GetIdentities()))
+	res.Principals = make([]PrincipalCheck, len(env.GetIdentities()))
+	for i, id := range env.GetIdentities() {
+		p, err := principalToString(id)
+		if err != nil {
+			return fmt.Errorf("invalid identity %d: %w", i, err)
+		}
+		var role mb.MSPRole
+		if err := proto.Unmarshal(id.GetPrincipal(), &role); err != nil {
+			return fmt.Errorf("cannot unmarshal msp role: %w", err)
+		}
+		principals[i] = &role
+		res.Principals[i].Principal = p
+	}
+
+	// verified identities, deduplicated as the committer does before evaluating the policy
+	var signers []*signer
+	res.Endorsements = make([]EndorsementCheck, len(endorsements))
+	for i, e := range endorsements {
+		var s *signer
+		res.Endorsements[i], s = checkIdentityEndorsement(e, msg)
+		if s != nil && !slices.ContainsFunc(signers, s.equal) {
+			signers = append(signers, s)
+		}
+	}
+
+	for i, role := range principals {
+		res.Principals[i].Satisfied = slices.ContainsFunc(signers, func(s *signer) bool {
+			return s.satisfies(role)
+		})
+	}
+
+	if len(endorsements) == 0 {
+		res.Error = "no endorsements"
+		return nil
+	}
+	if !evaluateRule(env.GetRule(), principals, signers, make([]bool, len(signers))) {
+		res.Error = "endorsements do not satisfy the policy"
+		return nil
+	}
+	res.Satisfied = true
+	return nil
+}
+
+// checkIdentityEndorsement verifies an endorsement with the certificate of its identity.
+// Returns the signer if the signature is valid.
+func checkIdentityEndorsement(e *applicationpb.EndorsementWithIdentity, msg []byte) (EndorsementCheck, *signer) {
+	check := EndorsementCheck{MSPID: e.GetIdentity().GetMspId()}
+
+	s, err := verifyIdentitySignature(e, msg)
+	if s != nil {
+		check.Subject = s.cert.Subject.String()
+		check.Expires = &s.cert.NotAfter
+	}
+	if err != nil {
+		check.Error = err.Error()
+		return check, nil
+	}
+
+	check.Valid = true
+	return check, s
+}
+
+// signer is an endorsing identity with a verified signature.
+type signer struct {
+	mspID string
+	cert  *x509.Certificate
+}
+
+func (s *signer) equal(other *signer) bool {
+	return s.mspID == other.mspID && bytes.Equal(s.cert.Raw, other.cert.Raw)
+}
+
+// satisfies reports whether the signer has the given role.
+// Members of an MSP satisfy any member principal; other roles require the matching node OU.
+func (s *signer) satisfies(role *mb.MSPRole) bool {
+	if s.mspID != role.GetMspIdentifier() {
+		return false
+	}
+	if role.GetRole() == mb.MSPRole_MEMBER {
+		return true
+	}
+	return slices.ContainsFunc(s.cert.Subject.OrganizationalUnit, func(ou string) bool {
+		return strings.EqualFold(ou, role.GetRole().String())
+	})
+}
+
+// evaluateRule evaluates a signature policy rule like the committer's policy engine:
+// every signer can satisfy at most one principal of a rule.
+func evaluateRule(rule *cb.SignaturePolicy, principals []*mb.MSPRole, signers []*signer, used []bool) bool {
+	switch t := rule.GetType().(type) {
+	case *cb.SignaturePolicy_NOutOf_:
+		verified := int32(0)
+		tmp := make([]bool, len(used))
+		for _, r := range t.NOutOf.GetRules() {
+			copy(tmp, used)
+			if evaluateRule(r, principals, signers, tmp) {
+				verified++
+				copy(used, tmp)
+			}
+		}
+		return verified >= t.NOutOf.GetN()
+
+	case *cb.SignaturePolicy_SignedBy:
+		if t.SignedBy < 0 || int(t.SignedBy) >= len(principals) {
+			return false
+		}
+		for i, s := range signers {
+			if !used[i] && s.satisfies(principals[t.SignedBy]) {
+				used[i] = true
+				return true
+			}
+		}
+		return false
+
+	default:
+		return false
+	}
+}
+
+// verifyIdentitySignature verifies the endorsement with the public key of the identity certificate.
+// Returns the signer if the certificate could be parsed, even if the signature is invalid.
+func verifyIdentitySignature(e *applicationpb.EndorsementWithIdentity, msg []byte) (*signer, error) {
+	id := e.GetIdentity()
+	if id == nil {
+		return nil, errors.New("endorsement has no identity")
+	}
+	if _, ok := id.GetCreator().(*msppb.Identity_CertificateId); ok {
+		return nil, errors.New("identity references a certificate by ID and cannot be verified offline")
+	}
+
+	certBytes := id.GetCertificate()
+	if block, _ := pem.Decode(certBytes); block != nil {
+		certBytes = block.Bytes
+	}
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse identity certificate: %w", err)
+	}
+	s := &signer{mspID: id.GetMspId(), cert: cert}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return s, fmt.Errorf("unsupported public key type %T", cert.PublicKey)
+	}
+	digest := sha256.Sum256(msg)
+	if !ecdsa.VerifyASN1(pub, digest[:], e.GetEndorsement()) {
+		return s, signature.ErrSignatureMismatch
+	}
+	if !isLowS(pub.Curve, e.GetEndorsement()) {
+		return s, errors.New("signature is not in low-S form")
+	}
+
+	return s, nil
+}
+
+// isLowS reports whether the S value of an ASN.1 ECDSA signature is at most half the curve order,
+// as required by the MSP signature verification.
+func isLowS(curve elliptic.Curve, sig []byte) bool {
+	var rs struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(sig, &rs); err != nil {
+		return false
+	}
+	halfOrder := new(big.Int).Rsh(curve.Params().N, 1)
+	return rs.S.Cmp(halfOrder) <= 0
+}
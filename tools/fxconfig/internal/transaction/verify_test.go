@@ -0,0 +1,296 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/api/applicationpb"
+	"github.com/hyperledger/fabric-x-common/api/msppb"
+)
+
+// testEndorser signs transactions with a self-signed certificate of an MSP.
+type testEndorser struct {
+	mspID string
+	key   *ecdsa.PrivateKey
+	cert  []byte
+}
+
+func newTestEndorser(t *testing.T, mspID string, ous ...string) *testEndorser {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "user@" + mspID, OrganizationalUnit: ous},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return &testEndorser{
+		mspID: mspID,
+		key:   key,
+		cert:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// sign returns a low-S ASN.1 signature over the SHA-256 digest of msg.
+func (e *testEndorser) sign(t *testing.T, msg []byte) []byte {
+	t.Helper()
+
+	digest := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, e.key, digest[:])
+	require.NoError(t, err)
+
+	n := e.key.Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+	return sig
+}
+
+// endorse appends an endorsement of the first namespace of tx.
+func (e *testEndorser) endorse(t *testing.T, txID string, tx *applicationpb.Tx) {
+	t.Helper()
+
+	msg, err := tx.GetNamespaces()[0].ASN1Marshal(txID)
+	require.NoError(t, err)
+
+	if len(tx.Endorsements) == 0 {
+		tx.Endorsements = []*applicationpb.Endorsements{{}}
+	}
+	tx.Endorsements[0].EndorsementsWithIdentity = append(tx.Endorsements[0].EndorsementsWithIdentity,
+		&applicationpb.EndorsementWithIdentity{
+			Endorsement: e.sign(t, msg),
+			Identity: &msppb.Identity{
+				MspId:   e.mspID,
+				Creator: &msppb.Identity_Certificate{Certificate: e.cert},
+			},
+		})
+}
+
+func someVerifyTx(t *testing.T) *applicationpb.Tx {
+	t.Helper()
+
+	p, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+	return CreateNamespacesTx(p, "payments", -1)
+}
+
+func TestVerifyEndorsements_MSPPolicy(t *testing.T) {
+	t.Parallel()
+
+	org1 := newTestEndorser(t, "Org1MSP")
+	org2 := newTestEndorser(t, "Org2MSP")
+	org1Admin := newTestEndorser(t, "Org1MSP", "admin")
+
+	tests := []struct {
+		name       string
+		policy     string
+		endorsers  []*testEndorser
+		satisfied  bool
+		principals []PrincipalCheck
+	}{
+		{
+			name:      "all required endorsements",
+			policy:    "AND('Org1MSP.member', 'Org2MSP.member')",
+			endorsers: []*testEndorser{org1, org2},
+			satisfied: true,
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+				{Principal: "'Org2MSP.member'", Satisfied: true},
+			},
+		},
+		{
+			name:      "missing endorsement",
+			policy:    "AND('Org1MSP.member', 'Org2MSP.member')",
+			endorsers: []*testEndorser{org1},
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+				{Principal: "'Org2MSP.member'", Satisfied: false},
+			},
+		},
+		{
+			name:      "admin role requires admin OU",
+			policy:    "OR('Org1MSP.admin')",
+			endorsers: []*testEndorser{org1},
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.admin'", Satisfied: false},
+			},
+		},
+		{
+			name:      "admin satisfies admin role",
+			policy:    "OR('Org1MSP.admin')",
+			endorsers: []*testEndorser{org1Admin},
+			satisfied: true,
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.admin'", Satisfied: true},
+			},
+		},
+		{
+			name:      "an identity counts once",
+			policy:    "OutOf(2, 'Org1MSP.member', 'Org1MSP.member')",
+			endorsers: []*testEndorser{org1, org1},
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+			},
+		},
+		{
+			name:      "distinct identities of the same MSP",
+			policy:    "OutOf(2, 'Org1MSP.member', 'Org1MSP.member')",
+			endorsers: []*testEndorser{org1, org1Admin},
+			satisfied: true,
+			principals: []PrincipalCheck{
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+				{Principal: "'Org1MSP.member'", Satisfied: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tx := someVerifyTx(t)
+			for _, e := range tt.endorsers {
+				e.endorse(t, "tx-1", tx)
+			}
+			p, err := CreateMspPolicy(tt.policy)
+			require.NoError(t, err)
+
+			res, err := VerifyEndorsements("tx-1", tx, 0, p)
+			require.NoError(t, err)
+			require.Equal(t, "_meta", res.NsID)
+			require.Equal(t, tt.satisfied, res.Satisfied, res.Error)
+			require.Equal(t, tt.principals, res.Principals)
+			require.Len(t, res.Endorsements, len(tt.endorsers))
+			for i, e := range res.Endorsements {
+				require.True(t, e.Valid, e.Error)
+				require.Equal(t, tt.endorsers[i].mspID, e.MSPID)
+				require.Contains(t, e.Subject, "CN=user@"+tt.endorsers[i].mspID)
+			}
+		})
+	}
+}
+
+func TestVerifyEndorsements_InvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	tx := someVerifyTx(t)
+	// endorses a different transaction ID
+	newTestEndorser(t, "Org1MSP").endorse(t, "tx-other", tx)
+
+	p, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+
+	res, err := VerifyEndorsements("tx-1", tx, 0, p)
+	require.NoError(t, err)
+	require.False(t, res.Satisfied)
+	require.False(t, res.Endorsements[0].Valid)
+	require.Equal(t, "signature mismatch", res.Endorsements[0].Error)
+	require.Equal(t, []PrincipalCheck{{Principal: "'Org1MSP.member'"}}, res.Principals)
+}
+
+func TestVerifyEndorsements_UnverifiableIdentity(t *testing.T) {
+	t.Parallel()
+
+	tx := someVerifyTx(t)
+	tx.Endorsements = []*applicationpb.Endorsements{{
+		EndorsementsWithIdentity: []*applicationpb.EndorsementWithIdentity{
+			{Identity: &msppb.Identity{MspId: "Org1MSP", Creator: &msppb.Identity_CertificateId{CertificateId: "abc"}}},
+			{Identity: &msppb.Identity{MspId: "Org1MSP", Creator: &msppb.Identity_Certificate{Certificate: []byte("x")}}},
+			{},
+		},
+	}}
+
+	p, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+
+	res, err := VerifyEndorsements("tx-1", tx, 0, p)
+	require.NoError(t, err)
+	require.False(t, res.Satisfied)
+	require.Contains(t, res.Endorsements[0].Error, "cannot be verified offline")
+	require.Contains(t, res.Endorsements[1].Error, "cannot parse identity certificate")
+	require.Equal(t, "endorsement has no identity", res.Endorsements[2].Error)
+}
+
+func TestVerifyEndorsements_NoEndorsements(t *testing.T) {
+	t.Parallel()
+
+	p, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+
+	res, err := VerifyEndorsements("tx-1", someVerifyTx(t), 0, p)
+	require.NoError(t, err)
+	require.False(t, res.Satisfied)
+	require.Equal(t, "no endorsements", res.Error)
+	require.Empty(t, res.Endorsements)
+}
+
+func TestVerifyEndorsements_ThresholdPolicy(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestEndorser(t, "Org1MSP")
+	other := newTestEndorser(t, "Org2MSP")
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&signer.key.PublicKey)
+	require.NoError(t, err)
+	p := &applicationpb.NamespacePolicy{Rule: &applicationpb.NamespacePolicy_ThresholdRule{
+		ThresholdRule: &applicationpb.ThresholdRule{
+			Scheme:    "ECDSA",
+			PublicKey: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}),
+		},
+	}}
+
+	tx := someVerifyTx(t)
+	signer.endorse(t, "tx-1", tx)
+	other.endorse(t, "tx-1", tx)
+
+	res, err := VerifyEndorsements("tx-1", tx, 0, p)
+	require.NoError(t, err)
+	require.True(t, res.Satisfied)
+	require.Equal(t, PolicyTypeThreshold, res.Policy.Type)
+	require.Empty(t, res.Principals)
+	require.True(t, res.Endorsements[0].Valid)
+	require.False(t, res.Endorsements[1].Valid)
+
+	// only the first endorsement is considered
+	tx.Endorsements[0].EndorsementsWithIdentity = tx.Endorsements[0].EndorsementsWithIdentity[1:]
+	res, err = VerifyEndorsements("tx-1", tx, 0, p)
+	require.NoError(t, err)
+	require.False(t, res.Satisfied)
+	require.Equal(t, "first endorsement does not match the policy key", res.Error)
+}
+
+func TestVerifyEndorsements_Errors(t *testing.T) {
+	t.Parallel()
+
+	p, err := CreateMspPolicy("OR('Org1MSP.member')")
+	require.NoError(t, err)
+
+	_, err = VerifyEndorsements("tx-1", someVerifyTx(t), 1, p)
+	require.ErrorContains(t, err, "namespace index 1 out of range")
+
+	_, err = VerifyEndorsements("tx-1", someVerifyTx(t), 0, &applicationpb.NamespacePolicy{})
+	require.ErrorContains(t, err, "namespace policy has no rule")
+}
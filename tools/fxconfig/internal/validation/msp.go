@@ -0,0 +1,224 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mspprotos "github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hyperledger/fabric-x-common/common/channelconfig"
+	"github.com/hyperledger/fabric-x-common/common/policydsl"
+	"github.com/hyperledger/fabric-x-common/msp"
+	"github.com/hyperledger/fabric-x-common/protoutil"
+)
+
+// ChannelMSPs maps the MSP IDs of a channel to the roles their identities can be classified into.
+type ChannelMSPs map[string][]mspprotos.MSPRole_MSPRoleType
+
+// LoadChannelMSPs reads the MSPs of the application and orderer organizations from a config block
+// file, as written by configtxgen or fetched from the orderer.
+func LoadChannelMSPs(path string) (ChannelMSPs, error) {
+	block, err := protoutil.ReadBlockFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read config block: %w", err)
+	}
+
+	return ChannelMSPsFromBlock(block)
+}
+
+// ChannelMSPsFromBlock reads the MSPs of the application and orderer organizations from a config block.
+func ChannelMSPsFromBlock(block *cb.Block) (ChannelMSPs, error) {
+	if len(block.GetData().GetData()) != 1 {
+		return nil, errors.New("not a config block")
+	}
+	env, err := protoutil.GetEnvelopeFromBlock(block.GetData().GetData()[0])
+	if err != nil {
+		return nil, fmt.Errorf("not a config block: %w", err)
+	}
+	payload, err := protoutil.UnmarshalPayload(env.GetPayload())
+	if err != nil {
+		return nil, fmt.Errorf("not a config block: %w", err)
+	}
+	chdr, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil || chdr.GetType() != int32(cb.HeaderType_CONFIG) {
+		return nil, errors.New("not a config block")
+	}
+	configEnv, err := protoutil.UnmarshalConfigEnvelope(payload.GetData())
+	if err != nil {
+		return nil, fmt.Errorf("not a config block: %w", err)
+	}
+
+	msps := make(ChannelMSPs)
+	channel := configEnv.GetConfig().GetChannelGroup()
+	for _, groupKey := range []string{channelconfig.ApplicationGroupKey, channelconfig.OrdererGroupKey} {
+		for name, org := range channel.GetGroups()[groupKey].GetGroups() {
+			value, ok := org.GetValues()[channelconfig.MSPKey]
+			if !ok {
+				continue
+			}
+			id, roles, err := mspRoles(value.GetValue())
+			if err != nil {
+				return nil, fmt.Errorf("invalid MSP of organization %s: %w", name, err)
+			}
+			msps[id] = roles
+		}
+	}
+
+	if len(msps) == 0 {
+		return nil, errors.New("config block declares no MSPs")
+	}
+	return msps, nil
+}
+
+// mspRoles returns the ID of a serialized MSP configuration and the roles its identities can be
+// classified into. Peer, client, and orderer identities are only told apart with NodeOUs; admins are
+// identified by NodeOUs or by admin certificates. All roles are assumed for non-X.509 MSPs.
+func mspRoles(data []byte) (string, []mspprotos.MSPRole_MSPRoleType, error) {
+	var conf mspprotos.MSPConfig
+	if err := proto.Unmarshal(data, &conf); err != nil {
+		return "", nil, err
+	}
+
+	var fabricConf mspprotos.FabricMSPConfig
+	if err := proto.Unmarshal(conf.GetConfig(), &fabricConf); err != nil {
+		return "", nil, err
+	}
+	if fabricConf.GetName() == "" {
+		return "", nil, errors.New("MSP has no ID")
+	}
+
+	if conf.GetType() != int32(msp.FABRIC) {
+		return fabricConf.GetName(), []mspprotos.MSPRole_MSPRoleType{
+			mspprotos.MSPRole_MEMBER, mspprotos.MSPRole_ADMIN, mspprotos.MSPRole_CLIENT,
+			mspprotos.MSPRole_PEER, mspprotos.MSPRole_ORDERER,
+		}, nil
+	}
+
+	roles := []mspprotos.MSPRole_MSPRoleType{mspprotos.MSPRole_MEMBER}
+	ous := fabricConf.GetFabricNodeOus()
+	if len(fabricConf.GetAdmins()) > 0 || (ous.GetEnable() && ous.GetAdminOuIdentifier() != nil) {
+		roles = append(roles, mspprotos.MSPRole_ADMIN)
+	}
+	if ous.GetEnable() {
+		if ous.GetClientOuIdentifier() != nil {
+			roles = append(roles, mspprotos.MSPRole_CLIENT)
+		}
+		if ous.GetPeerOuIdentifier() != nil {
+			roles = append(roles, mspprotos.MSPRole_PEER)
+		}
+		if ous.GetOrdererOuIdentifier() != nil {
+			roles = append(roles, mspprotos.MSPRole_ORDERER)
+		}
+	}
+
+	return fabricConf.GetName(), roles, nil
+}
+
+// MSPPolicyChecker validates policy DSL expressions against the MSPs of a channel.
+// A policy that can never be satisfied, because all its alternatives name unknown MSPs or roles the
+// MSPs do not define, is rejected. Principals that are unknown in a policy that can still be
+// satisfied are reported as warnings, or rejected if Strict is set.
+type MSPPolicyChecker struct {
+	MSPs ChannelMSPs
+	// Strict rejects policies with warnings.
+	Strict bool
+	// Warnings receives the warnings of policies that are not rejected; discarded if nil.
+	Warnings io.Writer
+}
+
+// Check validates the syntax of a policy expression and lints it against the MSPs of the channel.
+func (c *MSPPolicyChecker) Check(e string) error {
+	env, err := policydsl.FromString(e)
+	if err != nil {
+		return fmt.Errorf("invalid policy expression: %w", err)
+	}
+
+	findings, usable := c.lintPrincipals(env.GetIdentities())
+	if !satisfiable(env.GetRule(), usable) {
+		if len(findings) == 0 {
+			return errors.New("policy can never be satisfied")
+		}
+		return fmt.Errorf("policy can never be satisfied: %s", strings.Join(findings, "; "))
+	}
+
+	if len(findings) == 0 {
+		return nil
+	}
+	if c.Strict {
+		return fmt.Errorf("policy names principals that cannot sign: %s", strings.Join(findings, "; "))
+	}
+	if c.Warnings != nil {
+		for _, f := range findings {
+			_, _ = fmt.Fprintf(c.Warnings, "Warning: policy %s: %s\n", e, f)
+		}
+	}
+	return nil
+}
+
+// lintPrincipals returns the findings of the principals of a policy and whether each principal can
+// sign at all.
+func (c *MSPPolicyChecker) lintPrincipals(principals []*mspprotos.MSPPrincipal) ([]string, []bool) {
+	var findings []string
+	usable := make([]bool, len(principals))
+
+	for i, p := range principals {
+		if p.GetPrincipalClassification() != mspprotos.MSPPrincipal_ROLE {
+			usable[i] = true
+			continue
+		}
+
+		var role mspprotos.MSPRole
+		if err := proto.Unmarshal(p.GetPrincipal(), &role); err != nil {
+			findings = append(findings, fmt.Sprintf("invalid principal: %v", err))
+			continue
+		}
+
+		roles, ok := c.MSPs[role.GetMspIdentifier()]
+		switch {
+		case !ok:
+			findings = append(findings, fmt.Sprintf("unknown MSP %s (channel MSPs: %s)",
+				role.GetMspIdentifier(), strings.Join(slices.Sorted(maps.Keys(c.MSPs)), ", ")))
+		case !slices.Contains(roles, role.GetRole()):
+			findings = append(findings, fmt.Sprintf("MSP %s does not define the %s role",
+				role.GetMspIdentifier(), strings.ToLower(role.GetRole().String())))
+		default:
+			usable[i] = true
+		}
+	}
+
+	return findings, usable
+}
+
+// satisfiable reports whether a signature policy can be satisfied by signatures of the usable principals.
+func satisfiable(rule *cb.SignaturePolicy, usable []bool) bool {
+	switch t := rule.GetType().(type) {
+	case *cb.SignaturePolicy_SignedBy:
+		idx := int(t.SignedBy)
+		return idx >= 0 && idx < len(usable) && usable[idx]
+	case *cb.SignaturePolicy_NOutOf_:
+		n := int(t.NOutOf.GetN())
+		for _, r := range t.NOutOf.GetRules() {
+			if n <= 0 {
+				break
+			}
+			if satisfiable(r, usable) {
+				n--
+			}
+		}
+		return n <= 0
+	default:
+		return false
+	}
+}
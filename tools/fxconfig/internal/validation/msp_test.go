@@ -0,0 +1,180 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package validation_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mspprotos "github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/fabric-x-common/protoutil"
+	"github.com/hyperledger/fabric-x/tools/fxconfig/internal/validation"
+)
+
+// mspValue returns the config value of an X.509 MSP. Without NodeOUs, only members and, with admin
+// certificates, admins can be told apart.
+func mspValue(id string, nodeOUs, admins bool) *cb.ConfigValue {
+	conf := &mspprotos.FabricMSPConfig{Name: id}
+	if admins {
+		conf.Admins = [][]byte{[]byte("admin cert")}
+	}
+	if nodeOUs {
+		conf.FabricNodeOus = &mspprotos.FabricNodeOUs{
+			Enable:             true,
+			ClientOuIdentifier: &mspprotos.FabricOUIdentifier{OrganizationalUnitIdentifier: "client"},
+			PeerOuIdentifier:   &mspprotos.FabricOUIdentifier{OrganizationalUnitIdentifier: "peer"},
+			AdminOuIdentifier:  &mspprotos.FabricOUIdentifier{OrganizationalUnitIdentifier: "admin"},
+		}
+	}
+	return &cb.ConfigValue{Value: protoutil.MarshalOrPanic(&mspprotos.MSPConfig{
+		Config: protoutil.MarshalOrPanic(conf),
+	})}
+}
+
+// writeConfigBlock writes a config block with Org1MSP and Org2MSP (with NodeOUs) as application
+// organizations and OrdererMSP (without NodeOUs, with admin certificates) as orderer organization.
+func writeConfigBlock(t *testing.T) string {
+	t.Helper()
+
+	org := func(v *cb.ConfigValue) *cb.ConfigGroup {
+		return &cb.ConfigGroup{Values: map[string]*cb.ConfigValue{"MSP": v}}
+	}
+	config := &cb.Config{ChannelGroup: &cb.ConfigGroup{Groups: map[string]*cb.ConfigGroup{
+		"Application": {Groups: map[string]*cb.ConfigGroup{
+			"Org1": org(mspValue("Org1MSP", true, false)),
+			"Org2": org(mspValue("Org2MSP", true, false)),
+		}},
+		"Orderer": {Groups: map[string]*cb.ConfigGroup{
+			"OrdererOrg": org(mspValue("OrdererMSP", false, true)),
+		}},
+	}}}
+
+	block := protoutil.NewBlock(0, nil)
+	block.Data.Data = [][]byte{protoutil.MarshalOrPanic(&cb.Envelope{
+		Payload: protoutil.MarshalOrPanic(&cb.Payload{
+			Header: protoutil.MakePayloadHeader(
+				protoutil.MakeChannelHeader(cb.HeaderType_CONFIG, 0, "mychannel", 0),
+				protoutil.MakeSignatureHeader(nil, nil),
+			),
+			Data: protoutil.MarshalOrPanic(&cb.ConfigEnvelope{Config: config}),
+		}),
+	})}
+
+	path := filepath.Join(t.TempDir(), "config.block")
+	require.NoError(t, os.WriteFile(path, protoutil.MarshalOrPanic(block), 0o600))
+	return path
+}
+
+func TestLoadChannelMSPs(t *testing.T) {
+	t.Parallel()
+
+	msps, err := validation.LoadChannelMSPs(writeConfigBlock(t))
+	require.NoError(t, err)
+	require.Equal(t, validation.ChannelMSPs{
+		"Org1MSP":    {mspprotos.MSPRole_MEMBER, mspprotos.MSPRole_ADMIN, mspprotos.MSPRole_CLIENT, mspprotos.MSPRole_PEER},
+		"Org2MSP":    {mspprotos.MSPRole_MEMBER, mspprotos.MSPRole_ADMIN, mspprotos.MSPRole_CLIENT, mspprotos.MSPRole_PEER},
+		"OrdererMSP": {mspprotos.MSPRole_MEMBER, mspprotos.MSPRole_ADMIN},
+	}, msps)
+
+	_, err = validation.LoadChannelMSPs(filepath.Join(t.TempDir(), "missing.block"))
+	require.ErrorContains(t, err, "cannot read config block")
+
+	// a block with a regular transaction is not a config block
+	block := protoutil.NewBlock(1, nil)
+	block.Data.Data = [][]byte{protoutil.MarshalOrPanic(&cb.Envelope{
+		Payload: protoutil.MarshalOrPanic(&cb.Payload{
+			Header: protoutil.MakePayloadHeader(
+				protoutil.MakeChannelHeader(cb.HeaderType_MESSAGE, 0, "mychannel", 0),
+				protoutil.MakeSignatureHeader(nil, nil),
+			),
+		}),
+	})}
+	_, err = validation.ChannelMSPsFromBlock(block)
+	require.EqualError(t, err, "not a config block")
+}
+
+func TestMSPPolicyChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	msps, err := validation.LoadChannelMSPs(writeConfigBlock(t))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		expr          string
+		strict        bool
+		expectError   string
+		expectWarning string
+	}{
+		{name: "known MSPs", expr: "AND('Org1MSP.member', 'Org2MSP.admin')"},
+		{name: "NodeOU roles", expr: "OR('Org1MSP.peer', 'Org2MSP.client')"},
+		{name: "admin certificates", expr: "OR('OrdererMSP.admin')"},
+		{
+			name:        "syntax error",
+			expr:        "NOT_A_POLICY",
+			expectError: "invalid policy expression",
+		},
+		{
+			name:        "typo in MSP ID",
+			expr:        "OR('0rg1MSP.member')",
+			expectError: "policy can never be satisfied: unknown MSP 0rg1MSP (channel MSPs: OrdererMSP, Org1MSP, Org2MSP)",
+		},
+		{
+			name:        "role without NodeOUs",
+			expr:        "AND('Org1MSP.member', 'OrdererMSP.peer')",
+			expectError: "policy can never be satisfied: MSP OrdererMSP does not define the peer role",
+		},
+		{
+			name:        "more signatures than principals",
+			expr:        "OutOf(3, 'Org1MSP.member', 'Org2MSP.member')",
+			expectError: "policy can never be satisfied",
+		},
+		{
+			name:          "unknown MSP in satisfiable policy",
+			expr:          "OR('Org1MSP.member', 'Org3MSP.member')",
+			expectWarning: "Warning: policy OR('Org1MSP.member', 'Org3MSP.member'): unknown MSP Org3MSP",
+		},
+		{
+			name:        "unknown MSP in satisfiable policy, strict",
+			expr:        "OR('Org1MSP.member', 'Org3MSP.member')",
+			strict:      true,
+			expectError: "policy names principals that cannot sign: unknown MSP Org3MSP",
+		},
+		{
+			name:        "out of with too few usable principals",
+			expr:        "OutOf(2, 'Org1MSP.member', 'Org3MSP.member', 'OrdererMSP.peer')",
+			expectError: "policy can never be satisfied: unknown MSP Org3MSP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var warnings bytes.Buffer
+			checker := &validation.MSPPolicyChecker{MSPs: msps, Strict: tt.strict, Warnings: &warnings}
+
+			err := checker.Check(tt.expr)
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				require.Empty(t, warnings.String())
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectWarning != "" {
+				require.Contains(t, warnings.String(), tt.expectWarning)
+			} else {
+				require.Empty(t, warnings.String())
+			}
+		})
+	}
+}
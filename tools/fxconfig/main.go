@@ -8,6 +8,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
 
@@ -24,7 +26,7 @@ import (
 
 func main() {
 	if err := run(); err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
 
@@ -39,7 +41,10 @@ func run() error {
 		cliCtx,
 		// inject an application builder that is invoked once we have loaded the configuration
 		func(cfg *config.Config) (app.Application, error) {
-			vctx := validation.NewValidationContext()
+			vctx, err := newValidationContext(cfg, cliCtx.ErrOut)
+			if err != nil {
+				return nil, err
+			}
 			return &app.AdminApp{
 				Validators: vctx,
 				MspProvider: provider.New[fmsp.SigningIdentity, *config.MSPConfig](
@@ -70,9 +75,41 @@ func run() error {
 					&cfg.Notifications,
 					vctx,
 				),
+				BlockQueryProvider: provider.New[adapters.BlockQueryClient, *config.NotificationsConfig](
+					func(cfg *config.NotificationsConfig) (adapters.BlockQueryClient, error) {
+						return client.NewBlockQueryClient(*cfg)
+					},
+					&cfg.Notifications,
+					vctx,
+				),
 			}, nil
 		},
 	)
 
 	return cmd.ExecuteContext(ctx)
 }
+
+// newValidationContext returns the validators of the application. If policy linting is enabled,
+// MSP policies are checked against the MSPs of the configured channel config block, and lint
+// warnings are written to warnings.
+func newValidationContext(cfg *config.Config, warnings io.Writer) (validation.Context, error) {
+	vctx := validation.NewValidationContext()
+	if !cfg.PolicyLint.Enabled() {
+		return vctx, nil
+	}
+
+	if err := cfg.PolicyLint.Validate(vctx); err != nil {
+		return vctx, fmt.Errorf("invalid policyLint: %w", err)
+	}
+	msps, err := validation.LoadChannelMSPs(cfg.PolicyLint.ConfigBlock)
+	if err != nil {
+		return vctx, fmt.Errorf("invalid policyLint: %w", err)
+	}
+
+	vctx.PolicyChecker = &validation.MSPPolicyChecker{
+		MSPs:     msps,
+		Strict:   cfg.PolicyLint.Strict,
+		Warnings: warnings,
+	}
+	return vctx, nil
+}